@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	"github.com/echotools/nevr-agent/v4/internal/agent"
+	"github.com/echotools/nevr-agent/v4/internal/api"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -26,6 +28,10 @@ type StreamConfig struct {
 	Events        bool
 	EventsStream  bool
 	EventsURL     string
+	EventsBatch   int      // Frames per events API request
+	EventsFormat  string   // Batch encoding: protobuf or ndjson
+	Compression   string   // Events API batch compression: none, gzip or zstd
+	GRPCURL       string   // gRPC ingest server URL; empty disables gRPC
 	AllFrames     bool     // Send all frames, not just event frames
 	FPS           int      // Target frames per second for streaming
 	IncludeModes  []string // Only stream these game modes
@@ -34,6 +40,9 @@ type StreamConfig struct {
 	ActiveOnly    bool     // Only stream frames during active gameplay
 	ExcludePaused bool     // Exclude paused frames (only with ActiveOnly)
 	IdleFPS       int      // Frame rate for non-gametime frames
+	TLSCA         string   // CA verifying the events API server
+	TLSCert       string   // Client certificate for mutual TLS
+	TLSKey        string   // Client certificate key
 }
 
 func newAgentCommand() *cobra.Command {
@@ -44,6 +53,10 @@ func newAgentCommand() *cobra.Command {
 		events        bool
 		eventsStream  bool
 		eventsURL     string
+		eventsBatch   int
+		eventsFormat  string
+		compression   string
+		grpcURL       string
 		allFrames     bool
 		fps           int
 		includeModes  []string
@@ -52,6 +65,9 @@ func newAgentCommand() *cobra.Command {
 		activeOnly    bool
 		excludePaused bool
 		idleFPS       int
+		tlsCA         string
+		tlsCert       string
+		tlsKey        string
 	)
 
 	cmd := &cobra.Command{
@@ -67,6 +83,13 @@ Targets are specified as host:port or host:startPort-endPort for port ranges.`,
   # Stream to events API without saving files locally
   agent stream --format none --events-stream --events-url http://localhost:8081 127.0.0.1:6721
 
+  # Stream over mutual TLS, authenticating with a client certificate
+  agent stream --format none --events-stream --events-url https://api.example.com \
+    --tls-ca ca.pem --tls-cert agent.pem --tls-key agent.key 127.0.0.1:6721
+
+  # Stream to the gRPC ingest service
+  agent stream --format none --grpc-url http://localhost:9091 127.0.0.1:6721
+
   # Use a config file
   agent stream -c config.yaml 127.0.0.1:6721
 
@@ -84,6 +107,10 @@ Targets are specified as host:port or host:startPort-endPort for port ranges.`,
 				Events:        events,
 				EventsStream:  eventsStream,
 				EventsURL:     eventsURL,
+				EventsBatch:   eventsBatch,
+				EventsFormat:  eventsFormat,
+				Compression:   compression,
+				GRPCURL:       grpcURL,
 				AllFrames:     allFrames,
 				FPS:           fps,
 				IncludeModes:  includeModes,
@@ -92,6 +119,9 @@ Targets are specified as host:port or host:startPort-endPort for port ranges.`,
 				ActiveOnly:    activeOnly,
 				ExcludePaused: excludePaused,
 				IdleFPS:       idleFPS,
+				TLSCA:         tlsCA,
+				TLSCert:       tlsCert,
+				TLSKey:        tlsKey,
 			}
 			return runAgent(cmd, args, streamCfg)
 		},
@@ -106,6 +136,13 @@ Targets are specified as host:port or host:startPort-endPort for port ranges.`,
 	cmd.Flags().BoolVar(&events, "events", false, "Enable sending frames to events API")
 	cmd.Flags().BoolVar(&eventsStream, "events-stream", false, "Enable streaming frames to events API via WebSocket")
 	cmd.Flags().StringVar(&eventsURL, "events-url", "http://localhost:8081", "Base URL of the events API")
+	cmd.Flags().IntVar(&eventsBatch, "events-batch-size", 20, "Frames per events API request (1 = one JSON request per frame)")
+	cmd.Flags().StringVar(&eventsFormat, "events-format", "protobuf", "Encoding of events API batches (protobuf, ndjson)")
+	cmd.Flags().StringVar(&compression, "events-compression", "none", "Compression of events API batches (none, gzip, zstd)")
+	cmd.Flags().StringVar(&grpcURL, "grpc-url", "", "Stream frames to a gRPC ingest server (e.g., http://localhost:9091)")
+	cmd.Flags().StringVar(&tlsCA, "tls-ca", "", "CA file to verify an https events API with (default: system roots)")
+	cmd.Flags().StringVar(&tlsCert, "tls-cert", "", "Client certificate file for mutual TLS with the events API")
+	cmd.Flags().StringVar(&tlsKey, "tls-key", "", "Client certificate key file")
 
 	// Stream filtering options
 	cmd.Flags().BoolVar(&allFrames, "all-frames", false, "Send all frames, not just frames with events")
@@ -127,9 +164,30 @@ func runAgent(cmd *cobra.Command, args []string, streamCfg StreamConfig) error {
 	cfg.Agent.OutputDirectory = streamCfg.OutputDir
 	cfg.Agent.EventsEnabled = streamCfg.Events
 	cfg.Agent.EventsURL = streamCfg.EventsURL
+	if cmd.Flags().Changed("events-batch-size") {
+		cfg.Agent.EventsBatchSize = streamCfg.EventsBatch
+	}
+	if cmd.Flags().Changed("events-format") {
+		cfg.Agent.EventsFormat = streamCfg.EventsFormat
+	}
+	if cmd.Flags().Changed("events-compression") {
+		cfg.Agent.EventsCompression = streamCfg.Compression
+	}
+	if cmd.Flags().Changed("grpc-url") {
+		cfg.Agent.GRPCURL = streamCfg.GRPCURL
+	}
+	if cmd.Flags().Changed("tls-ca") {
+		cfg.Agent.TLSCA = streamCfg.TLSCA
+	}
+	if cmd.Flags().Changed("tls-cert") {
+		cfg.Agent.TLSCert = streamCfg.TLSCert
+	}
+	if cmd.Flags().Changed("tls-key") {
+		cfg.Agent.TLSKey = streamCfg.TLSKey
+	}
 
 	// If only streaming to events API, we don't need file output
-	if streamCfg.EventsStream || streamCfg.Events {
+	if streamCfg.EventsStream || streamCfg.Events || cfg.Agent.GRPCURL != "" {
 		// Check if any file format is specified
 		hasFileFormat := false
 		for _, f := range strings.Split(streamCfg.Format, ",") {
@@ -180,7 +238,17 @@ func runAgent(cmd *cobra.Command, args []string, streamCfg StreamConfig) error {
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt)
 
-	go startAgent(ctx, logger, targets, streamCfg)
+	// TLS for the events API writers; nil uses the defaults
+	var tlsConfig *tls.Config
+	if cfg.Agent.TLSCA != "" || cfg.Agent.TLSCert != "" {
+		var err error
+		tlsConfig, err = api.NewClientTLSConfig(cfg.Agent.TLSCA, cfg.Agent.TLSCert, cfg.Agent.TLSKey)
+		if err != nil {
+			return fmt.Errorf("failed to configure TLS: %w", err)
+		}
+	}
+
+	go startAgent(ctx, logger, targets, streamCfg, tlsConfig)
 
 	select {
 	case <-ctx.Done():
@@ -195,7 +263,7 @@ func runAgent(cmd *cobra.Command, args []string, streamCfg StreamConfig) error {
 	return nil
 }
 
-func startAgent(ctx context.Context, logger *zap.Logger, targets map[string][]int, streamCfg StreamConfig) {
+func startAgent(ctx context.Context, logger *zap.Logger, targets map[string][]int, streamCfg StreamConfig, tlsConfig *tls.Config) {
 	client := &http.Client{
 		Timeout: 3 * time.Second,
 		Transport: &http.Transport{
@@ -300,7 +368,17 @@ OuterLoop:
 
 				// If events sending is enabled, add EventsAPI writer
 				if cfg.Agent.EventsEnabled {
-					eventsWriter := agent.NewEventsAPIWriter(logger, streamCfg.EventsURL, cfg.Agent.JWTToken)
+					compression := cfg.Agent.EventsCompression
+					if compression == "none" {
+						compression = ""
+					}
+					eventsWriter := agent.NewEventsAPIWriter(logger, api.ClientConfig{
+						BaseURL:     streamCfg.EventsURL,
+						JWTToken:    cfg.Agent.JWTToken,
+						TLSConfig:   tlsConfig,
+						BatchFormat: cfg.Agent.EventsFormat,
+						Compression: compression,
+					}, cfg.Agent.EventsBatchSize)
 					writers = append(writers, eventsWriter)
 				}
 				// If events streaming is enabled, add WebSocket writer
@@ -312,7 +390,7 @@ OuterLoop:
 					}
 					wsURL = strings.TrimSuffix(wsURL, "/") + "/v3/stream"
 
-					wsWriter := agent.NewWebSocketWriter(logger, wsURL, cfg.Agent.JWTToken)
+					wsWriter := agent.NewWebSocketWriter(logger, wsURL, cfg.Agent.JWTToken, tlsConfig)
 					if err := wsWriter.Connect(); err != nil {
 						logger.Error("Failed to connect WebSocket writer", zap.Error(err))
 					} else {
@@ -321,6 +399,16 @@ OuterLoop:
 					}
 				}
 
+				// If a gRPC server is set, add gRPC writer
+				if cfg.Agent.GRPCURL != "" {
+					grpcWriter, err := agent.NewGRPCWriter(logger, cfg.Agent.GRPCURL, cfg.Agent.JWTToken, tlsConfig)
+					if err != nil {
+						logger.Error("Failed to create gRPC writer", zap.Error(err))
+					} else {
+						writers = append(writers, grpcWriter)
+					}
+				}
+
 				if len(writers) == 0 {
 					logger.Warn("No output format or destination specified, skipping session")
 					continue
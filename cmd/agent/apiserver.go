@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/echotools/nevr-agent/v4/internal/api"
 	"github.com/spf13/cobra"
@@ -47,12 +48,24 @@ and real-time streaming support.`,
   # Start with custom MongoDB URI
 	agent serve --mongo-uri mongodb://localhost:27017
 
+  # Run without MongoDB, storing sessions on disk
+	agent serve --storage embedded --data-dir ./data
+
   # Enable capture storage with retention
 	agent serve --capture-dir ./captures --capture-retention 168h
 
+  # Archive sessions to the capture directory after 30 days
+	agent serve --session-retention 720h
+
+  # Serve HTTPS and authenticate agents by client certificate
+	agent serve --tls-cert server.pem --tls-key server.key --tls-client-ca agents-ca.pem
+
   # Enable Prometheus metrics
 	agent serve --metrics-addr :9090
 
+  # Serve the gRPC TelemetryService alongside HTTP
+	agent serve --grpc-address :9091
+
   # Use a config file
 	agent serve -c config.yaml`,
 		RunE: runAPIServer,
@@ -62,18 +75,45 @@ and real-time streaming support.`,
 	cmd.Flags().String("server-address", ":8081", "Server listen address")
 	cmd.Flags().String("mongo-uri", "mongodb://localhost:27017", "MongoDB connection URI")
 	cmd.Flags().String("jwt-secret", "", "JWT secret key for token validation")
+	cmd.Flags().String("tls-cert", "", "TLS certificate file; enables HTTPS (reloaded when it changes)")
+	cmd.Flags().String("tls-key", "", "TLS private key file")
+	cmd.Flags().String("tls-client-ca", "", "CA file for client certificate authentication")
+	cmd.Flags().String("tls-client-auth", "", "Client certificate mode: request (default) or require")
+	cmd.Flags().String("jwt-revocation-file", "", "File of revoked token IDs, written by 'agent token revoke'")
+
+	// Storage backend flags
+	cmd.Flags().String("storage", "mongo", "Session storage backend (mongo, embedded)")
+	cmd.Flags().String("data-dir", "./data", "Directory for the embedded storage backend")
 
 	// Capture storage flags
 	cmd.Flags().String("capture-dir", "./captures", "Directory to store nevrcap capture files")
 	cmd.Flags().String("capture-retention", "168h", "How long to keep capture files (e.g., 24h, 7d)")
 	cmd.Flags().Int64("capture-max-size", 10*1024*1024*1024, "Maximum storage for captures in bytes")
+	cmd.Flags().String("session-retention", "", "How long to keep sessions in session storage before archiving them to the archive directory (empty keeps them forever)")
+	cmd.Flags().String("archive-dir", "", "Directory for archives of expired sessions, kept regardless of capture limits (default: archive in the capture directory)")
 
 	// Rate limiting
-	cmd.Flags().Int("max-stream-hz", 60, "Maximum frames per second to accept from clients")
+	cmd.Flags().Int("max-stream-hz", 60, "Maximum frames per second to accept from each node")
+	cmd.Flags().Int("token-max-stream-hz", 0, "Maximum frames per second to accept per token (0 = unlimited)")
+	cmd.Flags().Int("ingest-burst", 0, "Frames accepted at once above the rate (default: one second's worth)")
+	cmd.Flags().Int64("daily-frame-quota", 0, "Maximum frames per node per UTC day (0 = unlimited)")
+	cmd.Flags().Int64("daily-byte-quota", 0, "Maximum payload bytes per node per UTC day (0 = unlimited)")
+
+	// Stream subscribers
+	cmd.Flags().Int("stream-keyframe-interval", 0, "Frames between keyframes for stream subscribers on the binary delta protocol (0 = 60)")
+	cmd.Flags().String("stream-slow-consumer-policy", "drop", "What to do with live frames for stream subscribers that fall behind: drop, coalesce or disconnect")
+	cmd.Flags().String("stream-slow-consumer-timeout", "10s", "How long a stream subscriber may stay behind under the disconnect policy")
+	cmd.Flags().String("stream-bus", "", "Frame bus between API server replicas, so each serves every live match: empty for none, or amqp")
+	cmd.Flags().String("stream-bus-exchange", "", "AMQP exchange of the stream bus (default: match.frames)")
+	cmd.Flags().String("amqp-uri", "", "AMQP broker URI for the stream bus")
+	cmd.Flags().String("instance-id", "", "ID of this replica on the stream bus (default: host name with a random suffix)")
 
 	// Metrics
 	cmd.Flags().String("metrics-addr", "", "Prometheus metrics endpoint address (e.g., :9090)")
 
+	// gRPC
+	cmd.Flags().String("grpc-address", "", "gRPC listen address (e.g., :9091); empty disables gRPC")
+
 	// Bind flags to viper
 	viper.BindPFlags(cmd.Flags())
 
@@ -85,11 +125,70 @@ func runAPIServer(cmd *cobra.Command, args []string) error {
 	cfg.APIServer.ServerAddress = viper.GetString("server-address")
 	cfg.APIServer.MongoURI = viper.GetString("mongo-uri")
 	cfg.APIServer.JWTSecret = viper.GetString("jwt-secret")
+	if cmd.Flags().Changed("jwt-revocation-file") {
+		cfg.APIServer.JWTRevocationFile = viper.GetString("jwt-revocation-file")
+	}
+	if cmd.Flags().Changed("tls-cert") {
+		cfg.APIServer.TLS.CertFile = viper.GetString("tls-cert")
+	}
+	if cmd.Flags().Changed("tls-key") {
+		cfg.APIServer.TLS.KeyFile = viper.GetString("tls-key")
+	}
+	if cmd.Flags().Changed("tls-client-ca") {
+		cfg.APIServer.TLS.ClientCAFile = viper.GetString("tls-client-ca")
+	}
+	if cmd.Flags().Changed("tls-client-auth") {
+		cfg.APIServer.TLS.ClientAuth = viper.GetString("tls-client-auth")
+	}
+	cfg.APIServer.StorageBackend = viper.GetString("storage")
+	cfg.APIServer.DataDir = viper.GetString("data-dir")
 	cfg.APIServer.CaptureDir = viper.GetString("capture-dir")
 	cfg.APIServer.CaptureRetention = viper.GetString("capture-retention")
 	cfg.APIServer.CaptureMaxSize = viper.GetInt64("capture-max-size")
+	if cmd.Flags().Changed("session-retention") {
+		cfg.APIServer.SessionRetention = viper.GetString("session-retention")
+	}
+	if cmd.Flags().Changed("archive-dir") {
+		cfg.APIServer.ArchiveDir = viper.GetString("archive-dir")
+	}
 	cfg.APIServer.MaxStreamHz = viper.GetInt("max-stream-hz")
+	if cmd.Flags().Changed("token-max-stream-hz") {
+		cfg.APIServer.TokenMaxStreamHz = viper.GetInt("token-max-stream-hz")
+	}
+	if cmd.Flags().Changed("ingest-burst") {
+		cfg.APIServer.IngestBurst = viper.GetInt("ingest-burst")
+	}
+	if cmd.Flags().Changed("daily-frame-quota") {
+		cfg.APIServer.DailyFrameQuota = viper.GetInt64("daily-frame-quota")
+	}
+	if cmd.Flags().Changed("daily-byte-quota") {
+		cfg.APIServer.DailyByteQuota = viper.GetInt64("daily-byte-quota")
+	}
+	if cmd.Flags().Changed("stream-keyframe-interval") {
+		cfg.APIServer.StreamKeyframeInterval = viper.GetInt("stream-keyframe-interval")
+	}
+	if cmd.Flags().Changed("stream-slow-consumer-policy") {
+		cfg.APIServer.StreamSlowConsumerPolicy = viper.GetString("stream-slow-consumer-policy")
+	}
+	if cmd.Flags().Changed("stream-slow-consumer-timeout") {
+		cfg.APIServer.StreamSlowConsumerTimeout = viper.GetString("stream-slow-consumer-timeout")
+	}
+	if cmd.Flags().Changed("stream-bus") {
+		cfg.APIServer.StreamBus = viper.GetString("stream-bus")
+	}
+	if cmd.Flags().Changed("stream-bus-exchange") {
+		cfg.APIServer.StreamBusExchange = viper.GetString("stream-bus-exchange")
+	}
+	if cmd.Flags().Changed("amqp-uri") {
+		cfg.APIServer.AMQPURI = viper.GetString("amqp-uri")
+	}
+	if cmd.Flags().Changed("instance-id") {
+		cfg.APIServer.InstanceID = viper.GetString("instance-id")
+	}
 	cfg.APIServer.MetricsAddr = viper.GetString("metrics-addr")
+	if cmd.Flags().Changed("grpc-address") {
+		cfg.APIServer.GRPCAddress = viper.GetString("grpc-address")
+	}
 
 	// Validate configuration
 	if err := cfg.ValidateAPIServerConfig(); err != nil {
@@ -98,23 +197,69 @@ func runAPIServer(cmd *cobra.Command, args []string) error {
 
 	logger.Info("Starting API server",
 		zap.String("server_address", cfg.APIServer.ServerAddress),
+		zap.String("storage", cfg.APIServer.StorageBackend),
 		zap.String("mongo_uri", cfg.APIServer.MongoURI),
+		zap.String("data_dir", cfg.APIServer.DataDir),
 		zap.String("capture_dir", cfg.APIServer.CaptureDir),
 		zap.String("capture_retention", cfg.APIServer.CaptureRetention),
 		zap.Int64("capture_max_size", cfg.APIServer.CaptureMaxSize),
+		zap.String("session_retention", cfg.APIServer.SessionRetention),
+		zap.Int("session_retention_rules", len(cfg.APIServer.SessionRetentionRules)),
 		zap.Int("max_stream_hz", cfg.APIServer.MaxStreamHz),
-		zap.String("metrics_addr", cfg.APIServer.MetricsAddr))
+		zap.Int("token_max_stream_hz", cfg.APIServer.TokenMaxStreamHz),
+		zap.Int64("daily_frame_quota", cfg.APIServer.DailyFrameQuota),
+		zap.Int64("daily_byte_quota", cfg.APIServer.DailyByteQuota),
+		zap.String("metrics_addr", cfg.APIServer.MetricsAddr),
+		zap.String("grpc_address", cfg.APIServer.GRPCAddress),
+		zap.Bool("tls", cfg.APIServer.TLS.CertFile != ""),
+		zap.Bool("client_cert_auth", cfg.APIServer.TLS.ClientCAFile != ""))
 
 	// Create service configuration
 	serviceConfig := api.DefaultConfig()
+	serviceConfig.StorageBackend = cfg.APIServer.StorageBackend
+	serviceConfig.DataDir = cfg.APIServer.DataDir
 	serviceConfig.MongoURI = cfg.APIServer.MongoURI
 	serviceConfig.ServerAddress = cfg.APIServer.ServerAddress
 	serviceConfig.JWTSecret = cfg.APIServer.JWTSecret
+	serviceConfig.JWTKeys = jwtKeys(cfg.APIServer.JWTKeys)
+	serviceConfig.JWTRevocationFile = cfg.APIServer.JWTRevocationFile
+	serviceConfig.TLS = api.TLSConfig{
+		CertFile:     cfg.APIServer.TLS.CertFile,
+		KeyFile:      cfg.APIServer.TLS.KeyFile,
+		ClientCAFile: cfg.APIServer.TLS.ClientCAFile,
+		ClientAuth:   cfg.APIServer.TLS.ClientAuth,
+		ClientNodes:  cfg.APIServer.TLS.ClientNodes,
+		ClientScope:  cfg.APIServer.TLS.ClientScope,
+	}
 	serviceConfig.CaptureDir = cfg.APIServer.CaptureDir
 	serviceConfig.CaptureRetention = cfg.APIServer.CaptureRetention
 	serviceConfig.CaptureMaxSize = cfg.APIServer.CaptureMaxSize
+	serviceConfig.SessionRetention = cfg.APIServer.SessionRetention
+	serviceConfig.ArchiveDir = cfg.APIServer.ArchiveDir
+	for _, rule := range cfg.APIServer.SessionRetentionRules {
+		serviceConfig.SessionRetentionRules = append(serviceConfig.SessionRetentionRules, api.RetentionRule{
+			NodeID:       rule.NodeID,
+			MatchType:    rule.MatchType,
+			PrivateMatch: rule.PrivateMatch,
+			Retention:    rule.Retention,
+		})
+	}
 	serviceConfig.MaxStreamHz = cfg.APIServer.MaxStreamHz
+	serviceConfig.TokenMaxStreamHz = cfg.APIServer.TokenMaxStreamHz
+	serviceConfig.IngestBurst = cfg.APIServer.IngestBurst
+	serviceConfig.DailyFrameQuota = cfg.APIServer.DailyFrameQuota
+	serviceConfig.DailyByteQuota = cfg.APIServer.DailyByteQuota
+	serviceConfig.StreamKeyframeInterval = cfg.APIServer.StreamKeyframeInterval
+	serviceConfig.StreamSlowConsumerPolicy = cfg.APIServer.StreamSlowConsumerPolicy
+	serviceConfig.StreamSlowConsumerTimeout = cfg.APIServer.StreamSlowConsumerTimeout
+	serviceConfig.StreamBus = cfg.APIServer.StreamBus
+	serviceConfig.StreamBusExchange = cfg.APIServer.StreamBusExchange
+	if cfg.APIServer.AMQPURI != "" {
+		serviceConfig.AMQPURI = cfg.APIServer.AMQPURI
+	}
+	serviceConfig.InstanceID = cfg.APIServer.InstanceID
 	serviceConfig.MetricsAddr = cfg.APIServer.MetricsAddr
+	serviceConfig.GRPCAddress = cfg.APIServer.GRPCAddress
 
 	// Create service
 	service, err := api.NewService(serviceConfig, &zapLoggerAdapter{logger: logger})
@@ -155,8 +300,10 @@ func runAPIServer(cmd *cobra.Command, args []string) error {
 		logger.Info("Service stopped", zap.Error(err))
 	}
 
-	// Stop service
-	if err := service.Stop(context.Background()); err != nil {
+	// Stop service, giving open streams a bounded time to finish
+	stopCtx, stopCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer stopCancel()
+	if err := service.Stop(stopCtx); err != nil {
 		logger.Warn("Error stopping service", zap.Error(err))
 	}
 
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api"
+	"github.com/spf13/cobra"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func newBackfillEventsCommand() *cobra.Command {
+	var mongoURI string
+	var sessionID string
+
+	cmd := &cobra.Command{
+		Use:   "backfill-events",
+		Short: "Populate the typed events collection from stored frames",
+		Long: `Backfill-events scans the raw session frames stored in MongoDB and writes
+one document per detected event into the lobby_events collection.
+
+Events that were already stored are skipped, so the command can be re-run
+safely after an interruption.`,
+		Example: `  # Backfill events for all sessions
+  agent backfill-events
+
+  # Backfill events for a single session
+  agent backfill-events --session-id 550e8400-e29b-41d4-a716-446655440000`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if mongoURI == "" {
+				mongoURI = os.Getenv("EVR_APISERVER_MONGO_URI")
+			}
+			if mongoURI == "" {
+				mongoURI = "mongodb://localhost:27017"
+			}
+			return runBackfillEvents(mongoURI, sessionID)
+		},
+	}
+
+	cmd.Flags().StringVar(&mongoURI, "mongo-uri", "", "MongoDB connection URI")
+	cmd.Flags().StringVar(&sessionID, "session-id", "", "Only backfill events for this lobby session ID")
+
+	return cmd
+}
+
+func runBackfillEvents(mongoURI, sessionID string) error {
+	fmt.Printf("Connecting to MongoDB: %s\n", mongoURI)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Handle interrupt signals
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		fmt.Println("\nReceived interrupt signal, cancelling backfill...")
+		cancel()
+	}()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	defer func() {
+		disconnectCtx, disconnectCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer disconnectCancel()
+		client.Disconnect(disconnectCtx)
+	}()
+
+	if err := client.Ping(ctx, nil); err != nil {
+		return fmt.Errorf("failed to ping MongoDB: %w", err)
+	}
+
+	stats, err := api.BackfillEvents(ctx, client, sessionID, &api.DefaultLogger{})
+	if err != nil {
+		return fmt.Errorf("backfill failed: %w", err)
+	}
+
+	fmt.Println("\n=== Backfill Statistics ===")
+	fmt.Printf("Frames scanned:  %d\n", stats.FramesScanned)
+	fmt.Printf("Frames failed:   %d\n", stats.FramesFailed)
+	fmt.Printf("Events inserted: %d\n", stats.EventsInserted)
+	fmt.Printf("Duration:        %v\n", stats.EndTime.Sub(stats.StartTime))
+
+	return nil
+}
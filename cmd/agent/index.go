@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/echotools/nevr-agent/v4/internal/nevrcap"
+	"github.com/spf13/cobra"
+)
+
+func newIndexCommand() *cobra.Command {
+	var force bool
+	var blockFrames int
+
+	cmd := &cobra.Command{
+		Use:   "index [files or directories...]",
+		Short: "Build seek indexes for .nevrcap files",
+		Long: `Index rewrites .nevrcap files as a series of independently decodable blocks
+and writes a seek index beside each one (<file>.nevrcap.idx). The index maps
+frame indices, timestamps and round boundaries to the blocks, so playback and
+seeking can open any point of a capture without decoding it from the start.
+
+Captures written by the agent and the API server are indexed as they are
+written. Files that already have an up-to-date index are skipped unless
+--force is given. Directories are searched for .nevrcap files.
+
+Captures that are still being written are always skipped: those with a lock
+file (<file>.nevrcap.lock) or modified within the last two minutes. Remove
+the lock file of a capture whose writer did not exit cleanly to index it.`,
+		Example: `  # Index a single capture
+  agent index match.nevrcap
+
+  # Index every capture in a directory
+  agent index ./nevrcap_captures
+
+  # Rebuild indexes with smaller blocks, for finer seeking
+  agent index --force --block-frames 60 ./nevrcap_captures`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIndex(args, force, blockFrames)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Rebuild indexes that are already up to date")
+	cmd.Flags().IntVar(&blockFrames, "block-frames", nevrcap.DefaultBlockFrames, "Frames per independently decodable block")
+
+	return cmd
+}
+
+func runIndex(paths []string, force bool, blockFrames int) error {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.HasSuffix(strings.ToLower(p), ".nevrcap") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to search %s: %w", path, err)
+		}
+	}
+
+	var indexed, skipped, active, failed int
+	for _, file := range files {
+		inUse, err := nevrcap.CaptureInUse(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			failed++
+			continue
+		}
+		if inUse {
+			fmt.Fprintf(os.Stderr, "%s: skipped, %v\n", file, nevrcap.ErrCaptureInUse)
+			active++
+			continue
+		}
+
+		if !force {
+			if _, err := nevrcap.LoadIndex(file); err == nil {
+				skipped++
+				continue
+			}
+		}
+
+		index, err := nevrcap.Reindex(file, blockFrames)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", file, err)
+			failed++
+			continue
+		}
+		fmt.Printf("%s: %d frames in %d blocks, %d rounds\n", file, index.Frames, len(index.Blocks), len(index.Rounds))
+		indexed++
+	}
+
+	fmt.Printf("Indexed %d files, skipped %d up to date", indexed, skipped)
+	if active > 0 {
+		fmt.Printf(", %d being written", active)
+	}
+	if failed > 0 {
+		fmt.Printf(", %d failed\n", failed)
+		return fmt.Errorf("failed to index %d files", failed)
+	}
+	fmt.Println()
+	return nil
+}
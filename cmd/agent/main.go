@@ -101,6 +101,10 @@ serving recorded data.`,
 	rootCmd.AddCommand(pushCmd)
 
 	rootCmd.AddCommand(newVersionCheckCommand())
+	rootCmd.AddCommand(newMigrateCommand())
+	rootCmd.AddCommand(newBackfillEventsCommand())
+	rootCmd.AddCommand(newTokenCommand())
+	rootCmd.AddCommand(newIndexCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api"
+	"github.com/echotools/nevr-agent/v4/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/cobra"
+)
+
+func newTokenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Issue, inspect and revoke API tokens",
+		Long: `Token manages the JWTs accepted by the API server.
+
+Tokens are signed with the keys configured under apiserver.jwt_keys, or with
+apiserver.jwt_secret when no key is selected. Revoked token IDs are written to
+apiserver.jwt_revocation_file, which the server re-reads while running.`,
+		Example: `  # Issue an ingest token for a node, valid for 30 days
+  agent token issue --node node1 --user user1 --ttl 720h
+
+  # Issue a read and subscribe token signed with a specific key
+  agent token issue --kid 2026-10 --scope read --scope stream:subscribe
+
+  # Show a token's claims and check it against the configured keys
+  agent token inspect eyJhbGciOi...
+
+  # Revoke a token by value or by its ID
+  agent token revoke eyJhbGciOi...
+  agent token revoke 0b6f3c1e-...`,
+	}
+
+	cmd.PersistentFlags().String("jwt-secret", "", "JWT secret for tokens without a kid (default from config)")
+	cmd.PersistentFlags().String("revocation-file", "", "File of revoked token IDs (default from config)")
+
+	cmd.AddCommand(newTokenIssueCommand())
+	cmd.AddCommand(newTokenInspectCommand())
+	cmd.AddCommand(newTokenRevokeCommand())
+
+	return cmd
+}
+
+func newTokenIssueCommand() *cobra.Command {
+	var nodeID, userID, kid string
+	var scopes []string
+	var ttl time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Issue a signed token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := tokenSigningKey(cmd, kid)
+			if err != nil {
+				return err
+			}
+
+			for _, scope := range scopes {
+				switch scope {
+				case api.ScopeIngest, api.ScopeRead, api.ScopeStreamSubscribe, api.ScopeAdmin:
+				default:
+					return fmt.Errorf("unknown scope %q", scope)
+				}
+			}
+
+			claims := api.Claims{
+				NodeID: nodeID,
+				UserID: userID,
+				Scope:  strings.Join(scopes, " "),
+			}
+			if ttl > 0 {
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(ttl))
+			}
+
+			token, err := api.IssueToken(key, claims)
+			if err != nil {
+				return err
+			}
+			fmt.Println(token)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&nodeID, "node", "", "Node the token is issued to (frames are attributed to it)")
+	cmd.Flags().StringVar(&userID, "user", "", "User the token is issued to")
+	cmd.Flags().StringArrayVar(&scopes, "scope", []string{api.ScopeIngest}, "Scope to grant (ingest, read, stream:subscribe, admin); repeatable")
+	cmd.Flags().DurationVar(&ttl, "ttl", 30*24*time.Hour, "How long the token is valid (0 never expires)")
+	cmd.Flags().StringVar(&kid, "kid", "", "Signing key to use (default: jwt_secret, or the first key with a private key)")
+
+	return cmd
+}
+
+func newTokenInspectCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "inspect <token>",
+		Short: "Show a token's header and claims and verify it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tokenString := strings.TrimPrefix(args[0], "Bearer ")
+
+			token, _, err := jwt.NewParser().ParseUnverified(tokenString, &api.Claims{})
+			if err != nil {
+				return fmt.Errorf("failed to parse token: %w", err)
+			}
+
+			out, err := json.MarshalIndent(map[string]any{
+				"header": token.Header,
+				"claims": token.Claims,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(out))
+
+			auth, err := tokenAuthenticator(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := auth.Verify(tokenString); err != nil {
+				fmt.Printf("Status: invalid (%v)\n", err)
+				return nil
+			}
+			fmt.Println("Status: valid")
+			return nil
+		},
+	}
+}
+
+func newTokenRevokeCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke <token|jti>",
+		Short: "Revoke a token",
+		Long: `Revoke adds a token's ID to the revocation list. The token can be given
+by value or by the ID in its "jti" claim. The entry is dropped from the list
+once the token would have expired anyway.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := tokenRevocationFile(cmd)
+			if path == "" {
+				return fmt.Errorf("no revocation file configured (set apiserver.jwt_revocation_file or --revocation-file)")
+			}
+
+			id := args[0]
+			var expiresAt time.Time
+			if strings.Count(id, ".") == 2 {
+				claims := &api.Claims{}
+				if _, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(id, "Bearer "), claims); err != nil {
+					return fmt.Errorf("failed to parse token: %w", err)
+				}
+				if claims.ID == "" {
+					return fmt.Errorf("token has no jti claim and cannot be revoked; rotate its signing key instead")
+				}
+				id = claims.ID
+				if claims.ExpiresAt != nil {
+					expiresAt = claims.ExpiresAt.Time
+				}
+			}
+
+			list, err := api.NewRevocationList(path)
+			if err != nil {
+				return err
+			}
+			if err := list.Revoke(id, expiresAt); err != nil {
+				return err
+			}
+			fmt.Printf("Revoked token %s\n", id)
+			return nil
+		},
+	}
+}
+
+// tokenSecret returns the HMAC secret for tokens without a kid
+func tokenSecret(cmd *cobra.Command) string {
+	if secret, _ := cmd.Flags().GetString("jwt-secret"); secret != "" {
+		return secret
+	}
+	return cfg.APIServer.JWTSecret
+}
+
+// tokenRevocationFile returns the path of the revocation list
+func tokenRevocationFile(cmd *cobra.Command) string {
+	if path, _ := cmd.Flags().GetString("revocation-file"); path != "" {
+		return path
+	}
+	return cfg.APIServer.JWTRevocationFile
+}
+
+// tokenSigningKey selects the key to issue tokens with
+func tokenSigningKey(cmd *cobra.Command, kid string) (*api.SigningKey, error) {
+	keys, err := api.LoadSigningKeys(jwtKeys(cfg.APIServer.JWTKeys))
+	if err != nil {
+		return nil, err
+	}
+
+	if kid != "" {
+		for _, key := range keys {
+			if key.ID == kid {
+				return key, nil
+			}
+		}
+		return nil, fmt.Errorf("no jwt key with kid %q is configured", kid)
+	}
+
+	if secret := tokenSecret(cmd); secret != "" {
+		return api.NewHMACKey("", []byte(secret)), nil
+	}
+	for _, key := range keys {
+		if key.CanSign() {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no signing key configured (set apiserver.jwt_secret or a jwt key with a private key)")
+}
+
+// tokenAuthenticator verifies tokens the way the API server would
+func tokenAuthenticator(cmd *cobra.Command) (*api.Authenticator, error) {
+	auth := api.NewAuthenticator(tokenSecret(cmd))
+
+	keys, err := api.LoadSigningKeys(jwtKeys(cfg.APIServer.JWTKeys))
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		auth.AddKey(key)
+	}
+
+	if path := tokenRevocationFile(cmd); path != "" {
+		if _, err := os.Stat(path); err == nil {
+			list, err := api.NewRevocationList(path)
+			if err != nil {
+				return nil, err
+			}
+			auth.SetRevocationList(list)
+		}
+	}
+	return auth, nil
+}
+
+// jwtKeys converts the configured signing keys to their API server form
+func jwtKeys(keys []config.JWTKeyConfig) []api.JWTKey {
+	converted := make([]api.JWTKey, 0, len(keys))
+	for _, key := range keys {
+		converted = append(converted, api.JWTKey{
+			ID:             key.ID,
+			Algorithm:      key.Algorithm,
+			Secret:         key.Secret,
+			PublicKeyFile:  key.PublicKeyFile,
+			PrivateKeyFile: key.PrivateKeyFile,
+		})
+	}
+	return converted
+}
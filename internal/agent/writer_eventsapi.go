@@ -2,14 +2,22 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"time"
 
 	api "github.com/echotools/nevr-agent/v4/internal/api"
 	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gofrs/uuid/v5"
 	"go.uber.org/zap"
 )
 
+// eventsFlushInterval bounds how long a frame waits for its batch to fill
+const eventsFlushInterval = 250 * time.Millisecond
+
 // EventsAPIWriter implements FrameWriter and posts frames to a session events API.
 type EventsAPIWriter struct {
 	logger      *zap.Logger
@@ -21,59 +29,190 @@ type EventsAPIWriter struct {
 	framesCount int64
 	eventsSent  int64
 	eventsURL   string
+	batchSize   int
+
+	// Frames are dropped until then after the server reports a rate limit
+	limitedUntil  time.Time
+	framesLimited int64
 }
 
 // NewEventsAPIWriter creates a new EventsAPIWriter with a background sender.
-func NewEventsAPIWriter(logger *zap.Logger, baseURL, jwtToken string) *EventsAPIWriter {
+// Frames are posted in batches of up to batchSize, encoded and compressed as
+// set in config; a batchSize of 1 posts each frame as JSON on its own.
+func NewEventsAPIWriter(logger *zap.Logger, config api.ClientConfig, batchSize int) *EventsAPIWriter {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	c := api.NewClient(api.ClientConfig{
-		BaseURL:  baseURL,
-		Timeout:  5 * time.Second,
-		JWTToken: jwtToken,
-	})
+	if config.Timeout == 0 {
+		config.Timeout = 5 * time.Second
+	}
+	batchSize = max(batchSize, 1)
 
 	w := &EventsAPIWriter{
 		logger:     logger.With(zap.String("component", "events_api_writer")),
-		client:     c,
+		client:     api.NewClient(config),
 		ctx:        ctx,
 		cancel:     cancel,
 		outgoingCh: make(chan *telemetry.LobbySessionStateFrame, 1000),
 		stopped:    false,
-		eventsURL:  baseURL,
+		eventsURL:  config.BaseURL,
+		batchSize:  batchSize,
 	}
 
 	w.logger.Info("EventsAPIWriter initialized",
-		zap.String("events_endpoint", baseURL))
+		zap.String("events_endpoint", config.BaseURL),
+		zap.Int("batch_size", batchSize),
+		zap.String("compression", config.Compression))
 
 	go w.run()
 	return w
 }
 
 func (w *EventsAPIWriter) run() {
+	ticker := time.NewTicker(eventsFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*telemetry.LobbySessionStateFrame, 0, w.batchSize)
 	for {
 		select {
 		case <-w.ctx.Done():
 			return
 		case frame := <-w.outgoingCh:
-			// Use a short timeout to avoid blocking the pipeline.
-			ctx, cancel := context.WithTimeout(w.ctx, 2*time.Second)
-			resp, err := w.client.StoreSessionEvent(ctx, frame)
-			if err != nil {
-				w.logger.Warn("Failed to send session event",
-					zap.Error(err),
-					zap.String("url", w.eventsURL),
-					zap.Int("event_count", len(frame.Events)))
-			} else {
-				w.eventsSent++
-				w.logger.Debug("Session event sent successfully",
-					zap.Int("event_count", len(frame.Events)),
-					zap.Bool("success", resp.Success),
-					zap.Int64("total_events_sent", w.eventsSent))
+			batch = append(batch, frame)
+			if len(batch) >= w.batchSize {
+				w.send(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				w.send(batch)
+				batch = batch[:0]
+			}
+		}
+	}
+}
+
+// send posts a batch of frames, dropping it while the server's rate limit is
+// in effect
+func (w *EventsAPIWriter) send(batch []*telemetry.LobbySessionStateFrame) {
+	if time.Now().Before(w.limitedUntil) {
+		w.framesLimited += int64(len(batch))
+		return
+	}
+
+	eventCount := 0
+	for _, frame := range batch {
+		eventCount += len(frame.Events)
+	}
+
+	err := w.post(batch)
+	var limitErr *api.RateLimitError
+	if errors.As(err, &limitErr) {
+		w.limitedUntil = time.Now().Add(limitErr.RetryAfter)
+		w.framesLimited += int64(len(batch))
+		w.logger.Warn("Events API rate limit reached, dropping frames until it resets",
+			zap.String("limit", limitErr.Limit),
+			zap.Duration("retry_after", limitErr.RetryAfter),
+			zap.Int64("frames_limited", w.framesLimited))
+	} else if err != nil {
+		w.logger.Warn("Failed to send session events",
+			zap.Error(err),
+			zap.String("url", w.eventsURL),
+			zap.Int("frame_count", len(batch)),
+			zap.Int("event_count", eventCount))
+	} else {
+		w.eventsSent += int64(eventCount)
+		w.logger.Debug("Session events sent successfully",
+			zap.Int("frame_count", len(batch)),
+			zap.Int("event_count", eventCount),
+			zap.Int64("total_events_sent", w.eventsSent))
+	}
+}
+
+// post sends a batch with a short timeout to avoid blocking the pipeline.
+// Batches that fail on the network or with a server error are retried under
+// the same idempotency key, so a batch that reached the server before the
+// error is not stored twice.
+func (w *EventsAPIWriter) post(batch []*telemetry.LobbySessionStateFrame) error {
+	if w.batchSize == 1 {
+		ctx, cancel := context.WithTimeout(w.ctx, 2*time.Second)
+		defer cancel()
+		_, err := w.client.StoreSessionEvent(ctx, batch[0])
+		return err
+	}
+
+	key := uuid.Must(uuid.NewV4()).String()
+	var err error
+	for attempt := 0; attempt < len(eventsRetryDelays)+1; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(eventsRetryDelays[attempt-1]):
+			case <-w.ctx.Done():
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(w.ctx, 5*time.Second)
+		var resp *api.StoreSessionEventsResponse
+		resp, err = w.client.StoreSessionEvents(ctx, batch, key)
+		cancel()
+
+		if err == nil {
+			if resp.Rejected > 0 {
+				w.logger.Warn("Server rejected frames of batch",
+					zap.Int("rejected", resp.Rejected),
+					zap.Any("errors", resp.Errors))
 			}
-			cancel()
+			return nil
+		}
+		if w.ctx.Err() != nil || !retryable(err, attempt) {
+			return err
 		}
 	}
+
+	// An earlier attempt is still being stored. Its outcome is only known
+	// once the server answers the same key with something other than 409.
+	deadline := time.Now().Add(eventsConflictTimeout)
+	for inProgress(err) && time.Now().Before(deadline) {
+		w.logger.Debug("Batch still being stored by the server", zap.String("idempotency_key", key))
+		select {
+		case <-time.After(eventsRetryDelays[len(eventsRetryDelays)-1]):
+		case <-w.ctx.Done():
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(w.ctx, 5*time.Second)
+		_, err = w.client.StoreSessionEvents(ctx, batch, key)
+		cancel()
+	}
+	return err
+}
+
+// eventsRetryDelays are the waits before each retry of a batch; the last is
+// also the interval at which a batch still being stored is polled
+var eventsRetryDelays = []time.Duration{250 * time.Millisecond, 500 * time.Millisecond, time.Second}
+
+// eventsConflictTimeout is how long a batch still being stored by the server
+// is polled for its outcome before it is given up as failed
+var eventsConflictTimeout = 30 * time.Second
+
+// retryable reports whether a batch that failed with err may be sent again:
+// after network errors and server errors, and while an earlier attempt of
+// the batch is still being processed. Rate limits and rejected requests are
+// not retried.
+func retryable(err error, attempt int) bool {
+	var statusErr *api.StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500 || (attempt > 0 && inProgress(err))
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// inProgress reports whether the server is still processing an earlier
+// request with the same idempotency key
+func inProgress(err error) bool {
+	var statusErr *api.StatusError
+	return errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusConflict
 }
 
 // Context returns the writer context.
@@ -0,0 +1,64 @@
+package agent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/echotools/nevr-agent/v4/internal/api"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+func TestEventsAPIWriter_Retries(t *testing.T) {
+	delays, timeout := eventsRetryDelays, eventsConflictTimeout
+	eventsRetryDelays = []time.Duration{time.Millisecond, time.Millisecond, time.Millisecond}
+	eventsConflictTimeout = 50 * time.Millisecond
+	defer func() { eventsRetryDelays, eventsConflictTimeout = delays, timeout }()
+
+	conflicts := make([]int, 200)
+	for i := range conflicts {
+		conflicts[i] = http.StatusConflict
+	}
+
+	tests := []struct {
+		name     string
+		statuses []int // Replies in order, then 200
+		wantSent int   // Zero to not check the number of requests
+		wantErr  bool
+	}{
+		{"stored", nil, 1, false},
+		{"server error is retried", []int{http.StatusServiceUnavailable}, 2, false},
+		{"rejected request is not retried", []int{http.StatusBadRequest}, 1, true},
+		{"conflict on a retry waits for the first attempt", []int{http.StatusBadGateway, http.StatusConflict, http.StatusConflict}, 4, false},
+		{"conflict after the retries is polled until stored", []int{http.StatusBadGateway, http.StatusConflict, http.StatusConflict, http.StatusConflict}, 5, false},
+		{"conflict after the retries fails when it persists", append([]int{http.StatusBadGateway}, conflicts...), 0, true},
+		{"conflict after the retries fails when the first attempt fails", []int{http.StatusBadGateway, http.StatusConflict, http.StatusConflict, http.StatusConflict, http.StatusInternalServerError}, 5, true},
+		{"server errors give up", []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}, 4, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sent++
+				if sent <= len(tt.statuses) {
+					http.Error(w, http.StatusText(tt.statuses[sent-1]), tt.statuses[sent-1])
+					return
+				}
+				w.Write([]byte(`{"stored":1}`))
+			}))
+			defer server.Close()
+
+			w := NewEventsAPIWriter(testLogger(t), api.ClientConfig{BaseURL: server.URL}, 2)
+			defer w.Close()
+
+			err := w.post([]*telemetry.LobbySessionStateFrame{{FrameIndex: 1}})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("post() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantSent > 0 && sent != tt.wantSent {
+				t.Errorf("sent %d requests, want %d", sent, tt.wantSent)
+			}
+		})
+	}
+}
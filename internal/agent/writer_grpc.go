@@ -0,0 +1,190 @@
+package agent
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/rpc"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// grpcReconnectDelay is the wait before reopening a failed ingest stream
+const grpcReconnectDelay = 2 * time.Second
+
+// GRPCWriter implements FrameWriter and streams frames to the API server over
+// a gRPC IngestFrames stream.
+type GRPCWriter struct {
+	logger     *zap.Logger
+	target     string
+	jwtToken   string
+	conn       *grpc.ClientConn
+	client     rpc.TelemetryServiceClient
+	ctx        context.Context
+	cancel     context.CancelFunc
+	outgoingCh chan *telemetry.LobbySessionStateFrame
+	done       chan struct{}
+	mu         sync.Mutex
+	stopped    bool
+	framesSent int64
+}
+
+// NewGRPCWriter creates a GRPCWriter for serverURL, such as
+// http://localhost:9091. https URLs use TLS with tlsConfig, which may be nil
+// to use the system roots without a client certificate.
+func NewGRPCWriter(logger *zap.Logger, serverURL, jwtToken string, tlsConfig *tls.Config) (*GRPCWriter, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gRPC URL: %w", err)
+	}
+
+	var creds credentials.TransportCredentials
+	switch u.Scheme {
+	case "https", "grpcs":
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	case "http", "grpc":
+		creds = insecure.NewCredentials()
+	default:
+		return nil, fmt.Errorf("invalid gRPC URL %q: scheme must be http or https", serverURL)
+	}
+
+	conn, err := grpc.NewClient(u.Host, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &GRPCWriter{
+		logger:     logger.With(zap.String("component", "grpc_writer")),
+		target:     u.Host,
+		jwtToken:   jwtToken,
+		conn:       conn,
+		client:     rpc.NewTelemetryServiceClient(conn),
+		ctx:        ctx,
+		cancel:     cancel,
+		outgoingCh: make(chan *telemetry.LobbySessionStateFrame, 1000),
+		done:       make(chan struct{}),
+	}
+
+	w.logger.Info("GRPCWriter initialized", zap.String("target", w.target))
+
+	go w.run()
+	return w, nil
+}
+
+// run sends queued frames on an ingest stream, reopening it after errors
+func (w *GRPCWriter) run() {
+	defer close(w.done)
+
+	for w.ctx.Err() == nil {
+		if err := w.stream(); err != nil {
+			w.logger.Warn("gRPC ingest stream failed, reconnecting", zap.Error(err))
+			select {
+			case <-w.ctx.Done():
+			case <-time.After(grpcReconnectDelay):
+			}
+		}
+	}
+}
+
+// stream opens an ingest stream and sends frames on it until the writer is
+// closed or a send fails
+func (w *GRPCWriter) stream() error {
+	// The stream outlives the writer context so that it can be closed cleanly
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if w.jwtToken != "" {
+		streamCtx = metadata.AppendToOutgoingContext(streamCtx, "authorization", "Bearer "+w.jwtToken)
+	}
+
+	stream, err := w.client.IngestFrames(streamCtx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			closeCtx, closeCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer closeCancel()
+			go func() {
+				<-closeCtx.Done()
+				cancel()
+			}()
+
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				return err
+			}
+			w.logger.Info("gRPC ingest stream closed",
+				zap.Int64("stored", resp.GetStored()),
+				zap.Int64("duplicates", resp.GetDuplicates()),
+				zap.Int64("rejected", resp.GetRejected()),
+				zap.Int64("rate_limited", resp.GetRateLimited()))
+			return nil
+
+		case frame := <-w.outgoingCh:
+			if err := stream.Send(frame); err != nil {
+				// The status of the stream explains a failed send
+				_, err = stream.CloseAndRecv()
+				return err
+			}
+			w.framesSent++
+		}
+	}
+}
+
+// Context returns the writer context.
+func (w *GRPCWriter) Context() context.Context { return w.ctx }
+
+// WriteFrame queues a frame for sending.
+func (w *GRPCWriter) WriteFrame(frame *telemetry.LobbySessionStateFrame) error {
+	if w.IsStopped() {
+		return fmt.Errorf("grpc writer is stopped")
+	}
+
+	select {
+	case w.outgoingCh <- frame:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	default:
+		// Channel full; drop frame to preserve real-time behavior.
+		w.logger.Warn("Outgoing channel full, dropping frame")
+		return fmt.Errorf("outgoing channel full")
+	}
+}
+
+// Close ends the ingest stream and closes the connection.
+func (w *GRPCWriter) Close() {
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return
+	}
+	w.stopped = true
+	w.mu.Unlock()
+
+	w.cancel()
+	<-w.done
+	w.conn.Close()
+	w.logger.Info("gRPC writer closed", zap.Int64("frames_sent", w.framesSent))
+}
+
+// IsStopped returns whether the writer is stopped.
+func (w *GRPCWriter) IsStopped() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.stopped
+}
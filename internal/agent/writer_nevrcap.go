@@ -6,13 +6,14 @@ import (
 	"sync"
 	"time"
 
-	"github.com/echotools/nevr-capture/v3/pkg/codecs"
+	"github.com/echotools/nevr-agent/v4/internal/nevrcap"
 	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // NevrCapLogSession writes frames to a .nevrcap file (zstd compressed protobuf)
+// in seekable blocks, with its seek index beside it
 type NevrCapLogSession struct {
 	sync.Mutex
 	ctx         context.Context
@@ -46,7 +47,7 @@ func NewNevrCapLogSession(ctx context.Context, logger *zap.Logger, filePath stri
 
 func (n *NevrCapLogSession) ProcessFrames() error {
 	// Create a new nevrcap writer
-	writer, err := codecs.NewNevrCapWriter(n.filePath)
+	writer, err := nevrcap.Create(n.filePath)
 	if err != nil {
 		return fmt.Errorf("failed to create nevrcap writer: %w", err)
 	}
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -21,6 +22,7 @@ type WebSocketWriter struct {
 	logger     *zap.Logger
 	socketURL  string
 	jwtToken   string
+	tlsConfig  *tls.Config
 	ctx        context.Context
 	cancel     context.CancelFunc
 	conn       *websocket.Conn
@@ -30,14 +32,16 @@ type WebSocketWriter struct {
 	connected  bool
 }
 
-// NewWebSocketWriter creates a new WebSocketWriter.
-func NewWebSocketWriter(logger *zap.Logger, socketURL, jwtToken string) *WebSocketWriter {
+// NewWebSocketWriter creates a new WebSocketWriter. tlsConfig may be nil to
+// use the system roots without a client certificate.
+func NewWebSocketWriter(logger *zap.Logger, socketURL, jwtToken string, tlsConfig *tls.Config) *WebSocketWriter {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	w := &WebSocketWriter{
 		logger:     logger.With(zap.String("component", "websocket_writer")),
 		socketURL:  socketURL,
 		jwtToken:   jwtToken,
+		tlsConfig:  tlsConfig,
 		ctx:        ctx,
 		cancel:     cancel,
 		outgoingCh: make(chan *telemetry.LobbySessionStateFrame, 1000),
@@ -75,7 +79,9 @@ func (w *WebSocketWriter) Connect() error {
 
 	w.logger.Info("Connecting to WebSocket", zap.String("url", u.String()))
 
-	conn, _, err := websocket.DefaultDialer.DialContext(w.ctx, u.String(), header)
+	dialer := *websocket.DefaultDialer
+	dialer.TLSClientConfig = w.tlsConfig
+	conn, _, err := dialer.DialContext(w.ctx, u.String(), header)
 	if err != nil {
 		return fmt.Errorf("failed to dial websocket: %w", err)
 	}
@@ -161,7 +167,11 @@ func (w *WebSocketWriter) readLoop() {
 		var response map[string]interface{}
 		if err := json.Unmarshal(message, &response); err == nil {
 			if success, ok := response["success"].(bool); ok && !success {
-				if errMsg, ok := response["error"].(string); ok {
+				if response["code"] == "rate_limited" {
+					w.logger.Warn("Server rejected frame by rate limit",
+						zap.Any("error", response["error"]),
+						zap.Any("retry_after_seconds", response["retry_after"]))
+				} else if errMsg, ok := response["error"].(string); ok {
 					w.logger.Error("Server returned error", zap.String("error", errMsg))
 				}
 			}
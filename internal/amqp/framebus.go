@@ -0,0 +1,318 @@
+package amqp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	amqplib "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	// DefaultFrameExchange is the default exchange for live frames passed
+	// between API server instances
+	DefaultFrameExchange = "match.frames"
+
+	// frameQueueLength caps the frames waiting in an instance's queue; the
+	// oldest are dropped beyond it
+	frameQueueLength = 10000
+
+	// Bounds of the delay between reconnection attempts, which doubles
+	// after each failed attempt
+	minFrameBusReconnectDelay = time.Second
+	maxFrameBusReconnectDelay = 30 * time.Second
+)
+
+// FrameBus passes live frames between API server instances through a fanout
+// exchange. Every instance consumes from its own exclusive queue, which is
+// deleted when the instance disconnects. A lost connection is re-established
+// with backoff, and the queue declared and bound again; frames published
+// meanwhile are lost.
+type FrameBus struct {
+	uri      string
+	exchange string
+	conn     *amqplib.Connection
+	channel  *amqplib.Channel
+	handle   func(body []byte) // Set by Subscribe, consumed again on reconnecting
+	mu       sync.RWMutex
+	closed   bool
+	done     chan struct{}
+	logger   Logger
+}
+
+// NewFrameBus creates a frame bus on an exchange, DefaultFrameExchange if
+// it is empty
+func NewFrameBus(uri, exchange string, logger Logger) *FrameBus {
+	if exchange == "" {
+		exchange = DefaultFrameExchange
+	}
+	if logger == nil {
+		logger = &DefaultLogger{}
+	}
+	return &FrameBus{uri: uri, exchange: exchange, logger: logger, done: make(chan struct{})}
+}
+
+// Connect establishes a connection to RabbitMQ and declares the exchange
+func (b *FrameBus) Connect(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return fmt.Errorf("frame bus is closed")
+	}
+	if err := b.dial(); err != nil {
+		return err
+	}
+
+	go b.watch(b.conn, b.channel)
+	b.logger.Info("Connected frame bus to RabbitMQ", "uri", b.uri, "exchange", b.exchange)
+	return nil
+}
+
+// dial connects to RabbitMQ and declares the exchange. b.mu must be held.
+func (b *FrameBus) dial() error {
+	conn, err := amqplib.Dial(b.uri)
+	if err != nil {
+		return fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	channel, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to open channel: %w", err)
+	}
+
+	err = channel.ExchangeDeclare(
+		b.exchange, // name
+		"fanout",   // kind
+		true,       // durable
+		false,      // auto-delete
+		false,      // internal
+		false,      // no-wait
+		nil,        // arguments
+	)
+	if err != nil {
+		channel.Close()
+		conn.Close()
+		return fmt.Errorf("failed to declare exchange: %w", err)
+	}
+
+	b.conn = conn
+	b.channel = channel
+	return nil
+}
+
+// disconnect drops the connection. b.mu must be held.
+func (b *FrameBus) disconnect() {
+	if b.conn != nil {
+		b.conn.Close()
+	}
+	b.conn = nil
+	b.channel = nil
+}
+
+// watch re-establishes the connection whenever it or its channel closes,
+// until the bus is closed
+func (b *FrameBus) watch(conn *amqplib.Connection, channel *amqplib.Channel) {
+	for {
+		connClosed := conn.NotifyClose(make(chan *amqplib.Error, 1))
+		channelClosed := channel.NotifyClose(make(chan *amqplib.Error, 1))
+
+		var reason *amqplib.Error
+		select {
+		case <-b.done:
+			return
+		case reason = <-connClosed:
+		case reason = <-channelClosed:
+		}
+
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			return
+		}
+		b.disconnect()
+		b.mu.Unlock()
+		b.logger.Error("Frame bus disconnected from RabbitMQ", "exchange", b.exchange, "reason", reason)
+
+		var ok bool
+		if conn, channel, ok = b.reconnect(); !ok {
+			return
+		}
+	}
+}
+
+// reconnect connects again with backoff, and resumes consuming if the bus
+// was subscribed. It gives up when the bus is closed.
+func (b *FrameBus) reconnect() (*amqplib.Connection, *amqplib.Channel, bool) {
+	delay := minFrameBusReconnectDelay
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-b.done:
+			return nil, nil, false
+		case <-time.After(delay):
+		}
+
+		b.mu.Lock()
+		if b.closed {
+			b.mu.Unlock()
+			return nil, nil, false
+		}
+		err := b.dial()
+		if err == nil && b.handle != nil {
+			if err = b.consume(b.handle); err != nil {
+				b.disconnect()
+			}
+		}
+		conn, channel := b.conn, b.channel
+		b.mu.Unlock()
+
+		if err == nil {
+			b.logger.Info("Frame bus reconnected to RabbitMQ", "exchange", b.exchange, "attempts", attempt)
+			return conn, channel, true
+		}
+		b.logger.Warn("Failed to reconnect frame bus", "exchange", b.exchange, "error", err, "retry_in", delay)
+		delay = min(2*delay, maxFrameBusReconnectDelay)
+	}
+}
+
+// Connected reports whether the bus is connected to RabbitMQ
+func (b *FrameBus) Connected() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return !b.closed && b.channel != nil
+}
+
+// Publish sends a message to every instance, this one included. Live frames
+// are not persisted.
+func (b *FrameBus) Publish(ctx context.Context, body []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return fmt.Errorf("frame bus is closed")
+	}
+	if b.channel == nil {
+		return fmt.Errorf("not connected to RabbitMQ")
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, DefaultPublishTimeout)
+	defer cancel()
+
+	err := b.channel.PublishWithContext(
+		publishCtx,
+		b.exchange, // exchange
+		"",         // routing key, ignored by fanout exchanges
+		false,      // mandatory
+		false,      // immediate
+		amqplib.Publishing{
+			ContentType:  "application/octet-stream",
+			Body:         body,
+			DeliveryMode: amqplib.Transient,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to publish frame: %w", err)
+	}
+	return nil
+}
+
+// Subscribe binds a queue of this instance to the exchange and passes each
+// message published by any instance to handle, in order, until the bus is
+// closed
+func (b *FrameBus) Subscribe(handle func(body []byte)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return fmt.Errorf("frame bus is closed")
+	}
+	if b.channel == nil {
+		return fmt.Errorf("not connected to RabbitMQ")
+	}
+	if err := b.consume(handle); err != nil {
+		return err
+	}
+	b.handle = handle
+	return nil
+}
+
+// consume declares and binds the queue of this instance and passes its
+// messages to handle until the channel closes. b.mu must be held.
+func (b *FrameBus) consume(handle func(body []byte)) error {
+	queue, err := b.channel.QueueDeclare(
+		"",    // name, generated by the server
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		amqplib.Table{
+			"x-max-length": int32(frameQueueLength),
+			"x-overflow":   "drop-head",
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to declare queue: %w", err)
+	}
+	if err := b.channel.QueueBind(queue.Name, "", b.exchange, false, nil); err != nil {
+		return fmt.Errorf("failed to bind queue: %w", err)
+	}
+
+	deliveries, err := b.channel.Consume(
+		queue.Name, // queue
+		"",         // consumer
+		true,       // auto-ack
+		true,       // exclusive
+		false,      // no-local
+		false,      // no-wait
+		nil,        // arguments
+	)
+	if err != nil {
+		return fmt.Errorf("failed to consume queue: %w", err)
+	}
+
+	// Deliveries end with the channel; watch consumes again on reconnecting
+	go func() {
+		for d := range deliveries {
+			handle(d.Body)
+		}
+	}()
+	return nil
+}
+
+// Close closes the AMQP connection
+func (b *FrameBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil
+	}
+	b.closed = true
+	close(b.done)
+
+	var errs []error
+
+	if b.channel != nil {
+		if err := b.channel.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close channel: %w", err))
+		}
+		b.channel = nil
+	}
+
+	if b.conn != nil {
+		if err := b.conn.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close connection: %w", err))
+		}
+		b.conn = nil
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("errors closing frame bus: %v", errs)
+	}
+
+	b.logger.Info("AMQP frame bus closed")
+	return nil
+}
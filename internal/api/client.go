@@ -2,15 +2,22 @@ package api
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/encoding/protodelim"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // Client represents a client for the session events service
@@ -19,14 +26,28 @@ type Client struct {
 	httpClient *http.Client
 	jwtToken   string
 	userAgent  string
+
+	batchFormat string
+	compression string
+	zstdEncoder *zstd.Encoder
 }
 
+// Batch encodings for StoreSessionEvents
+const (
+	BatchFormatProtobuf = "protobuf" // Length-delimited binary protobuf
+	BatchFormatNDJSON   = "ndjson"   // One protojson frame per line
+)
+
 // ClientConfig holds configuration for the session events client
 type ClientConfig struct {
 	BaseURL   string        // Base URL of the session events service (e.g., "http://localhost:8080")
 	Timeout   time.Duration // HTTP request timeout (default: 30 seconds)
 	JWTToken  string        // JWT token for authentication
 	UserAgent string        // User-Agent header value
+	TLSConfig *tls.Config   // TLS for https URLs (default: system roots, no client certificate)
+
+	BatchFormat string // Encoding of StoreSessionEvents batches: "protobuf" (default) or "ndjson"
+	Compression string // Compression of StoreSessionEvents batches: "" (none), "gzip" or "zstd"
 }
 
 // NewClient creates a new session events client
@@ -39,14 +60,28 @@ func NewClient(config ClientConfig) *Client {
 		config.UserAgent = "NEVR-Agent"
 	}
 
-	return &Client{
-		baseURL: config.BaseURL,
-		httpClient: &http.Client{
-			Timeout: config.Timeout,
-		},
-		jwtToken:  config.JWTToken,
-		userAgent: config.UserAgent,
+	httpClient := &http.Client{
+		Timeout: config.Timeout,
+	}
+	if config.TLSConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config.TLSConfig
+		httpClient.Transport = transport
+	}
+
+	c := &Client{
+		baseURL:     config.BaseURL,
+		httpClient:  httpClient,
+		jwtToken:    config.JWTToken,
+		userAgent:   config.UserAgent,
+		batchFormat: config.BatchFormat,
+		compression: config.Compression,
+	}
+	if c.compression == EncodingZstd {
+		// Without options the encoder cannot fail
+		c.zstdEncoder, _ = zstd.NewWriter(nil)
 	}
+	return c
 }
 
 // StoreSessionEventResponse represents the response from storing a session event
@@ -76,14 +111,108 @@ func (c *Client) StoreSessionEvent(ctx context.Context, event *telemetry.LobbySe
 		return nil, fmt.Errorf("failed to marshal protobuf to JSON: %w", err)
 	}
 
-	// Create request
 	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/lobby-session-events", bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	req.Header.Set("Content-Type", ContentTypeJSON)
 
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
+	body, err := c.doIngest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var response StoreSessionEventResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// deterministicDelim encodes batches the same way on every attempt, so that a
+// retry carries the body its Idempotency-Key was first used with
+var deterministicDelim = protodelim.MarshalOptions{MarshalOptions: proto.MarshalOptions{Deterministic: true}}
+
+// StoreSessionEvents stores a batch of frames with one request to the v3
+// ingest route, encoded and compressed as configured. A non-empty
+// idempotencyKey lets the request be retried without storing it twice.
+func (c *Client) StoreSessionEvents(ctx context.Context, frames []*telemetry.LobbySessionStateFrame, idempotencyKey string) (*StoreSessionEventsResponse, error) {
+	var buf bytes.Buffer
+	contentType := ContentTypeProtobufDelimited
+	switch c.batchFormat {
+	case BatchFormatNDJSON:
+		contentType = ContentTypeNDJSON
+		for _, frame := range frames {
+			data, err := protojson.Marshal(frame)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal protobuf to JSON: %w", err)
+			}
+			buf.Write(data)
+			buf.WriteByte('\n')
+		}
+	default:
+		for _, frame := range frames {
+			if _, err := deterministicDelim.MarshalTo(&buf, frame); err != nil {
+				return nil, fmt.Errorf("failed to marshal protobuf: %w", err)
+			}
+		}
+	}
+
+	data, err := c.compress(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/v3/lobby-session-events", bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if c.compression != "" {
+		req.Header.Set("Content-Encoding", c.compression)
+	}
+	if idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+	}
+
+	body, err := c.doIngest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var response StoreSessionEventsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// compress encodes a request body with the configured compression
+func (c *Client) compress(data []byte) ([]byte, error) {
+	switch c.compression {
+	case EncodingGzip:
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		if _, err := gz.Write(data); err != nil {
+			return nil, err
+		}
+		if err := gz.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case EncodingZstd:
+		return c.zstdEncoder.EncodeAll(data, nil), nil
+	default:
+		return data, nil
+	}
+}
+
+// doIngest sends an ingest request and returns the response body. Rejections
+// by a rate limit are returned as *RateLimitError.
+func (c *Client) doIngest(req *http.Request) ([]byte, error) {
 	req.Header.Set("User-Agent", c.userAgent)
 	if c.jwtToken != "" {
 		req.Header.Set("Authorization", "Bearer "+c.jwtToken)
@@ -103,17 +232,28 @@ func (c *Client) StoreSessionEvent(ctx context.Context, event *telemetry.LobbySe
 	}
 
 	// Check status code
+	if resp.StatusCode == http.StatusTooManyRequests {
+		limit, _, _ := strings.Cut(strings.TrimSpace(string(body)), " exceeded")
+		retryAfter, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+		return nil, &RateLimitError{Limit: limit, RetryAfter: time.Duration(retryAfter) * time.Second}
+	}
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("server returned error: %d %s - %s", resp.StatusCode, resp.Status, string(body))
+		return nil, &StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: string(body)}
 	}
 
-	// Parse response
-	var response StoreSessionEventResponse
-	if err := json.Unmarshal(body, &response); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-	}
+	return body, nil
+}
 
-	return &response, nil
+// StatusError is returned by ingest requests the server answered with an
+// unexpected status
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("server returned error: %d %s - %s", e.StatusCode, e.Status, e.Body)
 }
 
 // GetSessionEvents retrieves session events by match ID
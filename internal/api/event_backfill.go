@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BackfillStats holds statistics about an event backfill run
+type BackfillStats struct {
+	FramesScanned  int64
+	FramesFailed   int64
+	EventsInserted int64
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// BackfillEvents populates the typed events collection from frames already stored
+// in the session_events collection. Events that were already stored are skipped,
+// so the backfill can be re-run safely. If sessionID is empty, all sessions are processed.
+func BackfillEvents(ctx context.Context, mongoClient *mongo.Client, sessionID string, logger Logger) (*BackfillStats, error) {
+	if mongoClient == nil {
+		return nil, fmt.Errorf("mongo client is nil")
+	}
+
+	if logger == nil {
+		logger = &DefaultLogger{}
+	}
+
+	return backfillEvents(ctx, mongoClient.Database(sessionEventDatabaseName), sessionID, logger)
+}
+
+// backfillEvents implements BackfillEvents on the given database
+func backfillEvents(ctx context.Context, db *mongo.Database, sessionID string, logger Logger) (*BackfillStats, error) {
+	stats := &BackfillStats{
+		StartTime: time.Now(),
+	}
+
+	collection := db.Collection(sessionEventCollectionName)
+
+	// Only frames that carried events need to be scanned
+	filter := bson.M{"event_types.0": bson.M{"$exists": true}}
+	if sessionID != "" {
+		filter["lobby_session_id"] = sessionID
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetBatchSize(500)
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session frames: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	logger.Info("Starting event backfill", "lobby_session_id", sessionID)
+
+	batchSize := 500
+	batch := make([]*store.EventDocument, 0, batchSize)
+
+	flush := func() error {
+		inserted, err := store.UpsertEvents(ctx, db, batch)
+		if err != nil {
+			return err
+		}
+		stats.EventsInserted += inserted
+		batch = batch[:0]
+		return nil
+	}
+
+	for cursor.Next(ctx) {
+		stats.FramesScanned++
+
+		var doc SessionFrameDocument
+		if err := cursor.Decode(&doc); err != nil {
+			logger.Error("Failed to decode session frame", "error", err)
+			stats.FramesFailed++
+			continue
+		}
+
+		batch = append(batch, store.EventsFromDocument(&doc)...)
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return stats, err
+			}
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return stats, fmt.Errorf("failed to iterate session frames: %w", err)
+	}
+
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return stats, err
+		}
+	}
+
+	stats.EndTime = time.Now()
+
+	logger.Info("Event backfill completed",
+		"frames_scanned", stats.FramesScanned,
+		"frames_failed", stats.FramesFailed,
+		"events_inserted", stats.EventsInserted,
+		"duration", stats.EndTime.Sub(stats.StartTime),
+	)
+
+	return stats, nil
+}
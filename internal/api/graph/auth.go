@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errForbidden = "FORBIDDEN"
+
+// Auth authorizes GraphQL operations against the identity the HTTP request
+// was authenticated with
+type Auth interface {
+	// AuthorizeOperation returns an error unless the caller in ctx may run
+	// operations of the given type ("query", "mutation" or "subscription")
+	AuthorizeOperation(ctx context.Context, operation string) error
+
+	// Identity returns the node and user of the caller in ctx
+	Identity(ctx context.Context) (nodeID, userID string)
+}
+
+// OperationAuth rejects operations the caller is not authorized for before
+// they execute
+type OperationAuth struct {
+	Auth Auth
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = OperationAuth{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (a OperationAuth) ExtensionName() string {
+	return "OperationAuth"
+}
+
+// Validate implements graphql.HandlerExtension
+func (a OperationAuth) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (a OperationAuth) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+
+	if err := a.Auth.AuthorizeOperation(ctx, string(opCtx.Operation.Operation)); err != nil {
+		gqlErr := gqlerror.Errorf("%s", err.Error())
+		errcode.Set(gqlErr, errForbidden)
+		return gqlErr
+	}
+	return nil
+}
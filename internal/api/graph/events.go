@@ -0,0 +1,518 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+// teamRoles is the role of each team by its index in SessionResponse.Teams
+var teamRoles = []Role{RoleBlueTeam, RoleOrangeTeam, RoleSpectator}
+
+func newRole(role telemetry.Role) Role {
+	r := Role(strings.TrimPrefix(role.String(), "ROLE_"))
+	if !r.IsValid() {
+		return RoleUnspecified
+	}
+	return r
+}
+
+func newEmoteType(emote telemetry.EmotePlayed_EmoteType) EmoteType {
+	e := EmoteType(strings.TrimPrefix(emote.String(), "EMOTE_TYPE_"))
+	if !e.IsValid() {
+		return EmoteTypeUnspecified
+	}
+	return e
+}
+
+// statsSource is implemented by both apigame.PlayerStats and apigame.TeamStats
+type statsSource interface {
+	GetPossessionTime() float64
+	GetPoints() int32
+	GetSaves() int32
+	GetGoals() int32
+	GetStuns() int32
+	GetPasses() int32
+	GetCatches() int32
+	GetSteals() int32
+	GetBlocks() int32
+	GetInterceptions() int32
+	GetAssists() int32
+	GetShotsTaken() int32
+}
+
+func newStats(s statsSource) *Stats {
+	return &Stats{
+		PossessionTime: s.GetPossessionTime(),
+		Points:         int(s.GetPoints()),
+		Saves:          int(s.GetSaves()),
+		Goals:          int(s.GetGoals()),
+		Stuns:          int(s.GetStuns()),
+		Passes:         int(s.GetPasses()),
+		Catches:        int(s.GetCatches()),
+		Steals:         int(s.GetSteals()),
+		Blocks:         int(s.GetBlocks()),
+		Interceptions:  int(s.GetInterceptions()),
+		Assists:        int(s.GetAssists()),
+		ShotsTaken:     int(s.GetShotsTaken()),
+	}
+}
+
+func newPlayer(member *apigame.TeamMember, role Role) *Player {
+	return &Player{
+		Slot:          int(member.GetSlotNumber()),
+		AccountNumber: strconv.FormatUint(member.GetAccountNumber(), 10),
+		DisplayName:   member.GetDisplayName(),
+		Role:          role,
+		JerseyNumber:  int(member.GetJerseyNumber()),
+		Level:         int(member.GetLevel()),
+		Ping:          int(member.GetPing()),
+		Stats:         newStats(member.GetStats()),
+	}
+}
+
+// frameTeams returns the blue, orange and spectator teams of a frame
+func frameTeams(frame *telemetry.LobbySessionStateFrame) []*Team {
+	teams := make([]*Team, 0, len(teamRoles))
+	for i, team := range frame.GetSession().GetTeams() {
+		if i >= len(teamRoles) {
+			break
+		}
+		t := &Team{
+			Role:          teamRoles[i],
+			Name:          team.GetTeamName(),
+			HasPossession: team.GetHasPossession(),
+			Stats:         newStats(team.GetStats()),
+			Players:       make([]*Player, 0, len(team.GetPlayers())),
+		}
+		for _, member := range team.GetPlayers() {
+			t.Players = append(t.Players, newPlayer(member, t.Role))
+		}
+		teams = append(teams, t)
+	}
+	return teams
+}
+
+// framePlayers returns the players of all teams of a frame
+func framePlayers(frame *telemetry.LobbySessionStateFrame) []*Player {
+	players := []*Player{}
+	for _, team := range frameTeams(frame) {
+		players = append(players, team.Players...)
+	}
+	return players
+}
+
+// newScoreboard returns the scoreboard of a frame, or nil if it has no session state
+func newScoreboard(frame *telemetry.LobbySessionStateFrame) *Scoreboard {
+	session := frame.GetSession()
+	if session == nil {
+		return nil
+	}
+	return &Scoreboard{
+		BluePoints:       int(session.GetBluePoints()),
+		OrangePoints:     int(session.GetOrangePoints()),
+		BlueRoundScore:   int(session.GetBlueRoundScore()),
+		OrangeRoundScore: int(session.GetOrangeRoundScore()),
+		TotalRoundCount:  int(session.GetTotalRoundCount()),
+		GameClock:        session.GetGameClock(),
+		GameClockDisplay: session.GetGameClockDisplay(),
+		GameStatus:       session.GetGameStatus(),
+	}
+}
+
+// eventFilter validates event type names (e.g. "goal_scored") and returns
+// them as a set, empty if no types are given
+func eventFilter(types []string) (map[string]bool, error) {
+	if _, err := store.FrameEventTypes(types); err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(types))
+	for _, t := range types {
+		wanted[t] = true
+	}
+	return wanted, nil
+}
+
+// frameEvents converts the events of a frame, keeping only the wanted types
+// if any are given. Players are resolved against the frame's roster.
+func frameEvents(matchID string, frame *telemetry.LobbySessionStateFrame, wanted map[string]bool) []MatchEvent {
+	if len(frame.GetEvents()) == 0 {
+		return nil
+	}
+
+	roster := frameRoster(frame)
+
+	var timestamp time.Time
+	if frame.GetTimestamp() != nil {
+		timestamp = frame.GetTimestamp().AsTime()
+	}
+
+	var events []MatchEvent
+	for i, evt := range frame.GetEvents() {
+		eventType := store.EventType(evt)
+		if eventType == "" || len(wanted) > 0 && !wanted[eventType] {
+			continue
+		}
+		base := eventBase{
+			MatchID:    matchID,
+			FrameIndex: int(frame.GetFrameIndex()),
+			EventIndex: i,
+			Timestamp:  timestamp,
+		}
+		if event := newMatchEvent(base, evt, roster); event != nil {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// frameRoster returns the players of a frame by slot
+func frameRoster(frame *telemetry.LobbySessionStateFrame) map[int32]*Player {
+	roster := make(map[int32]*Player)
+	for _, player := range framePlayers(frame) {
+		roster[int32(player.Slot)] = player
+	}
+	return roster
+}
+
+// eventBase holds the fields shared by all match events
+type eventBase struct {
+	MatchID    string
+	FrameIndex int
+	EventIndex int
+	Timestamp  time.Time
+}
+
+// newMatchEvent converts a telemetry event to its union member
+func newMatchEvent(b eventBase, evt *telemetry.LobbySessionEvent, roster map[int32]*Player) MatchEvent {
+	switch e := evt.GetEvent().(type) {
+	case *telemetry.LobbySessionEvent_RoundStarted:
+		return &RoundStarted{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			RoundNumber: int(e.RoundStarted.GetRoundNumber()),
+		}
+	case *telemetry.LobbySessionEvent_RoundPaused:
+		return &RoundPaused{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			Pause: newPauseState(e.RoundPaused.GetPauseState()),
+		}
+	case *telemetry.LobbySessionEvent_RoundUnpaused:
+		return &RoundUnpaused{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			Pause: newPauseState(e.RoundUnpaused.GetPauseState()),
+		}
+	case *telemetry.LobbySessionEvent_RoundEnded:
+		return &RoundEnded{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			RoundNumber: int(e.RoundEnded.GetRoundNumber()),
+			WinningTeam: newRole(e.RoundEnded.GetWinningTeam()),
+		}
+	case *telemetry.LobbySessionEvent_MatchEnded:
+		return &MatchEnded{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			WinningTeam: newRole(e.MatchEnded.GetWinningTeam()),
+		}
+	case *telemetry.LobbySessionEvent_ScoreboardUpdated:
+		s := e.ScoreboardUpdated
+		return &ScoreboardUpdated{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			BluePoints:       int(s.GetBluePoints()),
+			OrangePoints:     int(s.GetOrangePoints()),
+			BlueRoundScore:   int(s.GetBlueRoundScore()),
+			OrangeRoundScore: int(s.GetOrangeRoundScore()),
+			GameClockDisplay: s.GetGameClockDisplay(),
+		}
+	case *telemetry.LobbySessionEvent_PlayerJoined:
+		role := newRole(e.PlayerJoined.GetRole())
+		return &PlayerJoined{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			Player: newPlayer(e.PlayerJoined.GetPlayer(), role),
+			Role:   role,
+		}
+	case *telemetry.LobbySessionEvent_PlayerLeft:
+		return &PlayerLeft{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:  int(e.PlayerLeft.GetPlayerSlot()),
+			DisplayName: e.PlayerLeft.GetDisplayName(),
+		}
+	case *telemetry.LobbySessionEvent_PlayerSwitchedTeam:
+		s := e.PlayerSwitchedTeam
+		return &PlayerSwitchedTeam{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(s.GetPlayerSlot()),
+			Player:     roster[s.GetPlayerSlot()],
+			NewRole:    newRole(s.GetNewRole()),
+			PrevRole:   newRole(s.GetPrevRole()),
+		}
+	case *telemetry.LobbySessionEvent_EmotePlayed:
+		return &EmotePlayed{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(e.EmotePlayed.GetPlayerSlot()),
+			Player:     roster[e.EmotePlayed.GetPlayerSlot()],
+			Emote:      newEmoteType(e.EmotePlayed.GetEmote()),
+		}
+	case *telemetry.LobbySessionEvent_DiscPossessionChanged:
+		s := e.DiscPossessionChanged
+		return &DiscPossessionChanged{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:         int(s.GetPlayerSlot()),
+			Player:             roster[s.GetPlayerSlot()],
+			PreviousPlayerSlot: int(s.GetPreviousPlayerSlot()),
+			PreviousPlayer:     roster[s.GetPreviousPlayerSlot()],
+		}
+	case *telemetry.LobbySessionEvent_DiscThrown:
+		return &DiscThrown{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:   int(e.DiscThrown.GetPlayerSlot()),
+			Player:       roster[e.DiscThrown.GetPlayerSlot()],
+			ThrowDetails: newThrowDetails(e.DiscThrown.GetThrowDetails()),
+		}
+	case *telemetry.LobbySessionEvent_DiscCaught:
+		return &DiscCaught{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(e.DiscCaught.GetPlayerSlot()),
+			Player:     roster[e.DiscCaught.GetPlayerSlot()],
+		}
+	case *telemetry.LobbySessionEvent_GoalScored:
+		return &GoalScored{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			ScoreDetails: newScoreDetails(e.GoalScored.GetScoreDetails()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerGoal:
+		s := e.PlayerGoal
+		return &PlayerGoal{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(s.GetPlayerSlot()),
+			Player:     roster[s.GetPlayerSlot()],
+			TotalGoals: int(s.GetTotalGoals()),
+			Points:     int(s.GetPoints()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerSave:
+		s := e.PlayerSave
+		return &PlayerSave{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(s.GetPlayerSlot()),
+			Player:     roster[s.GetPlayerSlot()],
+			TotalSaves: int(s.GetTotalSaves()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerStun:
+		s := e.PlayerStun
+		return &PlayerStun{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(s.GetPlayerSlot()),
+			Player:     roster[s.GetPlayerSlot()],
+			TotalStuns: int(s.GetTotalStuns()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerPass:
+		s := e.PlayerPass
+		return &PlayerPass{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:  int(s.GetPlayerSlot()),
+			Player:      roster[s.GetPlayerSlot()],
+			TotalPasses: int(s.GetTotalPasses()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerSteal:
+		s := e.PlayerSteal
+		return &PlayerSteal{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:  int(s.GetPlayerSlot()),
+			Player:      roster[s.GetPlayerSlot()],
+			TotalSteals: int(s.GetTotalSteals()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerBlock:
+		s := e.PlayerBlock
+		return &PlayerBlock{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:  int(s.GetPlayerSlot()),
+			Player:      roster[s.GetPlayerSlot()],
+			TotalBlocks: int(s.GetTotalBlocks()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerInterception:
+		s := e.PlayerInterception
+		return &PlayerInterception{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:         int(s.GetPlayerSlot()),
+			Player:             roster[s.GetPlayerSlot()],
+			TotalInterceptions: int(s.GetTotalInterceptions()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerAssist:
+		s := e.PlayerAssist
+		return &PlayerAssist{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot:   int(s.GetPlayerSlot()),
+			Player:       roster[s.GetPlayerSlot()],
+			TotalAssists: int(s.GetTotalAssists()),
+		}
+	case *telemetry.LobbySessionEvent_PlayerShotTaken:
+		s := e.PlayerShotTaken
+		return &PlayerShotTaken{
+			MatchID: b.MatchID, FrameIndex: b.FrameIndex, EventIndex: b.EventIndex, Timestamp: b.Timestamp,
+			PlayerSlot: int(s.GetPlayerSlot()),
+			Player:     roster[s.GetPlayerSlot()],
+			TotalShots: int(s.GetTotalShots()),
+		}
+	default:
+		return nil
+	}
+}
+
+func newPauseState(p *apigame.PauseState) *PauseState {
+	if p == nil {
+		return nil
+	}
+	return &PauseState{
+		PausedState:         p.GetPausedState(),
+		UnpausedTeam:        p.GetUnpausedTeam(),
+		PausedRequestedTeam: p.GetPausedRequestedTeam(),
+		UnpausedTimer:       p.GetUnpausedTimer(),
+		PausedTimer:         p.GetPausedTimer(),
+	}
+}
+
+func newThrowDetails(t *apigame.LastThrowInfo) *ThrowDetails {
+	if t == nil {
+		return nil
+	}
+	return &ThrowDetails{
+		ArmSpeed:                t.GetArmSpeed(),
+		TotalSpeed:              t.GetTotalSpeed(),
+		OffAxisSpinDeg:          t.GetOffAxisSpinDeg(),
+		WristThrowPenalty:       t.GetWristThrowPenalty(),
+		RotPerSec:               t.GetRotPerSec(),
+		PotSpeedFromRot:         t.GetPotSpeedFromRot(),
+		SpeedFromArm:            t.GetSpeedFromArm(),
+		SpeedFromMovement:       t.GetSpeedFromMovement(),
+		SpeedFromWrist:          t.GetSpeedFromWrist(),
+		WristAlignToThrowDeg:    t.GetWristAlignToThrowDeg(),
+		ThrowAlignToMovementDeg: t.GetThrowAlignToMovementDeg(),
+		OffAxisPenalty:          t.GetOffAxisPenalty(),
+		ThrowMovePenalty:        t.GetThrowMovePenalty(),
+	}
+}
+
+func newScoreDetails(s *apigame.LastScore) *ScoreDetails {
+	if s == nil {
+		return nil
+	}
+	return &ScoreDetails{
+		DiscSpeed:      s.GetDiscSpeed(),
+		Team:           s.GetTeam(),
+		GoalType:       s.GetGoalType(),
+		PointAmount:    int(s.GetPointAmount()),
+		DistanceThrown: s.GetDistanceThrown(),
+		PersonScored:   s.GetPersonScored(),
+		AssistScored:   s.GetAssistScored(),
+	}
+}
+
+// roundsFromEvents builds the rounds of a match from its round start and end
+// events, in order. A round ending without a recorded start has no start fields.
+func roundsFromEvents(events []MatchEvent) []*Round {
+	rounds := []*Round{}
+	for _, event := range events {
+		switch e := event.(type) {
+		case *RoundStarted:
+			startFrameIndex, startedAt := e.FrameIndex, e.Timestamp
+			rounds = append(rounds, &Round{
+				Number:          e.RoundNumber,
+				StartFrameIndex: &startFrameIndex,
+				StartedAt:       &startedAt,
+			})
+		case *RoundEnded:
+			var round *Round
+			if n := len(rounds); n > 0 && rounds[n-1].Number == e.RoundNumber && rounds[n-1].EndedAt == nil {
+				round = rounds[n-1]
+			} else {
+				round = &Round{Number: e.RoundNumber}
+				rounds = append(rounds, round)
+			}
+			endFrameIndex, endedAt, winningTeam := e.FrameIndex, e.Timestamp, e.WinningTeam
+			round.EndFrameIndex = &endFrameIndex
+			round.EndedAt = &endedAt
+			round.WinningTeam = &winningTeam
+		}
+	}
+	return rounds
+}
+
+// latestFrame returns the last stored frame of a session, or nil if it has none
+func (r *Resolver) latestFrame(ctx context.Context, session *LobbySession) (*telemetry.LobbySessionStateFrame, error) {
+	doc, err := r.Repository.LatestFrame(ctx, session.LobbySessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest frame: %w", err)
+	}
+	if doc == nil {
+		return nil, nil
+	}
+	return doc.Frame, nil
+}
+
+// eventsPage returns a page of the stored events of a session in order, only
+// the given types if any. Only the frames carrying the page are loaded.
+func (r *Resolver) eventsPage(ctx context.Context, lobbySessionID string, types []string, limit, offset int) ([]MatchEvent, error) {
+	if _, err := eventFilter(types); err != nil {
+		return nil, err
+	}
+
+	page, err := r.Repository.EventsPage(ctx, lobbySessionID, types, int64(limit), int64(offset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session events: %w", err)
+	}
+
+	events := make([]MatchEvent, 0, len(page))
+	rosters := make(map[*store.SessionFrameDocument]map[int32]*Player)
+	for _, stored := range page {
+		// Events whose frame was deleted since cannot be converted
+		if stored.Frame == nil || stored.Event.EventIndex >= len(stored.Frame.Frame.GetEvents()) {
+			continue
+		}
+		frame := stored.Frame.Frame
+
+		roster, ok := rosters[stored.Frame]
+		if !ok {
+			roster = frameRoster(frame)
+			rosters[stored.Frame] = roster
+		}
+
+		base := eventBase{
+			MatchID:    lobbySessionID,
+			FrameIndex: int(stored.Event.FrameIndex),
+			EventIndex: stored.Event.EventIndex,
+			Timestamp:  stored.Event.Timestamp,
+		}
+		if event := newMatchEvent(base, frame.GetEvents()[stored.Event.EventIndex], roster); event != nil {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// sessionEvents returns the stored events of a session in order, only the
+// given types if any
+func (r *Resolver) sessionEvents(ctx context.Context, lobbySessionID string, types []string) ([]MatchEvent, error) {
+	wanted, err := eventFilter(types)
+	if err != nil {
+		return nil, err
+	}
+	indexed, err := store.FrameEventTypes(types)
+	if err != nil {
+		return nil, err
+	}
+
+	frames, err := r.Repository.EventFrames(ctx, lobbySessionID, indexed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session events: %w", err)
+	}
+
+	events := []MatchEvent{}
+	for _, doc := range frames {
+		events = append(events, frameEvents(lobbySessionID, doc.Frame, wanted)...)
+	}
+	return events, nil
+}
@@ -0,0 +1,17774 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/introspection"
+	gqlparser "github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// NewExecutableSchema creates an ExecutableSchema from the ResolverRoot interface.
+func NewExecutableSchema(cfg Config) graphql.ExecutableSchema {
+	return &executableSchema{
+		schema:     cfg.Schema,
+		resolvers:  cfg.Resolvers,
+		directives: cfg.Directives,
+		complexity: cfg.Complexity,
+	}
+}
+
+type Config struct {
+	Schema     *ast.Schema
+	Resolvers  ResolverRoot
+	Directives DirectiveRoot
+	Complexity ComplexityRoot
+}
+
+type ResolverRoot interface {
+	LobbySession() LobbySessionResolver
+	Mutation() MutationResolver
+	Query() QueryResolver
+	SessionEvent() SessionEventResolver
+	Subscription() SubscriptionResolver
+}
+
+type DirectiveRoot struct {
+}
+
+type ComplexityRoot struct {
+	DiscCaught struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	DiscPossessionChanged struct {
+		EventIndex         func(childComplexity int) int
+		FrameIndex         func(childComplexity int) int
+		MatchID            func(childComplexity int) int
+		Player             func(childComplexity int) int
+		PlayerSlot         func(childComplexity int) int
+		PreviousPlayer     func(childComplexity int) int
+		PreviousPlayerSlot func(childComplexity int) int
+		Timestamp          func(childComplexity int) int
+	}
+
+	DiscThrown struct {
+		EventIndex   func(childComplexity int) int
+		FrameIndex   func(childComplexity int) int
+		MatchID      func(childComplexity int) int
+		Player       func(childComplexity int) int
+		PlayerSlot   func(childComplexity int) int
+		ThrowDetails func(childComplexity int) int
+		Timestamp    func(childComplexity int) int
+	}
+
+	EmotePlayed struct {
+		Emote      func(childComplexity int) int
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	GoalScored struct {
+		EventIndex   func(childComplexity int) int
+		FrameIndex   func(childComplexity int) int
+		MatchID      func(childComplexity int) int
+		ScoreDetails func(childComplexity int) int
+		Timestamp    func(childComplexity int) int
+	}
+
+	HealthStatus struct {
+		Database  func(childComplexity int) int
+		Status    func(childComplexity int) int
+		Timestamp func(childComplexity int) int
+	}
+
+	LiveMatch struct {
+		GameStatus  func(childComplexity int) int
+		LastFrameAt func(childComplexity int) int
+		MapName     func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		MatchType   func(childComplexity int) int
+		StartedAt   func(childComplexity int) int
+	}
+
+	LobbySession struct {
+		CreatedAt      func(childComplexity int) int
+		Events         func(childComplexity int, types []string, limit *int, offset *int) int
+		Frames         func(childComplexity int, limit *int, offset *int) int
+		ID             func(childComplexity int) int
+		LobbySessionID func(childComplexity int) int
+		Players        func(childComplexity int) int
+		Rounds         func(childComplexity int) int
+		Scoreboard     func(childComplexity int) int
+		Teams          func(childComplexity int) int
+		TotalEvents    func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+	}
+
+	MatchEnded struct {
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		WinningTeam func(childComplexity int) int
+	}
+
+	MatchFrame struct {
+		FrameData  func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	Mutation struct {
+		StoreSessionEvent func(childComplexity int, input StoreSessionEventInput) int
+	}
+
+	PageInfo struct {
+		EndCursor       func(childComplexity int) int
+		HasNextPage     func(childComplexity int) int
+		HasPreviousPage func(childComplexity int) int
+		StartCursor     func(childComplexity int) int
+	}
+
+	PauseState struct {
+		PausedRequestedTeam func(childComplexity int) int
+		PausedState         func(childComplexity int) int
+		PausedTimer         func(childComplexity int) int
+		UnpausedTeam        func(childComplexity int) int
+		UnpausedTimer       func(childComplexity int) int
+	}
+
+	Player struct {
+		AccountNumber func(childComplexity int) int
+		DisplayName   func(childComplexity int) int
+		JerseyNumber  func(childComplexity int) int
+		Level         func(childComplexity int) int
+		Ping          func(childComplexity int) int
+		Role          func(childComplexity int) int
+		Slot          func(childComplexity int) int
+		Stats         func(childComplexity int) int
+	}
+
+	PlayerAssist struct {
+		EventIndex   func(childComplexity int) int
+		FrameIndex   func(childComplexity int) int
+		MatchID      func(childComplexity int) int
+		Player       func(childComplexity int) int
+		PlayerSlot   func(childComplexity int) int
+		Timestamp    func(childComplexity int) int
+		TotalAssists func(childComplexity int) int
+	}
+
+	PlayerBlock struct {
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		Player      func(childComplexity int) int
+		PlayerSlot  func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		TotalBlocks func(childComplexity int) int
+	}
+
+	PlayerGoal struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		Points     func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		TotalGoals func(childComplexity int) int
+	}
+
+	PlayerInterception struct {
+		EventIndex         func(childComplexity int) int
+		FrameIndex         func(childComplexity int) int
+		MatchID            func(childComplexity int) int
+		Player             func(childComplexity int) int
+		PlayerSlot         func(childComplexity int) int
+		Timestamp          func(childComplexity int) int
+		TotalInterceptions func(childComplexity int) int
+	}
+
+	PlayerJoined struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		Role       func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	PlayerLeft struct {
+		DisplayName func(childComplexity int) int
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		PlayerSlot  func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+	}
+
+	PlayerPass struct {
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		Player      func(childComplexity int) int
+		PlayerSlot  func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		TotalPasses func(childComplexity int) int
+	}
+
+	PlayerSave struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		TotalSaves func(childComplexity int) int
+	}
+
+	PlayerShotTaken struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		TotalShots func(childComplexity int) int
+	}
+
+	PlayerSteal struct {
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		Player      func(childComplexity int) int
+		PlayerSlot  func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		TotalSteals func(childComplexity int) int
+	}
+
+	PlayerStun struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+		TotalStuns func(childComplexity int) int
+	}
+
+	PlayerSwitchedTeam struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		NewRole    func(childComplexity int) int
+		Player     func(childComplexity int) int
+		PlayerSlot func(childComplexity int) int
+		PrevRole   func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	Query struct {
+		Health        func(childComplexity int) int
+		LobbySession  func(childComplexity int, id string) int
+		SessionEvents func(childComplexity int, lobbySessionID string, limit *int, offset *int) int
+	}
+
+	Round struct {
+		EndFrameIndex   func(childComplexity int) int
+		EndedAt         func(childComplexity int) int
+		Number          func(childComplexity int) int
+		StartFrameIndex func(childComplexity int) int
+		StartedAt       func(childComplexity int) int
+		WinningTeam     func(childComplexity int) int
+	}
+
+	RoundEnded struct {
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		RoundNumber func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+		WinningTeam func(childComplexity int) int
+	}
+
+	RoundPaused struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Pause      func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	RoundStarted struct {
+		EventIndex  func(childComplexity int) int
+		FrameIndex  func(childComplexity int) int
+		MatchID     func(childComplexity int) int
+		RoundNumber func(childComplexity int) int
+		Timestamp   func(childComplexity int) int
+	}
+
+	RoundUnpaused struct {
+		EventIndex func(childComplexity int) int
+		FrameIndex func(childComplexity int) int
+		MatchID    func(childComplexity int) int
+		Pause      func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
+	ScoreDetails struct {
+		AssistScored   func(childComplexity int) int
+		DiscSpeed      func(childComplexity int) int
+		DistanceThrown func(childComplexity int) int
+		GoalType       func(childComplexity int) int
+		PersonScored   func(childComplexity int) int
+		PointAmount    func(childComplexity int) int
+		Team           func(childComplexity int) int
+	}
+
+	Scoreboard struct {
+		BluePoints       func(childComplexity int) int
+		BlueRoundScore   func(childComplexity int) int
+		GameClock        func(childComplexity int) int
+		GameClockDisplay func(childComplexity int) int
+		GameStatus       func(childComplexity int) int
+		OrangePoints     func(childComplexity int) int
+		OrangeRoundScore func(childComplexity int) int
+		TotalRoundCount  func(childComplexity int) int
+	}
+
+	ScoreboardUpdated struct {
+		BluePoints       func(childComplexity int) int
+		BlueRoundScore   func(childComplexity int) int
+		EventIndex       func(childComplexity int) int
+		FrameIndex       func(childComplexity int) int
+		GameClockDisplay func(childComplexity int) int
+		MatchID          func(childComplexity int) int
+		OrangePoints     func(childComplexity int) int
+		OrangeRoundScore func(childComplexity int) int
+		Timestamp        func(childComplexity int) int
+	}
+
+	SessionEvent struct {
+		CreatedAt      func(childComplexity int) int
+		Events         func(childComplexity int, types []string) int
+		FrameData      func(childComplexity int) int
+		FrameIndex     func(childComplexity int) int
+		ID             func(childComplexity int) int
+		LobbySessionID func(childComplexity int) int
+		Players        func(childComplexity int) int
+		Scoreboard     func(childComplexity int) int
+		Teams          func(childComplexity int) int
+		Timestamp      func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		UserID         func(childComplexity int) int
+	}
+
+	SessionEventConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	SessionEventEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
+	Stats struct {
+		Assists        func(childComplexity int) int
+		Blocks         func(childComplexity int) int
+		Catches        func(childComplexity int) int
+		Goals          func(childComplexity int) int
+		Interceptions  func(childComplexity int) int
+		Passes         func(childComplexity int) int
+		Points         func(childComplexity int) int
+		PossessionTime func(childComplexity int) int
+		Saves          func(childComplexity int) int
+		ShotsTaken     func(childComplexity int) int
+		Steals         func(childComplexity int) int
+		Stuns          func(childComplexity int) int
+	}
+
+	StoreSessionEventPayload struct {
+		Duplicate func(childComplexity int) int
+		Error     func(childComplexity int) int
+		Event     func(childComplexity int) int
+		Success   func(childComplexity int) int
+	}
+
+	Subscription struct {
+		LiveMatches func(childComplexity int) int
+		MatchEvents func(childComplexity int, matchID string, types []string) int
+		MatchFrames func(childComplexity int, matchID string, fps *int) int
+	}
+
+	Team struct {
+		HasPossession func(childComplexity int) int
+		Name          func(childComplexity int) int
+		Players       func(childComplexity int) int
+		Role          func(childComplexity int) int
+		Stats         func(childComplexity int) int
+	}
+
+	ThrowDetails struct {
+		ArmSpeed                func(childComplexity int) int
+		OffAxisPenalty          func(childComplexity int) int
+		OffAxisSpinDeg          func(childComplexity int) int
+		PotSpeedFromRot         func(childComplexity int) int
+		RotPerSec               func(childComplexity int) int
+		SpeedFromArm            func(childComplexity int) int
+		SpeedFromMovement       func(childComplexity int) int
+		SpeedFromWrist          func(childComplexity int) int
+		ThrowAlignToMovementDeg func(childComplexity int) int
+		ThrowMovePenalty        func(childComplexity int) int
+		TotalSpeed              func(childComplexity int) int
+		WristAlignToThrowDeg    func(childComplexity int) int
+		WristThrowPenalty       func(childComplexity int) int
+	}
+}
+
+type LobbySessionResolver interface {
+	Frames(ctx context.Context, obj *LobbySession, limit *int, offset *int) (*SessionEventConnection, error)
+	Events(ctx context.Context, obj *LobbySession, types []string, limit *int, offset *int) ([]MatchEvent, error)
+	Players(ctx context.Context, obj *LobbySession) ([]*Player, error)
+	Teams(ctx context.Context, obj *LobbySession) ([]*Team, error)
+	Scoreboard(ctx context.Context, obj *LobbySession) (*Scoreboard, error)
+	Rounds(ctx context.Context, obj *LobbySession) ([]*Round, error)
+}
+type MutationResolver interface {
+	StoreSessionEvent(ctx context.Context, input StoreSessionEventInput) (*StoreSessionEventPayload, error)
+}
+type QueryResolver interface {
+	LobbySession(ctx context.Context, id string) (*LobbySession, error)
+	SessionEvents(ctx context.Context, lobbySessionID string, limit *int, offset *int) (*SessionEventConnection, error)
+	Health(ctx context.Context) (*HealthStatus, error)
+}
+type SessionEventResolver interface {
+	Players(ctx context.Context, obj *SessionEvent) ([]*Player, error)
+	Teams(ctx context.Context, obj *SessionEvent) ([]*Team, error)
+	Scoreboard(ctx context.Context, obj *SessionEvent) (*Scoreboard, error)
+	Events(ctx context.Context, obj *SessionEvent, types []string) ([]MatchEvent, error)
+}
+type SubscriptionResolver interface {
+	MatchFrames(ctx context.Context, matchID string, fps *int) (<-chan *MatchFrame, error)
+	MatchEvents(ctx context.Context, matchID string, types []string) (<-chan MatchEvent, error)
+	LiveMatches(ctx context.Context) (<-chan []*LiveMatch, error)
+}
+
+type executableSchema struct {
+	schema     *ast.Schema
+	resolvers  ResolverRoot
+	directives DirectiveRoot
+	complexity ComplexityRoot
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	if e.schema != nil {
+		return e.schema
+	}
+	return parsedSchema
+}
+
+func (e *executableSchema) Complexity(ctx context.Context, typeName, field string, childComplexity int, rawArgs map[string]any) (int, bool) {
+	ec := executionContext{nil, e, 0, 0, nil}
+	_ = ec
+	switch typeName + "." + field {
+
+	case "DiscCaught.eventIndex":
+		if e.complexity.DiscCaught.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.DiscCaught.EventIndex(childComplexity), true
+	case "DiscCaught.frameIndex":
+		if e.complexity.DiscCaught.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.DiscCaught.FrameIndex(childComplexity), true
+	case "DiscCaught.matchId":
+		if e.complexity.DiscCaught.MatchID == nil {
+			break
+		}
+
+		return e.complexity.DiscCaught.MatchID(childComplexity), true
+	case "DiscCaught.player":
+		if e.complexity.DiscCaught.Player == nil {
+			break
+		}
+
+		return e.complexity.DiscCaught.Player(childComplexity), true
+	case "DiscCaught.playerSlot":
+		if e.complexity.DiscCaught.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.DiscCaught.PlayerSlot(childComplexity), true
+	case "DiscCaught.timestamp":
+		if e.complexity.DiscCaught.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.DiscCaught.Timestamp(childComplexity), true
+
+	case "DiscPossessionChanged.eventIndex":
+		if e.complexity.DiscPossessionChanged.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.EventIndex(childComplexity), true
+	case "DiscPossessionChanged.frameIndex":
+		if e.complexity.DiscPossessionChanged.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.FrameIndex(childComplexity), true
+	case "DiscPossessionChanged.matchId":
+		if e.complexity.DiscPossessionChanged.MatchID == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.MatchID(childComplexity), true
+	case "DiscPossessionChanged.player":
+		if e.complexity.DiscPossessionChanged.Player == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.Player(childComplexity), true
+	case "DiscPossessionChanged.playerSlot":
+		if e.complexity.DiscPossessionChanged.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.PlayerSlot(childComplexity), true
+	case "DiscPossessionChanged.previousPlayer":
+		if e.complexity.DiscPossessionChanged.PreviousPlayer == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.PreviousPlayer(childComplexity), true
+	case "DiscPossessionChanged.previousPlayerSlot":
+		if e.complexity.DiscPossessionChanged.PreviousPlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.PreviousPlayerSlot(childComplexity), true
+	case "DiscPossessionChanged.timestamp":
+		if e.complexity.DiscPossessionChanged.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.DiscPossessionChanged.Timestamp(childComplexity), true
+
+	case "DiscThrown.eventIndex":
+		if e.complexity.DiscThrown.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.EventIndex(childComplexity), true
+	case "DiscThrown.frameIndex":
+		if e.complexity.DiscThrown.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.FrameIndex(childComplexity), true
+	case "DiscThrown.matchId":
+		if e.complexity.DiscThrown.MatchID == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.MatchID(childComplexity), true
+	case "DiscThrown.player":
+		if e.complexity.DiscThrown.Player == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.Player(childComplexity), true
+	case "DiscThrown.playerSlot":
+		if e.complexity.DiscThrown.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.PlayerSlot(childComplexity), true
+	case "DiscThrown.throwDetails":
+		if e.complexity.DiscThrown.ThrowDetails == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.ThrowDetails(childComplexity), true
+	case "DiscThrown.timestamp":
+		if e.complexity.DiscThrown.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.DiscThrown.Timestamp(childComplexity), true
+
+	case "EmotePlayed.emote":
+		if e.complexity.EmotePlayed.Emote == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.Emote(childComplexity), true
+	case "EmotePlayed.eventIndex":
+		if e.complexity.EmotePlayed.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.EventIndex(childComplexity), true
+	case "EmotePlayed.frameIndex":
+		if e.complexity.EmotePlayed.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.FrameIndex(childComplexity), true
+	case "EmotePlayed.matchId":
+		if e.complexity.EmotePlayed.MatchID == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.MatchID(childComplexity), true
+	case "EmotePlayed.player":
+		if e.complexity.EmotePlayed.Player == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.Player(childComplexity), true
+	case "EmotePlayed.playerSlot":
+		if e.complexity.EmotePlayed.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.PlayerSlot(childComplexity), true
+	case "EmotePlayed.timestamp":
+		if e.complexity.EmotePlayed.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.EmotePlayed.Timestamp(childComplexity), true
+
+	case "GoalScored.eventIndex":
+		if e.complexity.GoalScored.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.GoalScored.EventIndex(childComplexity), true
+	case "GoalScored.frameIndex":
+		if e.complexity.GoalScored.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.GoalScored.FrameIndex(childComplexity), true
+	case "GoalScored.matchId":
+		if e.complexity.GoalScored.MatchID == nil {
+			break
+		}
+
+		return e.complexity.GoalScored.MatchID(childComplexity), true
+	case "GoalScored.scoreDetails":
+		if e.complexity.GoalScored.ScoreDetails == nil {
+			break
+		}
+
+		return e.complexity.GoalScored.ScoreDetails(childComplexity), true
+	case "GoalScored.timestamp":
+		if e.complexity.GoalScored.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.GoalScored.Timestamp(childComplexity), true
+
+	case "HealthStatus.database":
+		if e.complexity.HealthStatus.Database == nil {
+			break
+		}
+
+		return e.complexity.HealthStatus.Database(childComplexity), true
+	case "HealthStatus.status":
+		if e.complexity.HealthStatus.Status == nil {
+			break
+		}
+
+		return e.complexity.HealthStatus.Status(childComplexity), true
+	case "HealthStatus.timestamp":
+		if e.complexity.HealthStatus.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.HealthStatus.Timestamp(childComplexity), true
+
+	case "LiveMatch.gameStatus":
+		if e.complexity.LiveMatch.GameStatus == nil {
+			break
+		}
+
+		return e.complexity.LiveMatch.GameStatus(childComplexity), true
+	case "LiveMatch.lastFrameAt":
+		if e.complexity.LiveMatch.LastFrameAt == nil {
+			break
+		}
+
+		return e.complexity.LiveMatch.LastFrameAt(childComplexity), true
+	case "LiveMatch.mapName":
+		if e.complexity.LiveMatch.MapName == nil {
+			break
+		}
+
+		return e.complexity.LiveMatch.MapName(childComplexity), true
+	case "LiveMatch.matchId":
+		if e.complexity.LiveMatch.MatchID == nil {
+			break
+		}
+
+		return e.complexity.LiveMatch.MatchID(childComplexity), true
+	case "LiveMatch.matchType":
+		if e.complexity.LiveMatch.MatchType == nil {
+			break
+		}
+
+		return e.complexity.LiveMatch.MatchType(childComplexity), true
+	case "LiveMatch.startedAt":
+		if e.complexity.LiveMatch.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.LiveMatch.StartedAt(childComplexity), true
+
+	case "LobbySession.createdAt":
+		if e.complexity.LobbySession.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.CreatedAt(childComplexity), true
+	case "LobbySession.events":
+		if e.complexity.LobbySession.Events == nil {
+			break
+		}
+
+		args, err := ec.field_LobbySession_events_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LobbySession.Events(childComplexity, args["types"].([]string), args["limit"].(*int), args["offset"].(*int)), true
+	case "LobbySession.frames":
+		if e.complexity.LobbySession.Frames == nil {
+			break
+		}
+
+		args, err := ec.field_LobbySession_frames_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LobbySession.Frames(childComplexity, args["limit"].(*int), args["offset"].(*int)), true
+	case "LobbySession.id":
+		if e.complexity.LobbySession.ID == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.ID(childComplexity), true
+	case "LobbySession.lobbySessionId":
+		if e.complexity.LobbySession.LobbySessionID == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.LobbySessionID(childComplexity), true
+	case "LobbySession.players":
+		if e.complexity.LobbySession.Players == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.Players(childComplexity), true
+	case "LobbySession.rounds":
+		if e.complexity.LobbySession.Rounds == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.Rounds(childComplexity), true
+	case "LobbySession.scoreboard":
+		if e.complexity.LobbySession.Scoreboard == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.Scoreboard(childComplexity), true
+	case "LobbySession.teams":
+		if e.complexity.LobbySession.Teams == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.Teams(childComplexity), true
+	case "LobbySession.totalEvents":
+		if e.complexity.LobbySession.TotalEvents == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.TotalEvents(childComplexity), true
+	case "LobbySession.updatedAt":
+		if e.complexity.LobbySession.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.LobbySession.UpdatedAt(childComplexity), true
+
+	case "MatchEnded.eventIndex":
+		if e.complexity.MatchEnded.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.MatchEnded.EventIndex(childComplexity), true
+	case "MatchEnded.frameIndex":
+		if e.complexity.MatchEnded.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.MatchEnded.FrameIndex(childComplexity), true
+	case "MatchEnded.matchId":
+		if e.complexity.MatchEnded.MatchID == nil {
+			break
+		}
+
+		return e.complexity.MatchEnded.MatchID(childComplexity), true
+	case "MatchEnded.timestamp":
+		if e.complexity.MatchEnded.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.MatchEnded.Timestamp(childComplexity), true
+	case "MatchEnded.winningTeam":
+		if e.complexity.MatchEnded.WinningTeam == nil {
+			break
+		}
+
+		return e.complexity.MatchEnded.WinningTeam(childComplexity), true
+
+	case "MatchFrame.frameData":
+		if e.complexity.MatchFrame.FrameData == nil {
+			break
+		}
+
+		return e.complexity.MatchFrame.FrameData(childComplexity), true
+	case "MatchFrame.frameIndex":
+		if e.complexity.MatchFrame.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.MatchFrame.FrameIndex(childComplexity), true
+	case "MatchFrame.matchId":
+		if e.complexity.MatchFrame.MatchID == nil {
+			break
+		}
+
+		return e.complexity.MatchFrame.MatchID(childComplexity), true
+	case "MatchFrame.timestamp":
+		if e.complexity.MatchFrame.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.MatchFrame.Timestamp(childComplexity), true
+
+	case "Mutation.storeSessionEvent":
+		if e.complexity.Mutation.StoreSessionEvent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_storeSessionEvent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.StoreSessionEvent(childComplexity, args["input"].(StoreSessionEventInput)), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+	case "PageInfo.startCursor":
+		if e.complexity.PageInfo.StartCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.StartCursor(childComplexity), true
+
+	case "PauseState.pausedRequestedTeam":
+		if e.complexity.PauseState.PausedRequestedTeam == nil {
+			break
+		}
+
+		return e.complexity.PauseState.PausedRequestedTeam(childComplexity), true
+	case "PauseState.pausedState":
+		if e.complexity.PauseState.PausedState == nil {
+			break
+		}
+
+		return e.complexity.PauseState.PausedState(childComplexity), true
+	case "PauseState.pausedTimer":
+		if e.complexity.PauseState.PausedTimer == nil {
+			break
+		}
+
+		return e.complexity.PauseState.PausedTimer(childComplexity), true
+	case "PauseState.unpausedTeam":
+		if e.complexity.PauseState.UnpausedTeam == nil {
+			break
+		}
+
+		return e.complexity.PauseState.UnpausedTeam(childComplexity), true
+	case "PauseState.unpausedTimer":
+		if e.complexity.PauseState.UnpausedTimer == nil {
+			break
+		}
+
+		return e.complexity.PauseState.UnpausedTimer(childComplexity), true
+
+	case "Player.accountNumber":
+		if e.complexity.Player.AccountNumber == nil {
+			break
+		}
+
+		return e.complexity.Player.AccountNumber(childComplexity), true
+	case "Player.displayName":
+		if e.complexity.Player.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.Player.DisplayName(childComplexity), true
+	case "Player.jerseyNumber":
+		if e.complexity.Player.JerseyNumber == nil {
+			break
+		}
+
+		return e.complexity.Player.JerseyNumber(childComplexity), true
+	case "Player.level":
+		if e.complexity.Player.Level == nil {
+			break
+		}
+
+		return e.complexity.Player.Level(childComplexity), true
+	case "Player.ping":
+		if e.complexity.Player.Ping == nil {
+			break
+		}
+
+		return e.complexity.Player.Ping(childComplexity), true
+	case "Player.role":
+		if e.complexity.Player.Role == nil {
+			break
+		}
+
+		return e.complexity.Player.Role(childComplexity), true
+	case "Player.slot":
+		if e.complexity.Player.Slot == nil {
+			break
+		}
+
+		return e.complexity.Player.Slot(childComplexity), true
+	case "Player.stats":
+		if e.complexity.Player.Stats == nil {
+			break
+		}
+
+		return e.complexity.Player.Stats(childComplexity), true
+
+	case "PlayerAssist.eventIndex":
+		if e.complexity.PlayerAssist.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.EventIndex(childComplexity), true
+	case "PlayerAssist.frameIndex":
+		if e.complexity.PlayerAssist.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.FrameIndex(childComplexity), true
+	case "PlayerAssist.matchId":
+		if e.complexity.PlayerAssist.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.MatchID(childComplexity), true
+	case "PlayerAssist.player":
+		if e.complexity.PlayerAssist.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.Player(childComplexity), true
+	case "PlayerAssist.playerSlot":
+		if e.complexity.PlayerAssist.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.PlayerSlot(childComplexity), true
+	case "PlayerAssist.timestamp":
+		if e.complexity.PlayerAssist.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.Timestamp(childComplexity), true
+	case "PlayerAssist.totalAssists":
+		if e.complexity.PlayerAssist.TotalAssists == nil {
+			break
+		}
+
+		return e.complexity.PlayerAssist.TotalAssists(childComplexity), true
+
+	case "PlayerBlock.eventIndex":
+		if e.complexity.PlayerBlock.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.EventIndex(childComplexity), true
+	case "PlayerBlock.frameIndex":
+		if e.complexity.PlayerBlock.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.FrameIndex(childComplexity), true
+	case "PlayerBlock.matchId":
+		if e.complexity.PlayerBlock.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.MatchID(childComplexity), true
+	case "PlayerBlock.player":
+		if e.complexity.PlayerBlock.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.Player(childComplexity), true
+	case "PlayerBlock.playerSlot":
+		if e.complexity.PlayerBlock.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.PlayerSlot(childComplexity), true
+	case "PlayerBlock.timestamp":
+		if e.complexity.PlayerBlock.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.Timestamp(childComplexity), true
+	case "PlayerBlock.totalBlocks":
+		if e.complexity.PlayerBlock.TotalBlocks == nil {
+			break
+		}
+
+		return e.complexity.PlayerBlock.TotalBlocks(childComplexity), true
+
+	case "PlayerGoal.eventIndex":
+		if e.complexity.PlayerGoal.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.EventIndex(childComplexity), true
+	case "PlayerGoal.frameIndex":
+		if e.complexity.PlayerGoal.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.FrameIndex(childComplexity), true
+	case "PlayerGoal.matchId":
+		if e.complexity.PlayerGoal.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.MatchID(childComplexity), true
+	case "PlayerGoal.player":
+		if e.complexity.PlayerGoal.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.Player(childComplexity), true
+	case "PlayerGoal.playerSlot":
+		if e.complexity.PlayerGoal.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.PlayerSlot(childComplexity), true
+	case "PlayerGoal.points":
+		if e.complexity.PlayerGoal.Points == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.Points(childComplexity), true
+	case "PlayerGoal.timestamp":
+		if e.complexity.PlayerGoal.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.Timestamp(childComplexity), true
+	case "PlayerGoal.totalGoals":
+		if e.complexity.PlayerGoal.TotalGoals == nil {
+			break
+		}
+
+		return e.complexity.PlayerGoal.TotalGoals(childComplexity), true
+
+	case "PlayerInterception.eventIndex":
+		if e.complexity.PlayerInterception.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.EventIndex(childComplexity), true
+	case "PlayerInterception.frameIndex":
+		if e.complexity.PlayerInterception.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.FrameIndex(childComplexity), true
+	case "PlayerInterception.matchId":
+		if e.complexity.PlayerInterception.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.MatchID(childComplexity), true
+	case "PlayerInterception.player":
+		if e.complexity.PlayerInterception.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.Player(childComplexity), true
+	case "PlayerInterception.playerSlot":
+		if e.complexity.PlayerInterception.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.PlayerSlot(childComplexity), true
+	case "PlayerInterception.timestamp":
+		if e.complexity.PlayerInterception.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.Timestamp(childComplexity), true
+	case "PlayerInterception.totalInterceptions":
+		if e.complexity.PlayerInterception.TotalInterceptions == nil {
+			break
+		}
+
+		return e.complexity.PlayerInterception.TotalInterceptions(childComplexity), true
+
+	case "PlayerJoined.eventIndex":
+		if e.complexity.PlayerJoined.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerJoined.EventIndex(childComplexity), true
+	case "PlayerJoined.frameIndex":
+		if e.complexity.PlayerJoined.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerJoined.FrameIndex(childComplexity), true
+	case "PlayerJoined.matchId":
+		if e.complexity.PlayerJoined.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerJoined.MatchID(childComplexity), true
+	case "PlayerJoined.player":
+		if e.complexity.PlayerJoined.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerJoined.Player(childComplexity), true
+	case "PlayerJoined.role":
+		if e.complexity.PlayerJoined.Role == nil {
+			break
+		}
+
+		return e.complexity.PlayerJoined.Role(childComplexity), true
+	case "PlayerJoined.timestamp":
+		if e.complexity.PlayerJoined.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerJoined.Timestamp(childComplexity), true
+
+	case "PlayerLeft.displayName":
+		if e.complexity.PlayerLeft.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.PlayerLeft.DisplayName(childComplexity), true
+	case "PlayerLeft.eventIndex":
+		if e.complexity.PlayerLeft.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerLeft.EventIndex(childComplexity), true
+	case "PlayerLeft.frameIndex":
+		if e.complexity.PlayerLeft.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerLeft.FrameIndex(childComplexity), true
+	case "PlayerLeft.matchId":
+		if e.complexity.PlayerLeft.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerLeft.MatchID(childComplexity), true
+	case "PlayerLeft.playerSlot":
+		if e.complexity.PlayerLeft.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerLeft.PlayerSlot(childComplexity), true
+	case "PlayerLeft.timestamp":
+		if e.complexity.PlayerLeft.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerLeft.Timestamp(childComplexity), true
+
+	case "PlayerPass.eventIndex":
+		if e.complexity.PlayerPass.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.EventIndex(childComplexity), true
+	case "PlayerPass.frameIndex":
+		if e.complexity.PlayerPass.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.FrameIndex(childComplexity), true
+	case "PlayerPass.matchId":
+		if e.complexity.PlayerPass.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.MatchID(childComplexity), true
+	case "PlayerPass.player":
+		if e.complexity.PlayerPass.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.Player(childComplexity), true
+	case "PlayerPass.playerSlot":
+		if e.complexity.PlayerPass.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.PlayerSlot(childComplexity), true
+	case "PlayerPass.timestamp":
+		if e.complexity.PlayerPass.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.Timestamp(childComplexity), true
+	case "PlayerPass.totalPasses":
+		if e.complexity.PlayerPass.TotalPasses == nil {
+			break
+		}
+
+		return e.complexity.PlayerPass.TotalPasses(childComplexity), true
+
+	case "PlayerSave.eventIndex":
+		if e.complexity.PlayerSave.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.EventIndex(childComplexity), true
+	case "PlayerSave.frameIndex":
+		if e.complexity.PlayerSave.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.FrameIndex(childComplexity), true
+	case "PlayerSave.matchId":
+		if e.complexity.PlayerSave.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.MatchID(childComplexity), true
+	case "PlayerSave.player":
+		if e.complexity.PlayerSave.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.Player(childComplexity), true
+	case "PlayerSave.playerSlot":
+		if e.complexity.PlayerSave.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.PlayerSlot(childComplexity), true
+	case "PlayerSave.timestamp":
+		if e.complexity.PlayerSave.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.Timestamp(childComplexity), true
+	case "PlayerSave.totalSaves":
+		if e.complexity.PlayerSave.TotalSaves == nil {
+			break
+		}
+
+		return e.complexity.PlayerSave.TotalSaves(childComplexity), true
+
+	case "PlayerShotTaken.eventIndex":
+		if e.complexity.PlayerShotTaken.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.EventIndex(childComplexity), true
+	case "PlayerShotTaken.frameIndex":
+		if e.complexity.PlayerShotTaken.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.FrameIndex(childComplexity), true
+	case "PlayerShotTaken.matchId":
+		if e.complexity.PlayerShotTaken.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.MatchID(childComplexity), true
+	case "PlayerShotTaken.player":
+		if e.complexity.PlayerShotTaken.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.Player(childComplexity), true
+	case "PlayerShotTaken.playerSlot":
+		if e.complexity.PlayerShotTaken.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.PlayerSlot(childComplexity), true
+	case "PlayerShotTaken.timestamp":
+		if e.complexity.PlayerShotTaken.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.Timestamp(childComplexity), true
+	case "PlayerShotTaken.totalShots":
+		if e.complexity.PlayerShotTaken.TotalShots == nil {
+			break
+		}
+
+		return e.complexity.PlayerShotTaken.TotalShots(childComplexity), true
+
+	case "PlayerSteal.eventIndex":
+		if e.complexity.PlayerSteal.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.EventIndex(childComplexity), true
+	case "PlayerSteal.frameIndex":
+		if e.complexity.PlayerSteal.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.FrameIndex(childComplexity), true
+	case "PlayerSteal.matchId":
+		if e.complexity.PlayerSteal.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.MatchID(childComplexity), true
+	case "PlayerSteal.player":
+		if e.complexity.PlayerSteal.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.Player(childComplexity), true
+	case "PlayerSteal.playerSlot":
+		if e.complexity.PlayerSteal.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.PlayerSlot(childComplexity), true
+	case "PlayerSteal.timestamp":
+		if e.complexity.PlayerSteal.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.Timestamp(childComplexity), true
+	case "PlayerSteal.totalSteals":
+		if e.complexity.PlayerSteal.TotalSteals == nil {
+			break
+		}
+
+		return e.complexity.PlayerSteal.TotalSteals(childComplexity), true
+
+	case "PlayerStun.eventIndex":
+		if e.complexity.PlayerStun.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.EventIndex(childComplexity), true
+	case "PlayerStun.frameIndex":
+		if e.complexity.PlayerStun.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.FrameIndex(childComplexity), true
+	case "PlayerStun.matchId":
+		if e.complexity.PlayerStun.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.MatchID(childComplexity), true
+	case "PlayerStun.player":
+		if e.complexity.PlayerStun.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.Player(childComplexity), true
+	case "PlayerStun.playerSlot":
+		if e.complexity.PlayerStun.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.PlayerSlot(childComplexity), true
+	case "PlayerStun.timestamp":
+		if e.complexity.PlayerStun.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.Timestamp(childComplexity), true
+	case "PlayerStun.totalStuns":
+		if e.complexity.PlayerStun.TotalStuns == nil {
+			break
+		}
+
+		return e.complexity.PlayerStun.TotalStuns(childComplexity), true
+
+	case "PlayerSwitchedTeam.eventIndex":
+		if e.complexity.PlayerSwitchedTeam.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.EventIndex(childComplexity), true
+	case "PlayerSwitchedTeam.frameIndex":
+		if e.complexity.PlayerSwitchedTeam.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.FrameIndex(childComplexity), true
+	case "PlayerSwitchedTeam.matchId":
+		if e.complexity.PlayerSwitchedTeam.MatchID == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.MatchID(childComplexity), true
+	case "PlayerSwitchedTeam.newRole":
+		if e.complexity.PlayerSwitchedTeam.NewRole == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.NewRole(childComplexity), true
+	case "PlayerSwitchedTeam.player":
+		if e.complexity.PlayerSwitchedTeam.Player == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.Player(childComplexity), true
+	case "PlayerSwitchedTeam.playerSlot":
+		if e.complexity.PlayerSwitchedTeam.PlayerSlot == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.PlayerSlot(childComplexity), true
+	case "PlayerSwitchedTeam.prevRole":
+		if e.complexity.PlayerSwitchedTeam.PrevRole == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.PrevRole(childComplexity), true
+	case "PlayerSwitchedTeam.timestamp":
+		if e.complexity.PlayerSwitchedTeam.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PlayerSwitchedTeam.Timestamp(childComplexity), true
+
+	case "Query.health":
+		if e.complexity.Query.Health == nil {
+			break
+		}
+
+		return e.complexity.Query.Health(childComplexity), true
+	case "Query.lobbySession":
+		if e.complexity.Query.LobbySession == nil {
+			break
+		}
+
+		args, err := ec.field_Query_lobbySession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.LobbySession(childComplexity, args["id"].(string)), true
+	case "Query.sessionEvents":
+		if e.complexity.Query.SessionEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_sessionEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SessionEvents(childComplexity, args["lobbySessionId"].(string), args["limit"].(*int), args["offset"].(*int)), true
+
+	case "Round.endFrameIndex":
+		if e.complexity.Round.EndFrameIndex == nil {
+			break
+		}
+
+		return e.complexity.Round.EndFrameIndex(childComplexity), true
+	case "Round.endedAt":
+		if e.complexity.Round.EndedAt == nil {
+			break
+		}
+
+		return e.complexity.Round.EndedAt(childComplexity), true
+	case "Round.number":
+		if e.complexity.Round.Number == nil {
+			break
+		}
+
+		return e.complexity.Round.Number(childComplexity), true
+	case "Round.startFrameIndex":
+		if e.complexity.Round.StartFrameIndex == nil {
+			break
+		}
+
+		return e.complexity.Round.StartFrameIndex(childComplexity), true
+	case "Round.startedAt":
+		if e.complexity.Round.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.Round.StartedAt(childComplexity), true
+	case "Round.winningTeam":
+		if e.complexity.Round.WinningTeam == nil {
+			break
+		}
+
+		return e.complexity.Round.WinningTeam(childComplexity), true
+
+	case "RoundEnded.eventIndex":
+		if e.complexity.RoundEnded.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundEnded.EventIndex(childComplexity), true
+	case "RoundEnded.frameIndex":
+		if e.complexity.RoundEnded.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundEnded.FrameIndex(childComplexity), true
+	case "RoundEnded.matchId":
+		if e.complexity.RoundEnded.MatchID == nil {
+			break
+		}
+
+		return e.complexity.RoundEnded.MatchID(childComplexity), true
+	case "RoundEnded.roundNumber":
+		if e.complexity.RoundEnded.RoundNumber == nil {
+			break
+		}
+
+		return e.complexity.RoundEnded.RoundNumber(childComplexity), true
+	case "RoundEnded.timestamp":
+		if e.complexity.RoundEnded.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.RoundEnded.Timestamp(childComplexity), true
+	case "RoundEnded.winningTeam":
+		if e.complexity.RoundEnded.WinningTeam == nil {
+			break
+		}
+
+		return e.complexity.RoundEnded.WinningTeam(childComplexity), true
+
+	case "RoundPaused.eventIndex":
+		if e.complexity.RoundPaused.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundPaused.EventIndex(childComplexity), true
+	case "RoundPaused.frameIndex":
+		if e.complexity.RoundPaused.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundPaused.FrameIndex(childComplexity), true
+	case "RoundPaused.matchId":
+		if e.complexity.RoundPaused.MatchID == nil {
+			break
+		}
+
+		return e.complexity.RoundPaused.MatchID(childComplexity), true
+	case "RoundPaused.pause":
+		if e.complexity.RoundPaused.Pause == nil {
+			break
+		}
+
+		return e.complexity.RoundPaused.Pause(childComplexity), true
+	case "RoundPaused.timestamp":
+		if e.complexity.RoundPaused.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.RoundPaused.Timestamp(childComplexity), true
+
+	case "RoundStarted.eventIndex":
+		if e.complexity.RoundStarted.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundStarted.EventIndex(childComplexity), true
+	case "RoundStarted.frameIndex":
+		if e.complexity.RoundStarted.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundStarted.FrameIndex(childComplexity), true
+	case "RoundStarted.matchId":
+		if e.complexity.RoundStarted.MatchID == nil {
+			break
+		}
+
+		return e.complexity.RoundStarted.MatchID(childComplexity), true
+	case "RoundStarted.roundNumber":
+		if e.complexity.RoundStarted.RoundNumber == nil {
+			break
+		}
+
+		return e.complexity.RoundStarted.RoundNumber(childComplexity), true
+	case "RoundStarted.timestamp":
+		if e.complexity.RoundStarted.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.RoundStarted.Timestamp(childComplexity), true
+
+	case "RoundUnpaused.eventIndex":
+		if e.complexity.RoundUnpaused.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundUnpaused.EventIndex(childComplexity), true
+	case "RoundUnpaused.frameIndex":
+		if e.complexity.RoundUnpaused.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.RoundUnpaused.FrameIndex(childComplexity), true
+	case "RoundUnpaused.matchId":
+		if e.complexity.RoundUnpaused.MatchID == nil {
+			break
+		}
+
+		return e.complexity.RoundUnpaused.MatchID(childComplexity), true
+	case "RoundUnpaused.pause":
+		if e.complexity.RoundUnpaused.Pause == nil {
+			break
+		}
+
+		return e.complexity.RoundUnpaused.Pause(childComplexity), true
+	case "RoundUnpaused.timestamp":
+		if e.complexity.RoundUnpaused.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.RoundUnpaused.Timestamp(childComplexity), true
+
+	case "ScoreDetails.assistScored":
+		if e.complexity.ScoreDetails.AssistScored == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.AssistScored(childComplexity), true
+	case "ScoreDetails.discSpeed":
+		if e.complexity.ScoreDetails.DiscSpeed == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.DiscSpeed(childComplexity), true
+	case "ScoreDetails.distanceThrown":
+		if e.complexity.ScoreDetails.DistanceThrown == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.DistanceThrown(childComplexity), true
+	case "ScoreDetails.goalType":
+		if e.complexity.ScoreDetails.GoalType == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.GoalType(childComplexity), true
+	case "ScoreDetails.personScored":
+		if e.complexity.ScoreDetails.PersonScored == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.PersonScored(childComplexity), true
+	case "ScoreDetails.pointAmount":
+		if e.complexity.ScoreDetails.PointAmount == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.PointAmount(childComplexity), true
+	case "ScoreDetails.team":
+		if e.complexity.ScoreDetails.Team == nil {
+			break
+		}
+
+		return e.complexity.ScoreDetails.Team(childComplexity), true
+
+	case "Scoreboard.bluePoints":
+		if e.complexity.Scoreboard.BluePoints == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.BluePoints(childComplexity), true
+	case "Scoreboard.blueRoundScore":
+		if e.complexity.Scoreboard.BlueRoundScore == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.BlueRoundScore(childComplexity), true
+	case "Scoreboard.gameClock":
+		if e.complexity.Scoreboard.GameClock == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.GameClock(childComplexity), true
+	case "Scoreboard.gameClockDisplay":
+		if e.complexity.Scoreboard.GameClockDisplay == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.GameClockDisplay(childComplexity), true
+	case "Scoreboard.gameStatus":
+		if e.complexity.Scoreboard.GameStatus == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.GameStatus(childComplexity), true
+	case "Scoreboard.orangePoints":
+		if e.complexity.Scoreboard.OrangePoints == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.OrangePoints(childComplexity), true
+	case "Scoreboard.orangeRoundScore":
+		if e.complexity.Scoreboard.OrangeRoundScore == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.OrangeRoundScore(childComplexity), true
+	case "Scoreboard.totalRoundCount":
+		if e.complexity.Scoreboard.TotalRoundCount == nil {
+			break
+		}
+
+		return e.complexity.Scoreboard.TotalRoundCount(childComplexity), true
+
+	case "ScoreboardUpdated.bluePoints":
+		if e.complexity.ScoreboardUpdated.BluePoints == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.BluePoints(childComplexity), true
+	case "ScoreboardUpdated.blueRoundScore":
+		if e.complexity.ScoreboardUpdated.BlueRoundScore == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.BlueRoundScore(childComplexity), true
+	case "ScoreboardUpdated.eventIndex":
+		if e.complexity.ScoreboardUpdated.EventIndex == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.EventIndex(childComplexity), true
+	case "ScoreboardUpdated.frameIndex":
+		if e.complexity.ScoreboardUpdated.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.FrameIndex(childComplexity), true
+	case "ScoreboardUpdated.gameClockDisplay":
+		if e.complexity.ScoreboardUpdated.GameClockDisplay == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.GameClockDisplay(childComplexity), true
+	case "ScoreboardUpdated.matchId":
+		if e.complexity.ScoreboardUpdated.MatchID == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.MatchID(childComplexity), true
+	case "ScoreboardUpdated.orangePoints":
+		if e.complexity.ScoreboardUpdated.OrangePoints == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.OrangePoints(childComplexity), true
+	case "ScoreboardUpdated.orangeRoundScore":
+		if e.complexity.ScoreboardUpdated.OrangeRoundScore == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.OrangeRoundScore(childComplexity), true
+	case "ScoreboardUpdated.timestamp":
+		if e.complexity.ScoreboardUpdated.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.ScoreboardUpdated.Timestamp(childComplexity), true
+
+	case "SessionEvent.createdAt":
+		if e.complexity.SessionEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.CreatedAt(childComplexity), true
+	case "SessionEvent.events":
+		if e.complexity.SessionEvent.Events == nil {
+			break
+		}
+
+		args, err := ec.field_SessionEvent_events_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.SessionEvent.Events(childComplexity, args["types"].([]string)), true
+	case "SessionEvent.frameData":
+		if e.complexity.SessionEvent.FrameData == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.FrameData(childComplexity), true
+	case "SessionEvent.frameIndex":
+		if e.complexity.SessionEvent.FrameIndex == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.FrameIndex(childComplexity), true
+	case "SessionEvent.id":
+		if e.complexity.SessionEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.ID(childComplexity), true
+	case "SessionEvent.lobbySessionId":
+		if e.complexity.SessionEvent.LobbySessionID == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.LobbySessionID(childComplexity), true
+	case "SessionEvent.players":
+		if e.complexity.SessionEvent.Players == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.Players(childComplexity), true
+	case "SessionEvent.scoreboard":
+		if e.complexity.SessionEvent.Scoreboard == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.Scoreboard(childComplexity), true
+	case "SessionEvent.teams":
+		if e.complexity.SessionEvent.Teams == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.Teams(childComplexity), true
+	case "SessionEvent.timestamp":
+		if e.complexity.SessionEvent.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.Timestamp(childComplexity), true
+	case "SessionEvent.updatedAt":
+		if e.complexity.SessionEvent.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.UpdatedAt(childComplexity), true
+	case "SessionEvent.userId":
+		if e.complexity.SessionEvent.UserID == nil {
+			break
+		}
+
+		return e.complexity.SessionEvent.UserID(childComplexity), true
+
+	case "SessionEventConnection.edges":
+		if e.complexity.SessionEventConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.SessionEventConnection.Edges(childComplexity), true
+	case "SessionEventConnection.pageInfo":
+		if e.complexity.SessionEventConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.SessionEventConnection.PageInfo(childComplexity), true
+	case "SessionEventConnection.totalCount":
+		if e.complexity.SessionEventConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.SessionEventConnection.TotalCount(childComplexity), true
+
+	case "SessionEventEdge.cursor":
+		if e.complexity.SessionEventEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.SessionEventEdge.Cursor(childComplexity), true
+	case "SessionEventEdge.node":
+		if e.complexity.SessionEventEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.SessionEventEdge.Node(childComplexity), true
+
+	case "Stats.assists":
+		if e.complexity.Stats.Assists == nil {
+			break
+		}
+
+		return e.complexity.Stats.Assists(childComplexity), true
+	case "Stats.blocks":
+		if e.complexity.Stats.Blocks == nil {
+			break
+		}
+
+		return e.complexity.Stats.Blocks(childComplexity), true
+	case "Stats.catches":
+		if e.complexity.Stats.Catches == nil {
+			break
+		}
+
+		return e.complexity.Stats.Catches(childComplexity), true
+	case "Stats.goals":
+		if e.complexity.Stats.Goals == nil {
+			break
+		}
+
+		return e.complexity.Stats.Goals(childComplexity), true
+	case "Stats.interceptions":
+		if e.complexity.Stats.Interceptions == nil {
+			break
+		}
+
+		return e.complexity.Stats.Interceptions(childComplexity), true
+	case "Stats.passes":
+		if e.complexity.Stats.Passes == nil {
+			break
+		}
+
+		return e.complexity.Stats.Passes(childComplexity), true
+	case "Stats.points":
+		if e.complexity.Stats.Points == nil {
+			break
+		}
+
+		return e.complexity.Stats.Points(childComplexity), true
+	case "Stats.possessionTime":
+		if e.complexity.Stats.PossessionTime == nil {
+			break
+		}
+
+		return e.complexity.Stats.PossessionTime(childComplexity), true
+	case "Stats.saves":
+		if e.complexity.Stats.Saves == nil {
+			break
+		}
+
+		return e.complexity.Stats.Saves(childComplexity), true
+	case "Stats.shotsTaken":
+		if e.complexity.Stats.ShotsTaken == nil {
+			break
+		}
+
+		return e.complexity.Stats.ShotsTaken(childComplexity), true
+	case "Stats.steals":
+		if e.complexity.Stats.Steals == nil {
+			break
+		}
+
+		return e.complexity.Stats.Steals(childComplexity), true
+	case "Stats.stuns":
+		if e.complexity.Stats.Stuns == nil {
+			break
+		}
+
+		return e.complexity.Stats.Stuns(childComplexity), true
+
+	case "StoreSessionEventPayload.duplicate":
+		if e.complexity.StoreSessionEventPayload.Duplicate == nil {
+			break
+		}
+
+		return e.complexity.StoreSessionEventPayload.Duplicate(childComplexity), true
+	case "StoreSessionEventPayload.error":
+		if e.complexity.StoreSessionEventPayload.Error == nil {
+			break
+		}
+
+		return e.complexity.StoreSessionEventPayload.Error(childComplexity), true
+	case "StoreSessionEventPayload.event":
+		if e.complexity.StoreSessionEventPayload.Event == nil {
+			break
+		}
+
+		return e.complexity.StoreSessionEventPayload.Event(childComplexity), true
+	case "StoreSessionEventPayload.success":
+		if e.complexity.StoreSessionEventPayload.Success == nil {
+			break
+		}
+
+		return e.complexity.StoreSessionEventPayload.Success(childComplexity), true
+
+	case "Subscription.liveMatches":
+		if e.complexity.Subscription.LiveMatches == nil {
+			break
+		}
+
+		return e.complexity.Subscription.LiveMatches(childComplexity), true
+	case "Subscription.matchEvents":
+		if e.complexity.Subscription.MatchEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_matchEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MatchEvents(childComplexity, args["matchId"].(string), args["types"].([]string)), true
+	case "Subscription.matchFrames":
+		if e.complexity.Subscription.MatchFrames == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_matchFrames_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.MatchFrames(childComplexity, args["matchId"].(string), args["fps"].(*int)), true
+
+	case "Team.hasPossession":
+		if e.complexity.Team.HasPossession == nil {
+			break
+		}
+
+		return e.complexity.Team.HasPossession(childComplexity), true
+	case "Team.name":
+		if e.complexity.Team.Name == nil {
+			break
+		}
+
+		return e.complexity.Team.Name(childComplexity), true
+	case "Team.players":
+		if e.complexity.Team.Players == nil {
+			break
+		}
+
+		return e.complexity.Team.Players(childComplexity), true
+	case "Team.role":
+		if e.complexity.Team.Role == nil {
+			break
+		}
+
+		return e.complexity.Team.Role(childComplexity), true
+	case "Team.stats":
+		if e.complexity.Team.Stats == nil {
+			break
+		}
+
+		return e.complexity.Team.Stats(childComplexity), true
+
+	case "ThrowDetails.armSpeed":
+		if e.complexity.ThrowDetails.ArmSpeed == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.ArmSpeed(childComplexity), true
+	case "ThrowDetails.offAxisPenalty":
+		if e.complexity.ThrowDetails.OffAxisPenalty == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.OffAxisPenalty(childComplexity), true
+	case "ThrowDetails.offAxisSpinDeg":
+		if e.complexity.ThrowDetails.OffAxisSpinDeg == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.OffAxisSpinDeg(childComplexity), true
+	case "ThrowDetails.potSpeedFromRot":
+		if e.complexity.ThrowDetails.PotSpeedFromRot == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.PotSpeedFromRot(childComplexity), true
+	case "ThrowDetails.rotPerSec":
+		if e.complexity.ThrowDetails.RotPerSec == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.RotPerSec(childComplexity), true
+	case "ThrowDetails.speedFromArm":
+		if e.complexity.ThrowDetails.SpeedFromArm == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.SpeedFromArm(childComplexity), true
+	case "ThrowDetails.speedFromMovement":
+		if e.complexity.ThrowDetails.SpeedFromMovement == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.SpeedFromMovement(childComplexity), true
+	case "ThrowDetails.speedFromWrist":
+		if e.complexity.ThrowDetails.SpeedFromWrist == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.SpeedFromWrist(childComplexity), true
+	case "ThrowDetails.throwAlignToMovementDeg":
+		if e.complexity.ThrowDetails.ThrowAlignToMovementDeg == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.ThrowAlignToMovementDeg(childComplexity), true
+	case "ThrowDetails.throwMovePenalty":
+		if e.complexity.ThrowDetails.ThrowMovePenalty == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.ThrowMovePenalty(childComplexity), true
+	case "ThrowDetails.totalSpeed":
+		if e.complexity.ThrowDetails.TotalSpeed == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.TotalSpeed(childComplexity), true
+	case "ThrowDetails.wristAlignToThrowDeg":
+		if e.complexity.ThrowDetails.WristAlignToThrowDeg == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.WristAlignToThrowDeg(childComplexity), true
+	case "ThrowDetails.wristThrowPenalty":
+		if e.complexity.ThrowDetails.WristThrowPenalty == nil {
+			break
+		}
+
+		return e.complexity.ThrowDetails.WristThrowPenalty(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputStoreSessionEventInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+//go:embed "schema.graphql"
+var sourcesFS embed.FS
+
+func sourceData(filename string) string {
+	data, err := sourcesFS.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("codegen problem: %s not available", filename))
+	}
+	return string(data)
+}
+
+var sources = []*ast.Source{
+	{Name: "schema.graphql", Input: sourceData("schema.graphql"), BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_LobbySession_events_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "types", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["types"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_LobbySession_frames_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_storeSessionEvent_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "input", ec.unmarshalNStoreSessionEventInput2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStoreSessionEventInput)
+	if err != nil {
+		return nil, err
+	}
+	args["input"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_lobbySession_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_sessionEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "lobbySessionId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["lobbySessionId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_SessionEvent_events_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "types", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["types"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_matchEvents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["matchId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "types", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["types"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_matchFrames_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "matchId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["matchId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "fps", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["fps"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _DiscCaught_matchId(ctx context.Context, field graphql.CollectedField, obj *DiscCaught) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscCaught_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscCaught_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscCaught",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscCaught_frameIndex(ctx context.Context, field graphql.CollectedField, obj *DiscCaught) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscCaught_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscCaught_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscCaught",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscCaught_eventIndex(ctx context.Context, field graphql.CollectedField, obj *DiscCaught) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscCaught_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscCaught_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscCaught",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscCaught_timestamp(ctx context.Context, field graphql.CollectedField, obj *DiscCaught) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscCaught_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscCaught_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscCaught",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscCaught_playerSlot(ctx context.Context, field graphql.CollectedField, obj *DiscCaught) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscCaught_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscCaught_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscCaught",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscCaught_player(ctx context.Context, field graphql.CollectedField, obj *DiscCaught) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscCaught_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscCaught_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscCaught",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_matchId(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_frameIndex(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_eventIndex(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_timestamp(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_playerSlot(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_player(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_previousPlayerSlot(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_previousPlayerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PreviousPlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_previousPlayerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscPossessionChanged_previousPlayer(ctx context.Context, field graphql.CollectedField, obj *DiscPossessionChanged) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscPossessionChanged_previousPlayer,
+		func(ctx context.Context) (any, error) {
+			return obj.PreviousPlayer, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscPossessionChanged_previousPlayer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscPossessionChanged",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_matchId(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_frameIndex(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_eventIndex(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_timestamp(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_playerSlot(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_player(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DiscThrown_throwDetails(ctx context.Context, field graphql.CollectedField, obj *DiscThrown) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DiscThrown_throwDetails,
+		func(ctx context.Context) (any, error) {
+			return obj.ThrowDetails, nil
+		},
+		nil,
+		ec.marshalOThrowDetails2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐThrowDetails,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_DiscThrown_throwDetails(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DiscThrown",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "armSpeed":
+				return ec.fieldContext_ThrowDetails_armSpeed(ctx, field)
+			case "totalSpeed":
+				return ec.fieldContext_ThrowDetails_totalSpeed(ctx, field)
+			case "offAxisSpinDeg":
+				return ec.fieldContext_ThrowDetails_offAxisSpinDeg(ctx, field)
+			case "wristThrowPenalty":
+				return ec.fieldContext_ThrowDetails_wristThrowPenalty(ctx, field)
+			case "rotPerSec":
+				return ec.fieldContext_ThrowDetails_rotPerSec(ctx, field)
+			case "potSpeedFromRot":
+				return ec.fieldContext_ThrowDetails_potSpeedFromRot(ctx, field)
+			case "speedFromArm":
+				return ec.fieldContext_ThrowDetails_speedFromArm(ctx, field)
+			case "speedFromMovement":
+				return ec.fieldContext_ThrowDetails_speedFromMovement(ctx, field)
+			case "speedFromWrist":
+				return ec.fieldContext_ThrowDetails_speedFromWrist(ctx, field)
+			case "wristAlignToThrowDeg":
+				return ec.fieldContext_ThrowDetails_wristAlignToThrowDeg(ctx, field)
+			case "throwAlignToMovementDeg":
+				return ec.fieldContext_ThrowDetails_throwAlignToMovementDeg(ctx, field)
+			case "offAxisPenalty":
+				return ec.fieldContext_ThrowDetails_offAxisPenalty(ctx, field)
+			case "throwMovePenalty":
+				return ec.fieldContext_ThrowDetails_throwMovePenalty(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ThrowDetails", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_matchId(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_frameIndex(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_eventIndex(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_timestamp(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_playerSlot(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_player(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EmotePlayed_emote(ctx context.Context, field graphql.CollectedField, obj *EmotePlayed) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EmotePlayed_emote,
+		func(ctx context.Context) (any, error) {
+			return obj.Emote, nil
+		},
+		nil,
+		ec.marshalNEmoteType2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐEmoteType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EmotePlayed_emote(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EmotePlayed",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type EmoteType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GoalScored_matchId(ctx context.Context, field graphql.CollectedField, obj *GoalScored) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GoalScored_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GoalScored_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GoalScored",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GoalScored_frameIndex(ctx context.Context, field graphql.CollectedField, obj *GoalScored) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GoalScored_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GoalScored_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GoalScored",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GoalScored_eventIndex(ctx context.Context, field graphql.CollectedField, obj *GoalScored) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GoalScored_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GoalScored_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GoalScored",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GoalScored_timestamp(ctx context.Context, field graphql.CollectedField, obj *GoalScored) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GoalScored_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_GoalScored_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GoalScored",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _GoalScored_scoreDetails(ctx context.Context, field graphql.CollectedField, obj *GoalScored) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_GoalScored_scoreDetails,
+		func(ctx context.Context) (any, error) {
+			return obj.ScoreDetails, nil
+		},
+		nil,
+		ec.marshalOScoreDetails2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐScoreDetails,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_GoalScored_scoreDetails(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "GoalScored",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "discSpeed":
+				return ec.fieldContext_ScoreDetails_discSpeed(ctx, field)
+			case "team":
+				return ec.fieldContext_ScoreDetails_team(ctx, field)
+			case "goalType":
+				return ec.fieldContext_ScoreDetails_goalType(ctx, field)
+			case "pointAmount":
+				return ec.fieldContext_ScoreDetails_pointAmount(ctx, field)
+			case "distanceThrown":
+				return ec.fieldContext_ScoreDetails_distanceThrown(ctx, field)
+			case "personScored":
+				return ec.fieldContext_ScoreDetails_personScored(ctx, field)
+			case "assistScored":
+				return ec.fieldContext_ScoreDetails_assistScored(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ScoreDetails", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HealthStatus_status(ctx context.Context, field graphql.CollectedField, obj *HealthStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HealthStatus_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HealthStatus_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HealthStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HealthStatus_timestamp(ctx context.Context, field graphql.CollectedField, obj *HealthStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HealthStatus_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HealthStatus_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HealthStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _HealthStatus_database(ctx context.Context, field graphql.CollectedField, obj *HealthStatus) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_HealthStatus_database,
+		func(ctx context.Context) (any, error) {
+			return obj.Database, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_HealthStatus_database(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "HealthStatus",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LiveMatch_matchId(ctx context.Context, field graphql.CollectedField, obj *LiveMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LiveMatch_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LiveMatch_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LiveMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LiveMatch_mapName(ctx context.Context, field graphql.CollectedField, obj *LiveMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LiveMatch_mapName,
+		func(ctx context.Context) (any, error) {
+			return obj.MapName, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LiveMatch_mapName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LiveMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LiveMatch_matchType(ctx context.Context, field graphql.CollectedField, obj *LiveMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LiveMatch_matchType,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchType, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LiveMatch_matchType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LiveMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LiveMatch_gameStatus(ctx context.Context, field graphql.CollectedField, obj *LiveMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LiveMatch_gameStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.GameStatus, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LiveMatch_gameStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LiveMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LiveMatch_startedAt(ctx context.Context, field graphql.CollectedField, obj *LiveMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LiveMatch_startedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.StartedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LiveMatch_startedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LiveMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LiveMatch_lastFrameAt(ctx context.Context, field graphql.CollectedField, obj *LiveMatch) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LiveMatch_lastFrameAt,
+		func(ctx context.Context) (any, error) {
+			return obj.LastFrameAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LiveMatch_lastFrameAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LiveMatch",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_id(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_lobbySessionId(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_lobbySessionId,
+		func(ctx context.Context) (any, error) {
+			return obj.LobbySessionID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_lobbySessionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_frames(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_frames,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.LobbySession().Frames(ctx, obj, fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNSessionEventConnection2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_frames(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_SessionEventConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SessionEventConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_SessionEventConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionEventConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LobbySession_frames_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_events(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_events,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.LobbySession().Events(ctx, obj, fc.Args["types"].([]string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNMatchEvent2ᚕgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MatchEvent does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_LobbySession_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_players(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_players,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.LobbySession().Players(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_teams(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_teams,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.LobbySession().Teams(ctx, obj)
+		},
+		nil,
+		ec.marshalNTeam2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐTeamᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_teams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "role":
+				return ec.fieldContext_Team_role(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "hasPossession":
+				return ec.fieldContext_Team_hasPossession(ctx, field)
+			case "stats":
+				return ec.fieldContext_Team_stats(ctx, field)
+			case "players":
+				return ec.fieldContext_Team_players(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_scoreboard(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_scoreboard,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.LobbySession().Scoreboard(ctx, obj)
+		},
+		nil,
+		ec.marshalOScoreboard2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐScoreboard,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_scoreboard(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "bluePoints":
+				return ec.fieldContext_Scoreboard_bluePoints(ctx, field)
+			case "orangePoints":
+				return ec.fieldContext_Scoreboard_orangePoints(ctx, field)
+			case "blueRoundScore":
+				return ec.fieldContext_Scoreboard_blueRoundScore(ctx, field)
+			case "orangeRoundScore":
+				return ec.fieldContext_Scoreboard_orangeRoundScore(ctx, field)
+			case "totalRoundCount":
+				return ec.fieldContext_Scoreboard_totalRoundCount(ctx, field)
+			case "gameClock":
+				return ec.fieldContext_Scoreboard_gameClock(ctx, field)
+			case "gameClockDisplay":
+				return ec.fieldContext_Scoreboard_gameClockDisplay(ctx, field)
+			case "gameStatus":
+				return ec.fieldContext_Scoreboard_gameStatus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Scoreboard", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_rounds(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_rounds,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.LobbySession().Rounds(ctx, obj)
+		},
+		nil,
+		ec.marshalNRound2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRoundᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_rounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "number":
+				return ec.fieldContext_Round_number(ctx, field)
+			case "startFrameIndex":
+				return ec.fieldContext_Round_startFrameIndex(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_Round_startedAt(ctx, field)
+			case "endFrameIndex":
+				return ec.fieldContext_Round_endFrameIndex(ctx, field)
+			case "endedAt":
+				return ec.fieldContext_Round_endedAt(ctx, field)
+			case "winningTeam":
+				return ec.fieldContext_Round_winningTeam(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Round", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_totalEvents(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_totalEvents,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalEvents, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_totalEvents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_createdAt(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LobbySession_updatedAt(ctx context.Context, field graphql.CollectedField, obj *LobbySession) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LobbySession_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LobbySession_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LobbySession",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchEnded_matchId(ctx context.Context, field graphql.CollectedField, obj *MatchEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchEnded_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchEnded_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchEnded_frameIndex(ctx context.Context, field graphql.CollectedField, obj *MatchEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchEnded_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchEnded_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchEnded_eventIndex(ctx context.Context, field graphql.CollectedField, obj *MatchEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchEnded_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchEnded_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchEnded_timestamp(ctx context.Context, field graphql.CollectedField, obj *MatchEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchEnded_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchEnded_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchEnded_winningTeam(ctx context.Context, field graphql.CollectedField, obj *MatchEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchEnded_winningTeam,
+		func(ctx context.Context) (any, error) {
+			return obj.WinningTeam, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchEnded_winningTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchFrame_matchId(ctx context.Context, field graphql.CollectedField, obj *MatchFrame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchFrame_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchFrame_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchFrame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchFrame_frameIndex(ctx context.Context, field graphql.CollectedField, obj *MatchFrame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchFrame_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchFrame_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchFrame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchFrame_timestamp(ctx context.Context, field graphql.CollectedField, obj *MatchFrame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchFrame_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchFrame_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchFrame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MatchFrame_frameData(ctx context.Context, field graphql.CollectedField, obj *MatchFrame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MatchFrame_frameData,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameData, nil
+		},
+		nil,
+		ec.marshalNJSON2map,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MatchFrame_frameData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MatchFrame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JSON does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_storeSessionEvent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_storeSessionEvent,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().StoreSessionEvent(ctx, fc.Args["input"].(StoreSessionEventInput))
+		},
+		nil,
+		ec.marshalNStoreSessionEventPayload2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStoreSessionEventPayload,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_storeSessionEvent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_StoreSessionEventPayload_success(ctx, field)
+			case "event":
+				return ec.fieldContext_StoreSessionEventPayload_event(ctx, field)
+			case "error":
+				return ec.fieldContext_StoreSessionEventPayload_error(ctx, field)
+			case "duplicate":
+				return ec.fieldContext_StoreSessionEventPayload_duplicate(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type StoreSessionEventPayload", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_storeSessionEvent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasNextPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasNextPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_hasPreviousPage,
+		func(ctx context.Context) (any, error) {
+			return obj.HasPreviousPage, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_startCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_startCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.StartCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_startCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PageInfo_endCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.EndCursor, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PauseState_pausedState(ctx context.Context, field graphql.CollectedField, obj *PauseState) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PauseState_pausedState,
+		func(ctx context.Context) (any, error) {
+			return obj.PausedState, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PauseState_pausedState(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PauseState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PauseState_unpausedTeam(ctx context.Context, field graphql.CollectedField, obj *PauseState) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PauseState_unpausedTeam,
+		func(ctx context.Context) (any, error) {
+			return obj.UnpausedTeam, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PauseState_unpausedTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PauseState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PauseState_pausedRequestedTeam(ctx context.Context, field graphql.CollectedField, obj *PauseState) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PauseState_pausedRequestedTeam,
+		func(ctx context.Context) (any, error) {
+			return obj.PausedRequestedTeam, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PauseState_pausedRequestedTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PauseState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PauseState_unpausedTimer(ctx context.Context, field graphql.CollectedField, obj *PauseState) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PauseState_unpausedTimer,
+		func(ctx context.Context) (any, error) {
+			return obj.UnpausedTimer, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PauseState_unpausedTimer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PauseState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PauseState_pausedTimer(ctx context.Context, field graphql.CollectedField, obj *PauseState) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PauseState_pausedTimer,
+		func(ctx context.Context) (any, error) {
+			return obj.PausedTimer, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PauseState_pausedTimer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PauseState",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_slot(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_slot,
+		func(ctx context.Context) (any, error) {
+			return obj.Slot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_accountNumber(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_accountNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.AccountNumber, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_accountNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_displayName(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_displayName,
+		func(ctx context.Context) (any, error) {
+			return obj.DisplayName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_role(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_role,
+		func(ctx context.Context) (any, error) {
+			return obj.Role, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_jerseyNumber(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_jerseyNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.JerseyNumber, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_jerseyNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_level(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_level,
+		func(ctx context.Context) (any, error) {
+			return obj.Level, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_level(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_ping(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_ping,
+		func(ctx context.Context) (any, error) {
+			return obj.Ping, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_ping(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_stats(ctx context.Context, field graphql.CollectedField, obj *Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_stats,
+		func(ctx context.Context) (any, error) {
+			return obj.Stats, nil
+		},
+		nil,
+		ec.marshalNStats2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "possessionTime":
+				return ec.fieldContext_Stats_possessionTime(ctx, field)
+			case "points":
+				return ec.fieldContext_Stats_points(ctx, field)
+			case "saves":
+				return ec.fieldContext_Stats_saves(ctx, field)
+			case "goals":
+				return ec.fieldContext_Stats_goals(ctx, field)
+			case "stuns":
+				return ec.fieldContext_Stats_stuns(ctx, field)
+			case "passes":
+				return ec.fieldContext_Stats_passes(ctx, field)
+			case "catches":
+				return ec.fieldContext_Stats_catches(ctx, field)
+			case "steals":
+				return ec.fieldContext_Stats_steals(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Stats_blocks(ctx, field)
+			case "interceptions":
+				return ec.fieldContext_Stats_interceptions(ctx, field)
+			case "assists":
+				return ec.fieldContext_Stats_assists(ctx, field)
+			case "shotsTaken":
+				return ec.fieldContext_Stats_shotsTaken(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_player(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerAssist_totalAssists(ctx context.Context, field graphql.CollectedField, obj *PlayerAssist) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerAssist_totalAssists,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAssists, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerAssist_totalAssists(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerAssist",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_player(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerBlock_totalBlocks(ctx context.Context, field graphql.CollectedField, obj *PlayerBlock) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerBlock_totalBlocks,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalBlocks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerBlock_totalBlocks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerBlock",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_player(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_totalGoals(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_totalGoals,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalGoals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_totalGoals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerGoal_points(ctx context.Context, field graphql.CollectedField, obj *PlayerGoal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerGoal_points,
+		func(ctx context.Context) (any, error) {
+			return obj.Points, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerGoal_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerGoal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_player(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerInterception_totalInterceptions(ctx context.Context, field graphql.CollectedField, obj *PlayerInterception) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerInterception_totalInterceptions,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalInterceptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerInterception_totalInterceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerInterception",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerJoined_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerJoined) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerJoined_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerJoined_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerJoined",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerJoined_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerJoined) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerJoined_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerJoined_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerJoined",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerJoined_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerJoined) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerJoined_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerJoined_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerJoined",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerJoined_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerJoined) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerJoined_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerJoined_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerJoined",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerJoined_player(ctx context.Context, field graphql.CollectedField, obj *PlayerJoined) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerJoined_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalNPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerJoined_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerJoined",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerJoined_role(ctx context.Context, field graphql.CollectedField, obj *PlayerJoined) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerJoined_role,
+		func(ctx context.Context) (any, error) {
+			return obj.Role, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerJoined_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerJoined",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerLeft_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerLeft) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerLeft_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerLeft_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerLeft",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerLeft_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerLeft) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerLeft_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerLeft_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerLeft",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerLeft_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerLeft) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerLeft_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerLeft_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerLeft",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerLeft_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerLeft) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerLeft_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerLeft_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerLeft",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerLeft_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerLeft) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerLeft_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerLeft_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerLeft",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerLeft_displayName(ctx context.Context, field graphql.CollectedField, obj *PlayerLeft) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerLeft_displayName,
+		func(ctx context.Context) (any, error) {
+			return obj.DisplayName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerLeft_displayName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerLeft",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_player(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerPass_totalPasses(ctx context.Context, field graphql.CollectedField, obj *PlayerPass) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerPass_totalPasses,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalPasses, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerPass_totalPasses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerPass",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_player(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSave_totalSaves(ctx context.Context, field graphql.CollectedField, obj *PlayerSave) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSave_totalSaves,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalSaves, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSave_totalSaves(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSave",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_player(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerShotTaken_totalShots(ctx context.Context, field graphql.CollectedField, obj *PlayerShotTaken) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerShotTaken_totalShots,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalShots, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerShotTaken_totalShots(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerShotTaken",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_player(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSteal_totalSteals(ctx context.Context, field graphql.CollectedField, obj *PlayerSteal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSteal_totalSteals,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalSteals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSteal_totalSteals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSteal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_player(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerStun_totalStuns(ctx context.Context, field graphql.CollectedField, obj *PlayerStun) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerStun_totalStuns,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalStuns, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerStun_totalStuns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerStun",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_matchId(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_frameIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_eventIndex(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_timestamp(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_playerSlot(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_playerSlot,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayerSlot, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_playerSlot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_player(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_player,
+		func(ctx context.Context) (any, error) {
+			return obj.Player, nil
+		},
+		nil,
+		ec.marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_newRole(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_newRole,
+		func(ctx context.Context) (any, error) {
+			return obj.NewRole, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_newRole(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayerSwitchedTeam_prevRole(ctx context.Context, field graphql.CollectedField, obj *PlayerSwitchedTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayerSwitchedTeam_prevRole,
+		func(ctx context.Context) (any, error) {
+			return obj.PrevRole, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayerSwitchedTeam_prevRole(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayerSwitchedTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_lobbySession(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_lobbySession,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().LobbySession(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOLobbySession2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐLobbySession,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_lobbySession(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_LobbySession_id(ctx, field)
+			case "lobbySessionId":
+				return ec.fieldContext_LobbySession_lobbySessionId(ctx, field)
+			case "frames":
+				return ec.fieldContext_LobbySession_frames(ctx, field)
+			case "events":
+				return ec.fieldContext_LobbySession_events(ctx, field)
+			case "players":
+				return ec.fieldContext_LobbySession_players(ctx, field)
+			case "teams":
+				return ec.fieldContext_LobbySession_teams(ctx, field)
+			case "scoreboard":
+				return ec.fieldContext_LobbySession_scoreboard(ctx, field)
+			case "rounds":
+				return ec.fieldContext_LobbySession_rounds(ctx, field)
+			case "totalEvents":
+				return ec.fieldContext_LobbySession_totalEvents(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_LobbySession_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_LobbySession_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LobbySession", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_lobbySession_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_sessionEvents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_sessionEvents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().SessionEvents(ctx, fc.Args["lobbySessionId"].(string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNSessionEventConnection2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventConnection,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_sessionEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "edges":
+				return ec.fieldContext_SessionEventConnection_edges(ctx, field)
+			case "pageInfo":
+				return ec.fieldContext_SessionEventConnection_pageInfo(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_SessionEventConnection_totalCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionEventConnection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_sessionEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_health(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_health,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Health(ctx)
+		},
+		nil,
+		ec.marshalNHealthStatus2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐHealthStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_health(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_HealthStatus_status(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_HealthStatus_timestamp(ctx, field)
+			case "database":
+				return ec.fieldContext_HealthStatus_database(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type HealthStatus", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Round_number(ctx context.Context, field graphql.CollectedField, obj *Round) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Round_number,
+		func(ctx context.Context) (any, error) {
+			return obj.Number, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Round_number(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Round",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Round_startFrameIndex(ctx context.Context, field graphql.CollectedField, obj *Round) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Round_startFrameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.StartFrameIndex, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Round_startFrameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Round",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Round_startedAt(ctx context.Context, field graphql.CollectedField, obj *Round) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Round_startedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.StartedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Round_startedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Round",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Round_endFrameIndex(ctx context.Context, field graphql.CollectedField, obj *Round) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Round_endFrameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EndFrameIndex, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Round_endFrameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Round",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Round_endedAt(ctx context.Context, field graphql.CollectedField, obj *Round) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Round_endedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.EndedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Round_endedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Round",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Round_winningTeam(ctx context.Context, field graphql.CollectedField, obj *Round) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Round_winningTeam,
+		func(ctx context.Context) (any, error) {
+			return obj.WinningTeam, nil
+		},
+		nil,
+		ec.marshalORole2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Round_winningTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Round",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundEnded_matchId(ctx context.Context, field graphql.CollectedField, obj *RoundEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundEnded_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundEnded_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundEnded_frameIndex(ctx context.Context, field graphql.CollectedField, obj *RoundEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundEnded_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundEnded_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundEnded_eventIndex(ctx context.Context, field graphql.CollectedField, obj *RoundEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundEnded_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundEnded_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundEnded_timestamp(ctx context.Context, field graphql.CollectedField, obj *RoundEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundEnded_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundEnded_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundEnded_roundNumber(ctx context.Context, field graphql.CollectedField, obj *RoundEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundEnded_roundNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundNumber, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundEnded_roundNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundEnded_winningTeam(ctx context.Context, field graphql.CollectedField, obj *RoundEnded) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundEnded_winningTeam,
+		func(ctx context.Context) (any, error) {
+			return obj.WinningTeam, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundEnded_winningTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundEnded",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundPaused_matchId(ctx context.Context, field graphql.CollectedField, obj *RoundPaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundPaused_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundPaused_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundPaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundPaused_frameIndex(ctx context.Context, field graphql.CollectedField, obj *RoundPaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundPaused_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundPaused_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundPaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundPaused_eventIndex(ctx context.Context, field graphql.CollectedField, obj *RoundPaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundPaused_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundPaused_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundPaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundPaused_timestamp(ctx context.Context, field graphql.CollectedField, obj *RoundPaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundPaused_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundPaused_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundPaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundPaused_pause(ctx context.Context, field graphql.CollectedField, obj *RoundPaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundPaused_pause,
+		func(ctx context.Context) (any, error) {
+			return obj.Pause, nil
+		},
+		nil,
+		ec.marshalOPauseState2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPauseState,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundPaused_pause(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundPaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "pausedState":
+				return ec.fieldContext_PauseState_pausedState(ctx, field)
+			case "unpausedTeam":
+				return ec.fieldContext_PauseState_unpausedTeam(ctx, field)
+			case "pausedRequestedTeam":
+				return ec.fieldContext_PauseState_pausedRequestedTeam(ctx, field)
+			case "unpausedTimer":
+				return ec.fieldContext_PauseState_unpausedTimer(ctx, field)
+			case "pausedTimer":
+				return ec.fieldContext_PauseState_pausedTimer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PauseState", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundStarted_matchId(ctx context.Context, field graphql.CollectedField, obj *RoundStarted) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundStarted_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundStarted_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundStarted",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundStarted_frameIndex(ctx context.Context, field graphql.CollectedField, obj *RoundStarted) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundStarted_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundStarted_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundStarted",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundStarted_eventIndex(ctx context.Context, field graphql.CollectedField, obj *RoundStarted) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundStarted_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundStarted_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundStarted",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundStarted_timestamp(ctx context.Context, field graphql.CollectedField, obj *RoundStarted) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundStarted_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundStarted_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundStarted",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundStarted_roundNumber(ctx context.Context, field graphql.CollectedField, obj *RoundStarted) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundStarted_roundNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.RoundNumber, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundStarted_roundNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundStarted",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundUnpaused_matchId(ctx context.Context, field graphql.CollectedField, obj *RoundUnpaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundUnpaused_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundUnpaused_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundUnpaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundUnpaused_frameIndex(ctx context.Context, field graphql.CollectedField, obj *RoundUnpaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundUnpaused_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundUnpaused_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundUnpaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundUnpaused_eventIndex(ctx context.Context, field graphql.CollectedField, obj *RoundUnpaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundUnpaused_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundUnpaused_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundUnpaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundUnpaused_timestamp(ctx context.Context, field graphql.CollectedField, obj *RoundUnpaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundUnpaused_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundUnpaused_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundUnpaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RoundUnpaused_pause(ctx context.Context, field graphql.CollectedField, obj *RoundUnpaused) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RoundUnpaused_pause,
+		func(ctx context.Context) (any, error) {
+			return obj.Pause, nil
+		},
+		nil,
+		ec.marshalOPauseState2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPauseState,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RoundUnpaused_pause(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RoundUnpaused",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "pausedState":
+				return ec.fieldContext_PauseState_pausedState(ctx, field)
+			case "unpausedTeam":
+				return ec.fieldContext_PauseState_unpausedTeam(ctx, field)
+			case "pausedRequestedTeam":
+				return ec.fieldContext_PauseState_pausedRequestedTeam(ctx, field)
+			case "unpausedTimer":
+				return ec.fieldContext_PauseState_unpausedTimer(ctx, field)
+			case "pausedTimer":
+				return ec.fieldContext_PauseState_pausedTimer(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PauseState", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_discSpeed(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_discSpeed,
+		func(ctx context.Context) (any, error) {
+			return obj.DiscSpeed, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_discSpeed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_team(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_team,
+		func(ctx context.Context) (any, error) {
+			return obj.Team, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_goalType(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_goalType,
+		func(ctx context.Context) (any, error) {
+			return obj.GoalType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_goalType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_pointAmount(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_pointAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.PointAmount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_pointAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_distanceThrown(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_distanceThrown,
+		func(ctx context.Context) (any, error) {
+			return obj.DistanceThrown, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_distanceThrown(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_personScored(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_personScored,
+		func(ctx context.Context) (any, error) {
+			return obj.PersonScored, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_personScored(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreDetails_assistScored(ctx context.Context, field graphql.CollectedField, obj *ScoreDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreDetails_assistScored,
+		func(ctx context.Context) (any, error) {
+			return obj.AssistScored, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreDetails_assistScored(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_bluePoints(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_bluePoints,
+		func(ctx context.Context) (any, error) {
+			return obj.BluePoints, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_bluePoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_orangePoints(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_orangePoints,
+		func(ctx context.Context) (any, error) {
+			return obj.OrangePoints, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_orangePoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_blueRoundScore(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_blueRoundScore,
+		func(ctx context.Context) (any, error) {
+			return obj.BlueRoundScore, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_blueRoundScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_orangeRoundScore(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_orangeRoundScore,
+		func(ctx context.Context) (any, error) {
+			return obj.OrangeRoundScore, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_orangeRoundScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_totalRoundCount(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_totalRoundCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalRoundCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_totalRoundCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_gameClock(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_gameClock,
+		func(ctx context.Context) (any, error) {
+			return obj.GameClock, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_gameClock(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_gameClockDisplay(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_gameClockDisplay,
+		func(ctx context.Context) (any, error) {
+			return obj.GameClockDisplay, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_gameClockDisplay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Scoreboard_gameStatus(ctx context.Context, field graphql.CollectedField, obj *Scoreboard) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Scoreboard_gameStatus,
+		func(ctx context.Context) (any, error) {
+			return obj.GameStatus, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Scoreboard_gameStatus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Scoreboard",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_matchId(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_matchId,
+		func(ctx context.Context) (any, error) {
+			return obj.MatchID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_matchId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_frameIndex(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_eventIndex(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_eventIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.EventIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_eventIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_timestamp(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_bluePoints(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_bluePoints,
+		func(ctx context.Context) (any, error) {
+			return obj.BluePoints, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_bluePoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_orangePoints(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_orangePoints,
+		func(ctx context.Context) (any, error) {
+			return obj.OrangePoints, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_orangePoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_blueRoundScore(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_blueRoundScore,
+		func(ctx context.Context) (any, error) {
+			return obj.BlueRoundScore, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_blueRoundScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_orangeRoundScore(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_orangeRoundScore,
+		func(ctx context.Context) (any, error) {
+			return obj.OrangeRoundScore, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_orangeRoundScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ScoreboardUpdated_gameClockDisplay(ctx context.Context, field graphql.CollectedField, obj *ScoreboardUpdated) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ScoreboardUpdated_gameClockDisplay,
+		func(ctx context.Context) (any, error) {
+			return obj.GameClockDisplay, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ScoreboardUpdated_gameClockDisplay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ScoreboardUpdated",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_id(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_lobbySessionId(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_lobbySessionId,
+		func(ctx context.Context) (any, error) {
+			return obj.LobbySessionID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_lobbySessionId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_userId(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_userId,
+		func(ctx context.Context) (any, error) {
+			return obj.UserID, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_userId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_frameIndex(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_frameIndex,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameIndex, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_frameIndex(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_frameData(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_frameData,
+		func(ctx context.Context) (any, error) {
+			return obj.FrameData, nil
+		},
+		nil,
+		ec.marshalOJSON2map,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_frameData(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JSON does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_players(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_players,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.SessionEvent().Players(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_teams(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_teams,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.SessionEvent().Teams(ctx, obj)
+		},
+		nil,
+		ec.marshalNTeam2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐTeamᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_teams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "role":
+				return ec.fieldContext_Team_role(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "hasPossession":
+				return ec.fieldContext_Team_hasPossession(ctx, field)
+			case "stats":
+				return ec.fieldContext_Team_stats(ctx, field)
+			case "players":
+				return ec.fieldContext_Team_players(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_scoreboard(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_scoreboard,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.SessionEvent().Scoreboard(ctx, obj)
+		},
+		nil,
+		ec.marshalOScoreboard2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐScoreboard,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_scoreboard(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "bluePoints":
+				return ec.fieldContext_Scoreboard_bluePoints(ctx, field)
+			case "orangePoints":
+				return ec.fieldContext_Scoreboard_orangePoints(ctx, field)
+			case "blueRoundScore":
+				return ec.fieldContext_Scoreboard_blueRoundScore(ctx, field)
+			case "orangeRoundScore":
+				return ec.fieldContext_Scoreboard_orangeRoundScore(ctx, field)
+			case "totalRoundCount":
+				return ec.fieldContext_Scoreboard_totalRoundCount(ctx, field)
+			case "gameClock":
+				return ec.fieldContext_Scoreboard_gameClock(ctx, field)
+			case "gameClockDisplay":
+				return ec.fieldContext_Scoreboard_gameClockDisplay(ctx, field)
+			case "gameStatus":
+				return ec.fieldContext_Scoreboard_gameStatus(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Scoreboard", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_events(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_events,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.SessionEvent().Events(ctx, obj, fc.Args["types"].([]string))
+		},
+		nil,
+		ec.marshalNMatchEvent2ᚕgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MatchEvent does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_SessionEvent_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_timestamp(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEvent_updatedAt(ctx context.Context, field graphql.CollectedField, obj *SessionEvent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEvent_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEvent_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEvent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEventConnection_edges(ctx context.Context, field graphql.CollectedField, obj *SessionEventConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEventConnection_edges,
+		func(ctx context.Context) (any, error) {
+			return obj.Edges, nil
+		},
+		nil,
+		ec.marshalNSessionEventEdge2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventEdgeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEventConnection_edges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEventConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_SessionEventEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_SessionEventEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionEventEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEventConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *SessionEventConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEventConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEventConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEventConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEventConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *SessionEventConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEventConnection_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEventConnection_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEventConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEventEdge_node(ctx context.Context, field graphql.CollectedField, obj *SessionEventEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEventEdge_node,
+		func(ctx context.Context) (any, error) {
+			return obj.Node, nil
+		},
+		nil,
+		ec.marshalNSessionEvent2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEventEdge_node(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEventEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SessionEvent_id(ctx, field)
+			case "lobbySessionId":
+				return ec.fieldContext_SessionEvent_lobbySessionId(ctx, field)
+			case "userId":
+				return ec.fieldContext_SessionEvent_userId(ctx, field)
+			case "frameIndex":
+				return ec.fieldContext_SessionEvent_frameIndex(ctx, field)
+			case "frameData":
+				return ec.fieldContext_SessionEvent_frameData(ctx, field)
+			case "players":
+				return ec.fieldContext_SessionEvent_players(ctx, field)
+			case "teams":
+				return ec.fieldContext_SessionEvent_teams(ctx, field)
+			case "scoreboard":
+				return ec.fieldContext_SessionEvent_scoreboard(ctx, field)
+			case "events":
+				return ec.fieldContext_SessionEvent_events(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_SessionEvent_timestamp(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SessionEvent_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SessionEvent_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SessionEventEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *SessionEventEdge) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SessionEventEdge_cursor,
+		func(ctx context.Context) (any, error) {
+			return obj.Cursor, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SessionEventEdge_cursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SessionEventEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_possessionTime(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_possessionTime,
+		func(ctx context.Context) (any, error) {
+			return obj.PossessionTime, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_possessionTime(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_points(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_points,
+		func(ctx context.Context) (any, error) {
+			return obj.Points, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_saves(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_saves,
+		func(ctx context.Context) (any, error) {
+			return obj.Saves, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_saves(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_goals(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_goals,
+		func(ctx context.Context) (any, error) {
+			return obj.Goals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_goals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_stuns(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_stuns,
+		func(ctx context.Context) (any, error) {
+			return obj.Stuns, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_stuns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_passes(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_passes,
+		func(ctx context.Context) (any, error) {
+			return obj.Passes, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_passes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_catches(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_catches,
+		func(ctx context.Context) (any, error) {
+			return obj.Catches, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_catches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_steals(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_steals,
+		func(ctx context.Context) (any, error) {
+			return obj.Steals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_steals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_blocks(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_blocks,
+		func(ctx context.Context) (any, error) {
+			return obj.Blocks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_blocks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_interceptions(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_interceptions,
+		func(ctx context.Context) (any, error) {
+			return obj.Interceptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_interceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_assists(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_assists,
+		func(ctx context.Context) (any, error) {
+			return obj.Assists, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_assists(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Stats_shotsTaken(ctx context.Context, field graphql.CollectedField, obj *Stats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Stats_shotsTaken,
+		func(ctx context.Context) (any, error) {
+			return obj.ShotsTaken, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Stats_shotsTaken(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Stats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoreSessionEventPayload_success(ctx context.Context, field graphql.CollectedField, obj *StoreSessionEventPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StoreSessionEventPayload_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StoreSessionEventPayload_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoreSessionEventPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoreSessionEventPayload_event(ctx context.Context, field graphql.CollectedField, obj *StoreSessionEventPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StoreSessionEventPayload_event,
+		func(ctx context.Context) (any, error) {
+			return obj.Event, nil
+		},
+		nil,
+		ec.marshalOSessionEvent2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEvent,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_StoreSessionEventPayload_event(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoreSessionEventPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_SessionEvent_id(ctx, field)
+			case "lobbySessionId":
+				return ec.fieldContext_SessionEvent_lobbySessionId(ctx, field)
+			case "userId":
+				return ec.fieldContext_SessionEvent_userId(ctx, field)
+			case "frameIndex":
+				return ec.fieldContext_SessionEvent_frameIndex(ctx, field)
+			case "frameData":
+				return ec.fieldContext_SessionEvent_frameData(ctx, field)
+			case "players":
+				return ec.fieldContext_SessionEvent_players(ctx, field)
+			case "teams":
+				return ec.fieldContext_SessionEvent_teams(ctx, field)
+			case "scoreboard":
+				return ec.fieldContext_SessionEvent_scoreboard(ctx, field)
+			case "events":
+				return ec.fieldContext_SessionEvent_events(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_SessionEvent_timestamp(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_SessionEvent_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_SessionEvent_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SessionEvent", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoreSessionEventPayload_error(ctx context.Context, field graphql.CollectedField, obj *StoreSessionEventPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StoreSessionEventPayload_error,
+		func(ctx context.Context) (any, error) {
+			return obj.Error, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_StoreSessionEventPayload_error(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoreSessionEventPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _StoreSessionEventPayload_duplicate(ctx context.Context, field graphql.CollectedField, obj *StoreSessionEventPayload) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_StoreSessionEventPayload_duplicate,
+		func(ctx context.Context) (any, error) {
+			return obj.Duplicate, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_StoreSessionEventPayload_duplicate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "StoreSessionEventPayload",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_matchFrames(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_matchFrames,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().MatchFrames(ctx, fc.Args["matchId"].(string), fc.Args["fps"].(*int))
+		},
+		nil,
+		ec.marshalNMatchFrame2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchFrame,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_matchFrames(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_MatchFrame_matchId(ctx, field)
+			case "frameIndex":
+				return ec.fieldContext_MatchFrame_frameIndex(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_MatchFrame_timestamp(ctx, field)
+			case "frameData":
+				return ec.fieldContext_MatchFrame_frameData(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MatchFrame", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_matchFrames_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_matchEvents(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_matchEvents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Subscription().MatchEvents(ctx, fc.Args["matchId"].(string), fc.Args["types"].([]string))
+		},
+		nil,
+		ec.marshalNMatchEvent2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_matchEvents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MatchEvent does not have child fields")
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_matchEvents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_liveMatches(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_liveMatches,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Subscription().LiveMatches(ctx)
+		},
+		nil,
+		ec.marshalNLiveMatch2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐLiveMatchᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_liveMatches(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "matchId":
+				return ec.fieldContext_LiveMatch_matchId(ctx, field)
+			case "mapName":
+				return ec.fieldContext_LiveMatch_mapName(ctx, field)
+			case "matchType":
+				return ec.fieldContext_LiveMatch_matchType(ctx, field)
+			case "gameStatus":
+				return ec.fieldContext_LiveMatch_gameStatus(ctx, field)
+			case "startedAt":
+				return ec.fieldContext_LiveMatch_startedAt(ctx, field)
+			case "lastFrameAt":
+				return ec.fieldContext_LiveMatch_lastFrameAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LiveMatch", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_role(ctx context.Context, field graphql.CollectedField, obj *Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_role,
+		func(ctx context.Context) (any, error) {
+			return obj.Role, nil
+		},
+		nil,
+		ec.marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_role(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Role does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_name(ctx context.Context, field graphql.CollectedField, obj *Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_hasPossession(ctx context.Context, field graphql.CollectedField, obj *Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_hasPossession,
+		func(ctx context.Context) (any, error) {
+			return obj.HasPossession, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_hasPossession(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_stats(ctx context.Context, field graphql.CollectedField, obj *Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_stats,
+		func(ctx context.Context) (any, error) {
+			return obj.Stats, nil
+		},
+		nil,
+		ec.marshalNStats2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStats,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "possessionTime":
+				return ec.fieldContext_Stats_possessionTime(ctx, field)
+			case "points":
+				return ec.fieldContext_Stats_points(ctx, field)
+			case "saves":
+				return ec.fieldContext_Stats_saves(ctx, field)
+			case "goals":
+				return ec.fieldContext_Stats_goals(ctx, field)
+			case "stuns":
+				return ec.fieldContext_Stats_stuns(ctx, field)
+			case "passes":
+				return ec.fieldContext_Stats_passes(ctx, field)
+			case "catches":
+				return ec.fieldContext_Stats_catches(ctx, field)
+			case "steals":
+				return ec.fieldContext_Stats_steals(ctx, field)
+			case "blocks":
+				return ec.fieldContext_Stats_blocks(ctx, field)
+			case "interceptions":
+				return ec.fieldContext_Stats_interceptions(ctx, field)
+			case "assists":
+				return ec.fieldContext_Stats_assists(ctx, field)
+			case "shotsTaken":
+				return ec.fieldContext_Stats_shotsTaken(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Stats", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_players(ctx context.Context, field graphql.CollectedField, obj *Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_players,
+		func(ctx context.Context) (any, error) {
+			return obj.Players, nil
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "slot":
+				return ec.fieldContext_Player_slot(ctx, field)
+			case "accountNumber":
+				return ec.fieldContext_Player_accountNumber(ctx, field)
+			case "displayName":
+				return ec.fieldContext_Player_displayName(ctx, field)
+			case "role":
+				return ec.fieldContext_Player_role(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "level":
+				return ec.fieldContext_Player_level(ctx, field)
+			case "ping":
+				return ec.fieldContext_Player_ping(ctx, field)
+			case "stats":
+				return ec.fieldContext_Player_stats(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_armSpeed(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_armSpeed,
+		func(ctx context.Context) (any, error) {
+			return obj.ArmSpeed, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_armSpeed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_totalSpeed(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_totalSpeed,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalSpeed, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_totalSpeed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_offAxisSpinDeg(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_offAxisSpinDeg,
+		func(ctx context.Context) (any, error) {
+			return obj.OffAxisSpinDeg, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_offAxisSpinDeg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_wristThrowPenalty(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_wristThrowPenalty,
+		func(ctx context.Context) (any, error) {
+			return obj.WristThrowPenalty, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_wristThrowPenalty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_rotPerSec(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_rotPerSec,
+		func(ctx context.Context) (any, error) {
+			return obj.RotPerSec, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_rotPerSec(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_potSpeedFromRot(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_potSpeedFromRot,
+		func(ctx context.Context) (any, error) {
+			return obj.PotSpeedFromRot, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_potSpeedFromRot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_speedFromArm(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_speedFromArm,
+		func(ctx context.Context) (any, error) {
+			return obj.SpeedFromArm, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_speedFromArm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_speedFromMovement(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_speedFromMovement,
+		func(ctx context.Context) (any, error) {
+			return obj.SpeedFromMovement, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_speedFromMovement(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_speedFromWrist(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_speedFromWrist,
+		func(ctx context.Context) (any, error) {
+			return obj.SpeedFromWrist, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_speedFromWrist(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_wristAlignToThrowDeg(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_wristAlignToThrowDeg,
+		func(ctx context.Context) (any, error) {
+			return obj.WristAlignToThrowDeg, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_wristAlignToThrowDeg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_throwAlignToMovementDeg(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_throwAlignToMovementDeg,
+		func(ctx context.Context) (any, error) {
+			return obj.ThrowAlignToMovementDeg, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_throwAlignToMovementDeg(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_offAxisPenalty(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_offAxisPenalty,
+		func(ctx context.Context) (any, error) {
+			return obj.OffAxisPenalty, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_offAxisPenalty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ThrowDetails_throwMovePenalty(ctx context.Context, field graphql.CollectedField, obj *ThrowDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ThrowDetails_throwMovePenalty,
+		func(ctx context.Context) (any, error) {
+			return obj.ThrowMovePenalty, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ThrowDetails_throwMovePenalty(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ThrowDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputStoreSessionEventInput(ctx context.Context, obj any) (StoreSessionEventInput, error) {
+	var it StoreSessionEventInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"lobbySessionId", "userId", "frameData"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "lobbySessionId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lobbySessionId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.LobbySessionID = data
+		case "userId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserID = data
+		case "frameData":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("frameData"))
+			data, err := ec.unmarshalNJSON2map(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FrameData = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _Event(ctx context.Context, sel ast.SelectionSet, obj Event) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case ScoreboardUpdated:
+		return ec._ScoreboardUpdated(ctx, sel, &obj)
+	case *ScoreboardUpdated:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ScoreboardUpdated(ctx, sel, obj)
+	case RoundUnpaused:
+		return ec._RoundUnpaused(ctx, sel, &obj)
+	case *RoundUnpaused:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundUnpaused(ctx, sel, obj)
+	case RoundStarted:
+		return ec._RoundStarted(ctx, sel, &obj)
+	case *RoundStarted:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundStarted(ctx, sel, obj)
+	case RoundPaused:
+		return ec._RoundPaused(ctx, sel, &obj)
+	case *RoundPaused:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundPaused(ctx, sel, obj)
+	case RoundEnded:
+		return ec._RoundEnded(ctx, sel, &obj)
+	case *RoundEnded:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundEnded(ctx, sel, obj)
+	case PlayerSwitchedTeam:
+		return ec._PlayerSwitchedTeam(ctx, sel, &obj)
+	case *PlayerSwitchedTeam:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerSwitchedTeam(ctx, sel, obj)
+	case PlayerStun:
+		return ec._PlayerStun(ctx, sel, &obj)
+	case *PlayerStun:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerStun(ctx, sel, obj)
+	case PlayerSteal:
+		return ec._PlayerSteal(ctx, sel, &obj)
+	case *PlayerSteal:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerSteal(ctx, sel, obj)
+	case PlayerShotTaken:
+		return ec._PlayerShotTaken(ctx, sel, &obj)
+	case *PlayerShotTaken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerShotTaken(ctx, sel, obj)
+	case PlayerSave:
+		return ec._PlayerSave(ctx, sel, &obj)
+	case *PlayerSave:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerSave(ctx, sel, obj)
+	case PlayerPass:
+		return ec._PlayerPass(ctx, sel, &obj)
+	case *PlayerPass:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerPass(ctx, sel, obj)
+	case PlayerLeft:
+		return ec._PlayerLeft(ctx, sel, &obj)
+	case *PlayerLeft:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerLeft(ctx, sel, obj)
+	case PlayerJoined:
+		return ec._PlayerJoined(ctx, sel, &obj)
+	case *PlayerJoined:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerJoined(ctx, sel, obj)
+	case PlayerInterception:
+		return ec._PlayerInterception(ctx, sel, &obj)
+	case *PlayerInterception:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerInterception(ctx, sel, obj)
+	case PlayerGoal:
+		return ec._PlayerGoal(ctx, sel, &obj)
+	case *PlayerGoal:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerGoal(ctx, sel, obj)
+	case PlayerBlock:
+		return ec._PlayerBlock(ctx, sel, &obj)
+	case *PlayerBlock:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerBlock(ctx, sel, obj)
+	case PlayerAssist:
+		return ec._PlayerAssist(ctx, sel, &obj)
+	case *PlayerAssist:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerAssist(ctx, sel, obj)
+	case MatchEnded:
+		return ec._MatchEnded(ctx, sel, &obj)
+	case *MatchEnded:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._MatchEnded(ctx, sel, obj)
+	case GoalScored:
+		return ec._GoalScored(ctx, sel, &obj)
+	case *GoalScored:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GoalScored(ctx, sel, obj)
+	case EmotePlayed:
+		return ec._EmotePlayed(ctx, sel, &obj)
+	case *EmotePlayed:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmotePlayed(ctx, sel, obj)
+	case DiscThrown:
+		return ec._DiscThrown(ctx, sel, &obj)
+	case *DiscThrown:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscThrown(ctx, sel, obj)
+	case DiscPossessionChanged:
+		return ec._DiscPossessionChanged(ctx, sel, &obj)
+	case *DiscPossessionChanged:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscPossessionChanged(ctx, sel, obj)
+	case DiscCaught:
+		return ec._DiscCaught(ctx, sel, &obj)
+	case *DiscCaught:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscCaught(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+func (ec *executionContext) _MatchEvent(ctx context.Context, sel ast.SelectionSet, obj MatchEvent) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case ScoreboardUpdated:
+		return ec._ScoreboardUpdated(ctx, sel, &obj)
+	case *ScoreboardUpdated:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ScoreboardUpdated(ctx, sel, obj)
+	case RoundUnpaused:
+		return ec._RoundUnpaused(ctx, sel, &obj)
+	case *RoundUnpaused:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundUnpaused(ctx, sel, obj)
+	case RoundStarted:
+		return ec._RoundStarted(ctx, sel, &obj)
+	case *RoundStarted:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundStarted(ctx, sel, obj)
+	case RoundPaused:
+		return ec._RoundPaused(ctx, sel, &obj)
+	case *RoundPaused:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundPaused(ctx, sel, obj)
+	case RoundEnded:
+		return ec._RoundEnded(ctx, sel, &obj)
+	case *RoundEnded:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._RoundEnded(ctx, sel, obj)
+	case PlayerSwitchedTeam:
+		return ec._PlayerSwitchedTeam(ctx, sel, &obj)
+	case *PlayerSwitchedTeam:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerSwitchedTeam(ctx, sel, obj)
+	case PlayerStun:
+		return ec._PlayerStun(ctx, sel, &obj)
+	case *PlayerStun:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerStun(ctx, sel, obj)
+	case PlayerSteal:
+		return ec._PlayerSteal(ctx, sel, &obj)
+	case *PlayerSteal:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerSteal(ctx, sel, obj)
+	case PlayerShotTaken:
+		return ec._PlayerShotTaken(ctx, sel, &obj)
+	case *PlayerShotTaken:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerShotTaken(ctx, sel, obj)
+	case PlayerSave:
+		return ec._PlayerSave(ctx, sel, &obj)
+	case *PlayerSave:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerSave(ctx, sel, obj)
+	case PlayerPass:
+		return ec._PlayerPass(ctx, sel, &obj)
+	case *PlayerPass:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerPass(ctx, sel, obj)
+	case PlayerLeft:
+		return ec._PlayerLeft(ctx, sel, &obj)
+	case *PlayerLeft:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerLeft(ctx, sel, obj)
+	case PlayerJoined:
+		return ec._PlayerJoined(ctx, sel, &obj)
+	case *PlayerJoined:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerJoined(ctx, sel, obj)
+	case PlayerInterception:
+		return ec._PlayerInterception(ctx, sel, &obj)
+	case *PlayerInterception:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerInterception(ctx, sel, obj)
+	case PlayerGoal:
+		return ec._PlayerGoal(ctx, sel, &obj)
+	case *PlayerGoal:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerGoal(ctx, sel, obj)
+	case PlayerBlock:
+		return ec._PlayerBlock(ctx, sel, &obj)
+	case *PlayerBlock:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerBlock(ctx, sel, obj)
+	case PlayerAssist:
+		return ec._PlayerAssist(ctx, sel, &obj)
+	case *PlayerAssist:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._PlayerAssist(ctx, sel, obj)
+	case MatchEnded:
+		return ec._MatchEnded(ctx, sel, &obj)
+	case *MatchEnded:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._MatchEnded(ctx, sel, obj)
+	case GoalScored:
+		return ec._GoalScored(ctx, sel, &obj)
+	case *GoalScored:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._GoalScored(ctx, sel, obj)
+	case EmotePlayed:
+		return ec._EmotePlayed(ctx, sel, &obj)
+	case *EmotePlayed:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._EmotePlayed(ctx, sel, obj)
+	case DiscThrown:
+		return ec._DiscThrown(ctx, sel, &obj)
+	case *DiscThrown:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscThrown(ctx, sel, obj)
+	case DiscPossessionChanged:
+		return ec._DiscPossessionChanged(ctx, sel, &obj)
+	case *DiscPossessionChanged:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscPossessionChanged(ctx, sel, obj)
+	case DiscCaught:
+		return ec._DiscCaught(ctx, sel, &obj)
+	case *DiscCaught:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._DiscCaught(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var discCaughtImplementors = []string{"DiscCaught", "Event", "MatchEvent"}
+
+func (ec *executionContext) _DiscCaught(ctx context.Context, sel ast.SelectionSet, obj *DiscCaught) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discCaughtImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscCaught")
+		case "matchId":
+			out.Values[i] = ec._DiscCaught_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._DiscCaught_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._DiscCaught_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._DiscCaught_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._DiscCaught_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._DiscCaught_player(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var discPossessionChangedImplementors = []string{"DiscPossessionChanged", "Event", "MatchEvent"}
+
+func (ec *executionContext) _DiscPossessionChanged(ctx context.Context, sel ast.SelectionSet, obj *DiscPossessionChanged) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discPossessionChangedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscPossessionChanged")
+		case "matchId":
+			out.Values[i] = ec._DiscPossessionChanged_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._DiscPossessionChanged_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._DiscPossessionChanged_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._DiscPossessionChanged_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._DiscPossessionChanged_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._DiscPossessionChanged_player(ctx, field, obj)
+		case "previousPlayerSlot":
+			out.Values[i] = ec._DiscPossessionChanged_previousPlayerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "previousPlayer":
+			out.Values[i] = ec._DiscPossessionChanged_previousPlayer(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var discThrownImplementors = []string{"DiscThrown", "Event", "MatchEvent"}
+
+func (ec *executionContext) _DiscThrown(ctx context.Context, sel ast.SelectionSet, obj *DiscThrown) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, discThrownImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DiscThrown")
+		case "matchId":
+			out.Values[i] = ec._DiscThrown_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._DiscThrown_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._DiscThrown_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._DiscThrown_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._DiscThrown_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._DiscThrown_player(ctx, field, obj)
+		case "throwDetails":
+			out.Values[i] = ec._DiscThrown_throwDetails(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var emotePlayedImplementors = []string{"EmotePlayed", "Event", "MatchEvent"}
+
+func (ec *executionContext) _EmotePlayed(ctx context.Context, sel ast.SelectionSet, obj *EmotePlayed) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, emotePlayedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EmotePlayed")
+		case "matchId":
+			out.Values[i] = ec._EmotePlayed_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._EmotePlayed_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._EmotePlayed_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._EmotePlayed_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._EmotePlayed_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._EmotePlayed_player(ctx, field, obj)
+		case "emote":
+			out.Values[i] = ec._EmotePlayed_emote(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var goalScoredImplementors = []string{"GoalScored", "Event", "MatchEvent"}
+
+func (ec *executionContext) _GoalScored(ctx context.Context, sel ast.SelectionSet, obj *GoalScored) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, goalScoredImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("GoalScored")
+		case "matchId":
+			out.Values[i] = ec._GoalScored_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._GoalScored_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._GoalScored_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._GoalScored_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "scoreDetails":
+			out.Values[i] = ec._GoalScored_scoreDetails(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var healthStatusImplementors = []string{"HealthStatus"}
+
+func (ec *executionContext) _HealthStatus(ctx context.Context, sel ast.SelectionSet, obj *HealthStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, healthStatusImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HealthStatus")
+		case "status":
+			out.Values[i] = ec._HealthStatus_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._HealthStatus_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "database":
+			out.Values[i] = ec._HealthStatus_database(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var liveMatchImplementors = []string{"LiveMatch"}
+
+func (ec *executionContext) _LiveMatch(ctx context.Context, sel ast.SelectionSet, obj *LiveMatch) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, liveMatchImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LiveMatch")
+		case "matchId":
+			out.Values[i] = ec._LiveMatch_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mapName":
+			out.Values[i] = ec._LiveMatch_mapName(ctx, field, obj)
+		case "matchType":
+			out.Values[i] = ec._LiveMatch_matchType(ctx, field, obj)
+		case "gameStatus":
+			out.Values[i] = ec._LiveMatch_gameStatus(ctx, field, obj)
+		case "startedAt":
+			out.Values[i] = ec._LiveMatch_startedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastFrameAt":
+			out.Values[i] = ec._LiveMatch_lastFrameAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var lobbySessionImplementors = []string{"LobbySession"}
+
+func (ec *executionContext) _LobbySession(ctx context.Context, sel ast.SelectionSet, obj *LobbySession) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, lobbySessionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LobbySession")
+		case "id":
+			out.Values[i] = ec._LobbySession_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lobbySessionId":
+			out.Values[i] = ec._LobbySession_lobbySessionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "frames":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LobbySession_frames(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "events":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LobbySession_events(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "players":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LobbySession_players(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "teams":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LobbySession_teams(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "scoreboard":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LobbySession_scoreboard(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "rounds":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._LobbySession_rounds(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "totalEvents":
+			out.Values[i] = ec._LobbySession_totalEvents(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._LobbySession_createdAt(ctx, field, obj)
+		case "updatedAt":
+			out.Values[i] = ec._LobbySession_updatedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var matchEndedImplementors = []string{"MatchEnded", "Event", "MatchEvent"}
+
+func (ec *executionContext) _MatchEnded(ctx context.Context, sel ast.SelectionSet, obj *MatchEnded) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, matchEndedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MatchEnded")
+		case "matchId":
+			out.Values[i] = ec._MatchEnded_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._MatchEnded_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._MatchEnded_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._MatchEnded_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "winningTeam":
+			out.Values[i] = ec._MatchEnded_winningTeam(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var matchFrameImplementors = []string{"MatchFrame"}
+
+func (ec *executionContext) _MatchFrame(ctx context.Context, sel ast.SelectionSet, obj *MatchFrame) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, matchFrameImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MatchFrame")
+		case "matchId":
+			out.Values[i] = ec._MatchFrame_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._MatchFrame_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._MatchFrame_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameData":
+			out.Values[i] = ec._MatchFrame_frameData(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "storeSessionEvent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_storeSessionEvent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPreviousPage":
+			out.Values[i] = ec._PageInfo_hasPreviousPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startCursor":
+			out.Values[i] = ec._PageInfo_startCursor(ctx, field, obj)
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var pauseStateImplementors = []string{"PauseState"}
+
+func (ec *executionContext) _PauseState(ctx context.Context, sel ast.SelectionSet, obj *PauseState) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pauseStateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PauseState")
+		case "pausedState":
+			out.Values[i] = ec._PauseState_pausedState(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unpausedTeam":
+			out.Values[i] = ec._PauseState_unpausedTeam(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pausedRequestedTeam":
+			out.Values[i] = ec._PauseState_pausedRequestedTeam(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unpausedTimer":
+			out.Values[i] = ec._PauseState_unpausedTimer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pausedTimer":
+			out.Values[i] = ec._PauseState_pausedTimer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerImplementors = []string{"Player"}
+
+func (ec *executionContext) _Player(ctx context.Context, sel ast.SelectionSet, obj *Player) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Player")
+		case "slot":
+			out.Values[i] = ec._Player_slot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "accountNumber":
+			out.Values[i] = ec._Player_accountNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._Player_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "role":
+			out.Values[i] = ec._Player_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "jerseyNumber":
+			out.Values[i] = ec._Player_jerseyNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "level":
+			out.Values[i] = ec._Player_level(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ping":
+			out.Values[i] = ec._Player_ping(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stats":
+			out.Values[i] = ec._Player_stats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerAssistImplementors = []string{"PlayerAssist", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerAssist(ctx context.Context, sel ast.SelectionSet, obj *PlayerAssist) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerAssistImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerAssist")
+		case "matchId":
+			out.Values[i] = ec._PlayerAssist_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerAssist_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerAssist_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerAssist_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerAssist_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerAssist_player(ctx, field, obj)
+		case "totalAssists":
+			out.Values[i] = ec._PlayerAssist_totalAssists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerBlockImplementors = []string{"PlayerBlock", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerBlock(ctx context.Context, sel ast.SelectionSet, obj *PlayerBlock) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerBlockImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerBlock")
+		case "matchId":
+			out.Values[i] = ec._PlayerBlock_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerBlock_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerBlock_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerBlock_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerBlock_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerBlock_player(ctx, field, obj)
+		case "totalBlocks":
+			out.Values[i] = ec._PlayerBlock_totalBlocks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerGoalImplementors = []string{"PlayerGoal", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerGoal(ctx context.Context, sel ast.SelectionSet, obj *PlayerGoal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerGoalImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerGoal")
+		case "matchId":
+			out.Values[i] = ec._PlayerGoal_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerGoal_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerGoal_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerGoal_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerGoal_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerGoal_player(ctx, field, obj)
+		case "totalGoals":
+			out.Values[i] = ec._PlayerGoal_totalGoals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "points":
+			out.Values[i] = ec._PlayerGoal_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerInterceptionImplementors = []string{"PlayerInterception", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerInterception(ctx context.Context, sel ast.SelectionSet, obj *PlayerInterception) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerInterceptionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerInterception")
+		case "matchId":
+			out.Values[i] = ec._PlayerInterception_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerInterception_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerInterception_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerInterception_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerInterception_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerInterception_player(ctx, field, obj)
+		case "totalInterceptions":
+			out.Values[i] = ec._PlayerInterception_totalInterceptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerJoinedImplementors = []string{"PlayerJoined", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerJoined(ctx context.Context, sel ast.SelectionSet, obj *PlayerJoined) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerJoinedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerJoined")
+		case "matchId":
+			out.Values[i] = ec._PlayerJoined_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerJoined_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerJoined_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerJoined_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerJoined_player(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "role":
+			out.Values[i] = ec._PlayerJoined_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerLeftImplementors = []string{"PlayerLeft", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerLeft(ctx context.Context, sel ast.SelectionSet, obj *PlayerLeft) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerLeftImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerLeft")
+		case "matchId":
+			out.Values[i] = ec._PlayerLeft_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerLeft_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerLeft_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerLeft_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerLeft_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "displayName":
+			out.Values[i] = ec._PlayerLeft_displayName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerPassImplementors = []string{"PlayerPass", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerPass(ctx context.Context, sel ast.SelectionSet, obj *PlayerPass) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerPassImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerPass")
+		case "matchId":
+			out.Values[i] = ec._PlayerPass_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerPass_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerPass_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerPass_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerPass_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerPass_player(ctx, field, obj)
+		case "totalPasses":
+			out.Values[i] = ec._PlayerPass_totalPasses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerSaveImplementors = []string{"PlayerSave", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerSave(ctx context.Context, sel ast.SelectionSet, obj *PlayerSave) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerSaveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerSave")
+		case "matchId":
+			out.Values[i] = ec._PlayerSave_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerSave_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerSave_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerSave_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerSave_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerSave_player(ctx, field, obj)
+		case "totalSaves":
+			out.Values[i] = ec._PlayerSave_totalSaves(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerShotTakenImplementors = []string{"PlayerShotTaken", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerShotTaken(ctx context.Context, sel ast.SelectionSet, obj *PlayerShotTaken) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerShotTakenImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerShotTaken")
+		case "matchId":
+			out.Values[i] = ec._PlayerShotTaken_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerShotTaken_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerShotTaken_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerShotTaken_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerShotTaken_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerShotTaken_player(ctx, field, obj)
+		case "totalShots":
+			out.Values[i] = ec._PlayerShotTaken_totalShots(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerStealImplementors = []string{"PlayerSteal", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerSteal(ctx context.Context, sel ast.SelectionSet, obj *PlayerSteal) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerStealImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerSteal")
+		case "matchId":
+			out.Values[i] = ec._PlayerSteal_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerSteal_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerSteal_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerSteal_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerSteal_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerSteal_player(ctx, field, obj)
+		case "totalSteals":
+			out.Values[i] = ec._PlayerSteal_totalSteals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerStunImplementors = []string{"PlayerStun", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerStun(ctx context.Context, sel ast.SelectionSet, obj *PlayerStun) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerStunImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerStun")
+		case "matchId":
+			out.Values[i] = ec._PlayerStun_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerStun_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerStun_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerStun_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerStun_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerStun_player(ctx, field, obj)
+		case "totalStuns":
+			out.Values[i] = ec._PlayerStun_totalStuns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerSwitchedTeamImplementors = []string{"PlayerSwitchedTeam", "Event", "MatchEvent"}
+
+func (ec *executionContext) _PlayerSwitchedTeam(ctx context.Context, sel ast.SelectionSet, obj *PlayerSwitchedTeam) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerSwitchedTeamImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayerSwitchedTeam")
+		case "matchId":
+			out.Values[i] = ec._PlayerSwitchedTeam_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._PlayerSwitchedTeam_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._PlayerSwitchedTeam_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PlayerSwitchedTeam_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "playerSlot":
+			out.Values[i] = ec._PlayerSwitchedTeam_playerSlot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "player":
+			out.Values[i] = ec._PlayerSwitchedTeam_player(ctx, field, obj)
+		case "newRole":
+			out.Values[i] = ec._PlayerSwitchedTeam_newRole(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "prevRole":
+			out.Values[i] = ec._PlayerSwitchedTeam_prevRole(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "lobbySession":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_lobbySession(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "sessionEvents":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_sessionEvents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "health":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_health(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var roundImplementors = []string{"Round"}
+
+func (ec *executionContext) _Round(ctx context.Context, sel ast.SelectionSet, obj *Round) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roundImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Round")
+		case "number":
+			out.Values[i] = ec._Round_number(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startFrameIndex":
+			out.Values[i] = ec._Round_startFrameIndex(ctx, field, obj)
+		case "startedAt":
+			out.Values[i] = ec._Round_startedAt(ctx, field, obj)
+		case "endFrameIndex":
+			out.Values[i] = ec._Round_endFrameIndex(ctx, field, obj)
+		case "endedAt":
+			out.Values[i] = ec._Round_endedAt(ctx, field, obj)
+		case "winningTeam":
+			out.Values[i] = ec._Round_winningTeam(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var roundEndedImplementors = []string{"RoundEnded", "Event", "MatchEvent"}
+
+func (ec *executionContext) _RoundEnded(ctx context.Context, sel ast.SelectionSet, obj *RoundEnded) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roundEndedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoundEnded")
+		case "matchId":
+			out.Values[i] = ec._RoundEnded_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._RoundEnded_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._RoundEnded_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._RoundEnded_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roundNumber":
+			out.Values[i] = ec._RoundEnded_roundNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "winningTeam":
+			out.Values[i] = ec._RoundEnded_winningTeam(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var roundPausedImplementors = []string{"RoundPaused", "Event", "MatchEvent"}
+
+func (ec *executionContext) _RoundPaused(ctx context.Context, sel ast.SelectionSet, obj *RoundPaused) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roundPausedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoundPaused")
+		case "matchId":
+			out.Values[i] = ec._RoundPaused_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._RoundPaused_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._RoundPaused_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._RoundPaused_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pause":
+			out.Values[i] = ec._RoundPaused_pause(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var roundStartedImplementors = []string{"RoundStarted", "Event", "MatchEvent"}
+
+func (ec *executionContext) _RoundStarted(ctx context.Context, sel ast.SelectionSet, obj *RoundStarted) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roundStartedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoundStarted")
+		case "matchId":
+			out.Values[i] = ec._RoundStarted_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._RoundStarted_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._RoundStarted_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._RoundStarted_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "roundNumber":
+			out.Values[i] = ec._RoundStarted_roundNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var roundUnpausedImplementors = []string{"RoundUnpaused", "Event", "MatchEvent"}
+
+func (ec *executionContext) _RoundUnpaused(ctx context.Context, sel ast.SelectionSet, obj *RoundUnpaused) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, roundUnpausedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RoundUnpaused")
+		case "matchId":
+			out.Values[i] = ec._RoundUnpaused_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._RoundUnpaused_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._RoundUnpaused_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._RoundUnpaused_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pause":
+			out.Values[i] = ec._RoundUnpaused_pause(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var scoreDetailsImplementors = []string{"ScoreDetails"}
+
+func (ec *executionContext) _ScoreDetails(ctx context.Context, sel ast.SelectionSet, obj *ScoreDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scoreDetailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ScoreDetails")
+		case "discSpeed":
+			out.Values[i] = ec._ScoreDetails_discSpeed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "team":
+			out.Values[i] = ec._ScoreDetails_team(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "goalType":
+			out.Values[i] = ec._ScoreDetails_goalType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pointAmount":
+			out.Values[i] = ec._ScoreDetails_pointAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "distanceThrown":
+			out.Values[i] = ec._ScoreDetails_distanceThrown(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "personScored":
+			out.Values[i] = ec._ScoreDetails_personScored(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assistScored":
+			out.Values[i] = ec._ScoreDetails_assistScored(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var scoreboardImplementors = []string{"Scoreboard"}
+
+func (ec *executionContext) _Scoreboard(ctx context.Context, sel ast.SelectionSet, obj *Scoreboard) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scoreboardImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Scoreboard")
+		case "bluePoints":
+			out.Values[i] = ec._Scoreboard_bluePoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orangePoints":
+			out.Values[i] = ec._Scoreboard_orangePoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blueRoundScore":
+			out.Values[i] = ec._Scoreboard_blueRoundScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orangeRoundScore":
+			out.Values[i] = ec._Scoreboard_orangeRoundScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalRoundCount":
+			out.Values[i] = ec._Scoreboard_totalRoundCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gameClock":
+			out.Values[i] = ec._Scoreboard_gameClock(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gameClockDisplay":
+			out.Values[i] = ec._Scoreboard_gameClockDisplay(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gameStatus":
+			out.Values[i] = ec._Scoreboard_gameStatus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var scoreboardUpdatedImplementors = []string{"ScoreboardUpdated", "Event", "MatchEvent"}
+
+func (ec *executionContext) _ScoreboardUpdated(ctx context.Context, sel ast.SelectionSet, obj *ScoreboardUpdated) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, scoreboardUpdatedImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ScoreboardUpdated")
+		case "matchId":
+			out.Values[i] = ec._ScoreboardUpdated_matchId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "frameIndex":
+			out.Values[i] = ec._ScoreboardUpdated_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventIndex":
+			out.Values[i] = ec._ScoreboardUpdated_eventIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._ScoreboardUpdated_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "bluePoints":
+			out.Values[i] = ec._ScoreboardUpdated_bluePoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orangePoints":
+			out.Values[i] = ec._ScoreboardUpdated_orangePoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blueRoundScore":
+			out.Values[i] = ec._ScoreboardUpdated_blueRoundScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orangeRoundScore":
+			out.Values[i] = ec._ScoreboardUpdated_orangeRoundScore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gameClockDisplay":
+			out.Values[i] = ec._ScoreboardUpdated_gameClockDisplay(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sessionEventImplementors = []string{"SessionEvent"}
+
+func (ec *executionContext) _SessionEvent(ctx context.Context, sel ast.SelectionSet, obj *SessionEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SessionEvent")
+		case "id":
+			out.Values[i] = ec._SessionEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lobbySessionId":
+			out.Values[i] = ec._SessionEvent_lobbySessionId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "userId":
+			out.Values[i] = ec._SessionEvent_userId(ctx, field, obj)
+		case "frameIndex":
+			out.Values[i] = ec._SessionEvent_frameIndex(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "frameData":
+			out.Values[i] = ec._SessionEvent_frameData(ctx, field, obj)
+		case "players":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SessionEvent_players(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "teams":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SessionEvent_teams(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "scoreboard":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SessionEvent_scoreboard(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "events":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._SessionEvent_events(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "timestamp":
+			out.Values[i] = ec._SessionEvent_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "createdAt":
+			out.Values[i] = ec._SessionEvent_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "updatedAt":
+			out.Values[i] = ec._SessionEvent_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sessionEventConnectionImplementors = []string{"SessionEventConnection"}
+
+func (ec *executionContext) _SessionEventConnection(ctx context.Context, sel ast.SelectionSet, obj *SessionEventConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionEventConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SessionEventConnection")
+		case "edges":
+			out.Values[i] = ec._SessionEventConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._SessionEventConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCount":
+			out.Values[i] = ec._SessionEventConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var sessionEventEdgeImplementors = []string{"SessionEventEdge"}
+
+func (ec *executionContext) _SessionEventEdge(ctx context.Context, sel ast.SelectionSet, obj *SessionEventEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sessionEventEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SessionEventEdge")
+		case "node":
+			out.Values[i] = ec._SessionEventEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cursor":
+			out.Values[i] = ec._SessionEventEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var statsImplementors = []string{"Stats"}
+
+func (ec *executionContext) _Stats(ctx context.Context, sel ast.SelectionSet, obj *Stats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, statsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Stats")
+		case "possessionTime":
+			out.Values[i] = ec._Stats_possessionTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "points":
+			out.Values[i] = ec._Stats_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "saves":
+			out.Values[i] = ec._Stats_saves(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "goals":
+			out.Values[i] = ec._Stats_goals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stuns":
+			out.Values[i] = ec._Stats_stuns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "passes":
+			out.Values[i] = ec._Stats_passes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "catches":
+			out.Values[i] = ec._Stats_catches(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "steals":
+			out.Values[i] = ec._Stats_steals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blocks":
+			out.Values[i] = ec._Stats_blocks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "interceptions":
+			out.Values[i] = ec._Stats_interceptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assists":
+			out.Values[i] = ec._Stats_assists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shotsTaken":
+			out.Values[i] = ec._Stats_shotsTaken(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var storeSessionEventPayloadImplementors = []string{"StoreSessionEventPayload"}
+
+func (ec *executionContext) _StoreSessionEventPayload(ctx context.Context, sel ast.SelectionSet, obj *StoreSessionEventPayload) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, storeSessionEventPayloadImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("StoreSessionEventPayload")
+		case "success":
+			out.Values[i] = ec._StoreSessionEventPayload_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "event":
+			out.Values[i] = ec._StoreSessionEventPayload_event(ctx, field, obj)
+		case "error":
+			out.Values[i] = ec._StoreSessionEventPayload_error(ctx, field, obj)
+		case "duplicate":
+			out.Values[i] = ec._StoreSessionEventPayload_duplicate(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "matchFrames":
+		return ec._Subscription_matchFrames(ctx, fields[0])
+	case "matchEvents":
+		return ec._Subscription_matchEvents(ctx, fields[0])
+	case "liveMatches":
+		return ec._Subscription_liveMatches(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var teamImplementors = []string{"Team"}
+
+func (ec *executionContext) _Team(ctx context.Context, sel ast.SelectionSet, obj *Team) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Team")
+		case "role":
+			out.Values[i] = ec._Team_role(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Team_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasPossession":
+			out.Values[i] = ec._Team_hasPossession(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stats":
+			out.Values[i] = ec._Team_stats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "players":
+			out.Values[i] = ec._Team_players(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var throwDetailsImplementors = []string{"ThrowDetails"}
+
+func (ec *executionContext) _ThrowDetails(ctx context.Context, sel ast.SelectionSet, obj *ThrowDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, throwDetailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ThrowDetails")
+		case "armSpeed":
+			out.Values[i] = ec._ThrowDetails_armSpeed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalSpeed":
+			out.Values[i] = ec._ThrowDetails_totalSpeed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "offAxisSpinDeg":
+			out.Values[i] = ec._ThrowDetails_offAxisSpinDeg(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "wristThrowPenalty":
+			out.Values[i] = ec._ThrowDetails_wristThrowPenalty(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rotPerSec":
+			out.Values[i] = ec._ThrowDetails_rotPerSec(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "potSpeedFromRot":
+			out.Values[i] = ec._ThrowDetails_potSpeedFromRot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "speedFromArm":
+			out.Values[i] = ec._ThrowDetails_speedFromArm(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "speedFromMovement":
+			out.Values[i] = ec._ThrowDetails_speedFromMovement(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "speedFromWrist":
+			out.Values[i] = ec._ThrowDetails_speedFromWrist(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "wristAlignToThrowDeg":
+			out.Values[i] = ec._ThrowDetails_wristAlignToThrowDeg(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "throwAlignToMovementDeg":
+			out.Values[i] = ec._ThrowDetails_throwAlignToMovementDeg(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "offAxisPenalty":
+			out.Values[i] = ec._ThrowDetails_offAxisPenalty(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "throwMovePenalty":
+			out.Values[i] = ec._ThrowDetails_throwMovePenalty(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __InputValueImplementors = []string{"__InputValue"}
+
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __SchemaImplementors = []string{"__Schema"}
+
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __TypeImplementors = []string{"__Type"}
+
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNEmoteType2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐEmoteType(ctx context.Context, v any) (EmoteType, error) {
+	var res EmoteType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNEmoteType2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐEmoteType(ctx context.Context, sel ast.SelectionSet, v EmoteType) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) marshalNHealthStatus2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐHealthStatus(ctx context.Context, sel ast.SelectionSet, v HealthStatus) graphql.Marshaler {
+	return ec._HealthStatus(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNHealthStatus2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐHealthStatus(ctx context.Context, sel ast.SelectionSet, v *HealthStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._HealthStatus(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNJSON2map(ctx context.Context, v any) (map[string]any, error) {
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNJSON2map(ctx context.Context, sel ast.SelectionSet, v map[string]any) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	_ = sel
+	res := graphql.MarshalMap(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNLiveMatch2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐLiveMatchᚄ(ctx context.Context, sel ast.SelectionSet, v []*LiveMatch) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLiveMatch2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐLiveMatch(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLiveMatch2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐLiveMatch(ctx context.Context, sel ast.SelectionSet, v *LiveMatch) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LiveMatch(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNMatchEvent2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchEvent(ctx context.Context, sel ast.SelectionSet, v MatchEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MatchEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNMatchEvent2ᚕgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchEventᚄ(ctx context.Context, sel ast.SelectionSet, v []MatchEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMatchEvent2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMatchFrame2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchFrame(ctx context.Context, sel ast.SelectionSet, v MatchFrame) graphql.Marshaler {
+	return ec._MatchFrame(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMatchFrame2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐMatchFrame(ctx context.Context, sel ast.SelectionSet, v *MatchFrame) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MatchFrame(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPlayer2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*Player) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer(ctx context.Context, sel ast.SelectionSet, v *Player) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Player(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole(ctx context.Context, v any) (Role, error) {
+	var res Role
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRole2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole(ctx context.Context, sel ast.SelectionSet, v Role) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRound2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRoundᚄ(ctx context.Context, sel ast.SelectionSet, v []*Round) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRound2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRound(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNRound2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRound(ctx context.Context, sel ast.SelectionSet, v *Round) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Round(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSessionEvent2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEvent(ctx context.Context, sel ast.SelectionSet, v *SessionEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SessionEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSessionEventConnection2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventConnection(ctx context.Context, sel ast.SelectionSet, v SessionEventConnection) graphql.Marshaler {
+	return ec._SessionEventConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSessionEventConnection2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventConnection(ctx context.Context, sel ast.SelectionSet, v *SessionEventConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SessionEventConnection(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNSessionEventEdge2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*SessionEventEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSessionEventEdge2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSessionEventEdge2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEventEdge(ctx context.Context, sel ast.SelectionSet, v *SessionEventEdge) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SessionEventEdge(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNStats2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStats(ctx context.Context, sel ast.SelectionSet, v *Stats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Stats(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNStoreSessionEventInput2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStoreSessionEventInput(ctx context.Context, v any) (StoreSessionEventInput, error) {
+	res, err := ec.unmarshalInputStoreSessionEventInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNStoreSessionEventPayload2githubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStoreSessionEventPayload(ctx context.Context, sel ast.SelectionSet, v StoreSessionEventPayload) graphql.Marshaler {
+	return ec._StoreSessionEventPayload(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNStoreSessionEventPayload2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐStoreSessionEventPayload(ctx context.Context, sel ast.SelectionSet, v *StoreSessionEventPayload) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._StoreSessionEventPayload(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNTeam2ᚕᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐTeamᚄ(ctx context.Context, sel ast.SelectionSet, v []*Team) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTeam2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐTeam(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTeam2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐTeam(ctx context.Context, sel ast.SelectionSet, v *Team) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Team(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNTime2timeᚐTime(ctx context.Context, v any) (time.Time, error) {
+	res, err := graphql.UnmarshalTime(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalTime(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v any) (*bool, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalBoolean(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v any) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalInt(*v)
+	return res
+}
+
+func (ec *executionContext) unmarshalOJSON2map(ctx context.Context, v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOJSON2map(ctx context.Context, sel ast.SelectionSet, v map[string]any) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalMap(v)
+	return res
+}
+
+func (ec *executionContext) marshalOLobbySession2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐLobbySession(ctx context.Context, sel ast.SelectionSet, v *LobbySession) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._LobbySession(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPauseState2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPauseState(ctx context.Context, sel ast.SelectionSet, v *PauseState) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PauseState(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPlayer2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐPlayer(ctx context.Context, sel ast.SelectionSet, v *Player) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Player(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORole2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole(ctx context.Context, v any) (*Role, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(Role)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORole2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐRole(ctx context.Context, sel ast.SelectionSet, v *Role) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOScoreDetails2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐScoreDetails(ctx context.Context, sel ast.SelectionSet, v *ScoreDetails) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ScoreDetails(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOScoreboard2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐScoreboard(ctx context.Context, sel ast.SelectionSet, v *Scoreboard) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Scoreboard(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOSessionEvent2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐSessionEvent(ctx context.Context, sel ast.SelectionSet, v *SessionEvent) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SessionEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalString(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel ast.SelectionSet, v *string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalString(*v)
+	return res
+}
+
+func (ec *executionContext) marshalOThrowDetails2ᚖgithubᚗcomᚋechotoolsᚋnevrᚑagentᚋv4ᚋinternalᚋapiᚋgraphᚐThrowDetails(ctx context.Context, sel ast.SelectionSet, v *ThrowDetails) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ThrowDetails(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOTime2ᚖtimeᚐTime(ctx context.Context, v any) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalTime(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	_ = sel
+	_ = ctx
+	res := graphql.MarshalTime(*v)
+	return res
+}
+
+func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Field) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx context.Context, sel ast.SelectionSet, v *introspection.Schema) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.___Schema(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.___Type(ctx, sel, v)
+}
+
+// endregion ***************************** type.gotpl *****************************
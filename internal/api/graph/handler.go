@@ -2,185 +2,99 @@ package graph
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/gorilla/websocket"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
-// GraphQLRequest represents a GraphQL request body
-type GraphQLRequest struct {
-	Query         string         `json:"query"`
-	OperationName string         `json:"operationName"`
-	Variables     map[string]any `json:"variables"`
-}
-
-// GraphQLResponse represents a GraphQL response body
-type GraphQLResponse struct {
-	Data   any            `json:"data,omitempty"`
-	Errors []GraphQLError `json:"errors,omitempty"`
-}
-
-// GraphQLError represents a GraphQL error
-type GraphQLError struct {
-	Message    string         `json:"message"`
-	Locations  []Location     `json:"locations,omitempty"`
-	Path       []any          `json:"path,omitempty"`
-	Extensions map[string]any `json:"extensions,omitempty"`
-}
-
-// Location represents a location in the GraphQL query
-type Location struct {
-	Line   int `json:"line"`
-	Column int `json:"column"`
-}
+// Error codes set in the extensions of errors returned by resolvers
+const (
+	errBadUserInput = "BAD_USER_INPUT"
+	errInternal     = "INTERNAL_SERVER_ERROR"
+)
 
-// Handler returns an HTTP handler for GraphQL requests
+// Handler returns an HTTP handler executing GraphQL operations against the
+// schema, over GET and POST, and subscriptions over WebSocket
 func (r *Resolver) Handler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-
-		if req.Method != http.MethodPost {
-			writeError(w, http.StatusMethodNotAllowed, "Only POST method is allowed")
-			return
-		}
-
-		var gqlReq GraphQLRequest
-		if err := json.NewDecoder(req.Body).Decode(&gqlReq); err != nil {
-			writeError(w, http.StatusBadRequest, "Invalid JSON request body")
-			return
-		}
-
-		ctx := req.Context()
-		result := r.Execute(ctx, gqlReq)
-
-		w.WriteHeader(http.StatusOK)
-		json.NewEncoder(w).Encode(result)
-	})
-}
-
-// Execute executes a GraphQL query and returns the response
-func (r *Resolver) Execute(ctx context.Context, req GraphQLRequest) *GraphQLResponse {
-	// Simple query parser - supports basic queries
-	// In production, you would use gqlgen's generated executor
-
-	response := &GraphQLResponse{}
-
-	// Parse and execute the query
-	data, err := r.executeQuery(ctx, req)
-	if err != nil {
-		response.Errors = []GraphQLError{{Message: err.Error()}}
-		return response
+	maxDepth := r.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxDepth
 	}
-
-	response.Data = data
-	return response
-}
-
-func (r *Resolver) executeQuery(ctx context.Context, req GraphQLRequest) (map[string]any, error) {
-	result := make(map[string]any)
-
-	// Simple query routing based on operation name and query content
-	// This is a simplified implementation - gqlgen would generate a proper executor
-
-	// Check for health query
-	if containsQuery(req.Query, "health") {
-		health, err := r.Health(ctx)
-		if err != nil {
-			return nil, err
-		}
-		result["health"] = health
+	maxComplexity := r.MaxComplexity
+	if maxComplexity == 0 {
+		maxComplexity = DefaultMaxComplexity
 	}
 
-	// Check for lobbySession query
-	if containsQuery(req.Query, "lobbySession") {
-		id, _ := getStringVariable(req.Variables, "id")
-		if id != "" {
-			session, err := r.LobbySession(ctx, id)
-			if err != nil {
-				return nil, err
-			}
-			result["lobbySession"] = session
-		}
-	}
+	srv := handler.New(NewExecutableSchema(Config{
+		Resolvers:  r,
+		Complexity: complexity(),
+	}))
+
+	// graphql-ws and graphql-transport-ws subscriptions; checked first since
+	// the upgrade request is also a GET
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024 * 64, // 64KB for frame data
+			CheckOrigin: func(r *http.Request) bool {
+				return true // Allow all origins, as the stream API does
+			},
+		},
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
 
-	// Check for sessionEvents query
-	if containsQuery(req.Query, "sessionEvents") {
-		lobbySessionID, _ := getStringVariable(req.Variables, "lobbySessionId")
-		if lobbySessionID != "" {
-			limit := getIntVariable(req.Variables, "limit")
-			offset := getIntVariable(req.Variables, "offset")
-			events, err := r.SessionEvents(ctx, lobbySessionID, limit, offset)
-			if err != nil {
-				return nil, err
-			}
-			result["sessionEvents"] = events
-		}
-	}
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
 
-	// Check for storeSessionEvent mutation
-	if containsQuery(req.Query, "storeSessionEvent") {
-		inputRaw, ok := req.Variables["input"].(map[string]any)
-		if ok {
-			input := StoreSessionEventInput{
-				LobbySessionID: inputRaw["lobbySessionId"].(string),
-				FrameData:      inputRaw["frameData"].(map[string]any),
-			}
-			if userID, ok := inputRaw["userId"].(string); ok {
-				input.UserID = &userID
-			}
-			payload, err := r.StoreSessionEvent(ctx, input)
-			if err != nil {
-				return nil, err
-			}
-			result["storeSessionEvent"] = payload
-		}
+	if r.Auth != nil {
+		srv.Use(OperationAuth{Auth: r.Auth})
 	}
+	srv.Use(extension.Introspection{})
+	srv.Use(DepthLimit{Limit: maxDepth})
+	srv.Use(extension.FixedComplexityLimit(maxComplexity))
 
-	return result, nil
-}
-
-func containsQuery(query, field string) bool {
-	// Simple check - in production gqlgen handles this
-	return len(query) > 0 && (contains(query, field) || contains(query, "..."))
-}
+	srv.SetErrorPresenter(presentError)
+	srv.SetRecoverFunc(recoverPanic)
 
-func contains(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
-		}
-	}
-	return false
+	return srv
 }
 
-func getStringVariable(vars map[string]any, key string) (string, bool) {
-	if vars == nil {
-		return "", false
+// presentError adds an error code to resolver errors. Invalid arguments are
+// reported as BAD_USER_INPUT, anything else without a code as INTERNAL_SERVER_ERROR.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if _, ok := gqlErr.Extensions["code"]; ok {
+		return gqlErr
 	}
-	if v, ok := vars[key].(string); ok {
-		return v, true
-	}
-	return "", false
-}
 
-func getIntVariable(vars map[string]any, key string) *int {
-	if vars == nil {
-		return nil
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]any)
 	}
-	if v, ok := vars[key].(float64); ok {
-		i := int(v)
-		return &i
+	if errors.Is(err, store.ErrInvalidSessionID) || errors.Is(err, store.ErrUnknownEventType) {
+		gqlErr.Extensions["code"] = errBadUserInput
+	} else {
+		gqlErr.Extensions["code"] = errInternal
 	}
-	if v, ok := vars[key].(int); ok {
-		return &v
-	}
-	return nil
+	return gqlErr
 }
 
-func writeError(w http.ResponseWriter, status int, message string) {
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(GraphQLResponse{
-		Errors: []GraphQLError{{Message: message}},
-	})
+// recoverPanic turns a panic in a resolver into an error for its field
+func recoverPanic(ctx context.Context, err any) error {
+	gqlErr := gqlerror.Errorf("internal server error")
+	gqlErr.Extensions = map[string]any{"code": errInternal}
+	return gqlErr
 }
 
 // PlaygroundHandler returns an HTTP handler that serves the GraphQL Playground
@@ -0,0 +1,274 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/websocket"
+)
+
+type testResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, h http.Handler, query string, variables map[string]any) testResponse {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": variables})
+	req := httptest.NewRequest(http.MethodPost, "/v3/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp testResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid response %q: %v", rec.Body.String(), err)
+	}
+	return resp
+}
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	sessionID := "3b8e7a2c-9f1d-4c55-b0a4-6e2d1f7c8a90"
+
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		doc, err := store.NewSessionFrameDocument(sessionID, "node1", "user1", &telemetry.LobbySessionStateFrame{FrameIndex: uint32(i)})
+		if err != nil {
+			t.Fatalf("NewSessionFrameDocument() error = %v", err)
+		}
+		if err := repo.StoreFrame(ctx, doc); err != nil {
+			t.Fatalf("StoreFrame() error = %v", err)
+		}
+	}
+
+	h := NewResolver(repo).Handler()
+
+	t.Run("selection, aliases, fragments and variables", func(t *testing.T) {
+		resp := execute(t, h, `
+			query Session($id: ID!, $limit: Int) {
+				session: lobbySession(id: $id) {
+					totalEvents
+					frames(limit: $limit) { ...page }
+				}
+			}
+			fragment page on SessionEventConnection {
+				totalCount
+				pageInfo { hasNextPage }
+				edges { node { userId } }
+			}`, map[string]any{"id": sessionID, "limit": 2})
+		if len(resp.Errors) > 0 {
+			t.Fatalf("unexpected errors: %+v", resp.Errors)
+		}
+
+		session := resp.Data["session"].(map[string]any)
+		if len(session) != 2 || session["totalEvents"] != float64(3) {
+			t.Errorf("session = %v, want only the selected fields", session)
+		}
+		frames := session["frames"].(map[string]any)
+		if frames["totalCount"] != float64(3) || len(frames["edges"].([]any)) != 2 {
+			t.Errorf("frames = %v, want 2 of 3 frames", frames)
+		}
+		if !frames["pageInfo"].(map[string]any)["hasNextPage"].(bool) {
+			t.Errorf("hasNextPage = false, want true")
+		}
+	})
+
+	t.Run("typed players, rounds and events", func(t *testing.T) {
+		typedID := "5d0c2b7e-1a3f-4e8b-9c6d-2f4a8e1b3c70"
+		session := &apigame.SessionResponse{
+			BluePoints: 2,
+			Teams: []*apigame.Team{
+				{Players: []*apigame.TeamMember{{SlotNumber: 0, DisplayName: "blue0", AccountNumber: 1001}}},
+				{Players: []*apigame.TeamMember{{SlotNumber: 5, DisplayName: "orange5", AccountNumber: 1005}}},
+			},
+		}
+		frames := []*telemetry.LobbySessionStateFrame{
+			{FrameIndex: 0, Session: session, Events: []*telemetry.LobbySessionEvent{
+				{Event: &telemetry.LobbySessionEvent_RoundStarted{RoundStarted: &telemetry.RoundStarted{RoundNumber: 1}}},
+			}},
+			{FrameIndex: 1, Session: session, Events: []*telemetry.LobbySessionEvent{
+				{Event: &telemetry.LobbySessionEvent_DiscCaught{DiscCaught: &telemetry.DiscCaught{PlayerSlot: 0}}},
+				{Event: &telemetry.LobbySessionEvent_PlayerStun{PlayerStun: &telemetry.PlayerStun{PlayerSlot: 5, TotalStuns: 3}}},
+			}},
+			{FrameIndex: 2, Session: session, Events: []*telemetry.LobbySessionEvent{
+				{Event: &telemetry.LobbySessionEvent_RoundEnded{RoundEnded: &telemetry.RoundEnded{RoundNumber: 1, WinningTeam: telemetry.Role_ROLE_BLUE_TEAM}}},
+			}},
+		}
+		for _, frame := range frames {
+			doc, err := store.NewSessionFrameDocument(typedID, "node1", "", frame)
+			if err != nil {
+				t.Fatalf("NewSessionFrameDocument() error = %v", err)
+			}
+			if err := repo.StoreFrame(ctx, doc); err != nil {
+				t.Fatalf("StoreFrame() error = %v", err)
+			}
+		}
+
+		resp := execute(t, h, `query($id: ID!) {
+			lobbySession(id: $id) {
+				players { displayName role accountNumber }
+				scoreboard { bluePoints }
+				rounds { number startFrameIndex endFrameIndex winningTeam }
+				events(types: ["player_stun"]) {
+					__typename
+					... on PlayerStun { playerSlot totalStuns player { displayName } }
+				}
+			}
+		}`, map[string]any{"id": typedID})
+		if len(resp.Errors) > 0 {
+			t.Fatalf("unexpected errors: %+v", resp.Errors)
+		}
+
+		got, _ := json.Marshal(resp.Data["lobbySession"])
+		// Maps are marshaled with sorted keys
+		want := `{"events":[{"__typename":"PlayerStun","player":{"displayName":"orange5"},"playerSlot":5,"totalStuns":3}],` +
+			`"players":[{"accountNumber":"1001","displayName":"blue0","role":"BLUE_TEAM"},` +
+			`{"accountNumber":"1005","displayName":"orange5","role":"ORANGE_TEAM"}],` +
+			`"rounds":[{"endFrameIndex":2,"number":1,"startFrameIndex":0,"winningTeam":"BLUE_TEAM"}],` +
+			`"scoreboard":{"bluePoints":2}}`
+		if string(got) != want {
+			t.Errorf("lobbySession = %s, want %s", got, want)
+		}
+
+		resp = execute(t, h, `query($id: ID!) { lobbySession(id: $id) { events(types: ["nope"]) { __typename } } }`, map[string]any{"id": typedID})
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != errBadUserInput {
+			t.Errorf("unknown event type errors = %+v, want %s", resp.Errors, errBadUserInput)
+		}
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		resp := execute(t, h, `{ __type(name: "LobbySession") { fields { name } } }`, nil)
+		if len(resp.Errors) > 0 {
+			t.Fatalf("unexpected errors: %+v", resp.Errors)
+		}
+		fields := resp.Data["__type"].(map[string]any)["fields"].([]any)
+		if len(fields) == 0 {
+			t.Errorf("no fields introspected")
+		}
+	})
+
+	t.Run("structured errors", func(t *testing.T) {
+		resp := execute(t, h, `query($id: ID!) { lobbySession(id: $id) { id } }`, map[string]any{"id": "bad id!"})
+		if len(resp.Errors) != 1 {
+			t.Fatalf("errors = %+v, want one", resp.Errors)
+		}
+		if got := resp.Errors[0].Extensions["code"]; got != errBadUserInput {
+			t.Errorf("code = %v, want %s", got, errBadUserInput)
+		}
+		if len(resp.Errors[0].Path) != 1 || resp.Errors[0].Path[0] != "lobbySession" {
+			t.Errorf("path = %v, want [lobbySession]", resp.Errors[0].Path)
+		}
+
+		resp = execute(t, h, `{ lobbySession { id } }`, nil)
+		if len(resp.Errors) == 0 || resp.Errors[0].Extensions["code"] != "GRAPHQL_VALIDATION_FAILED" {
+			t.Errorf("missing argument errors = %+v, want validation failure", resp.Errors)
+		}
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		limited := (&Resolver{Repository: repo, MaxDepth: 3}).Handler()
+		resp := execute(t, limited, `{ sessionEvents(lobbySessionId: "x") { edges { node { id } } } }`, nil)
+		if len(resp.Errors) == 0 || resp.Errors[0].Extensions["code"] != errDepthLimit {
+			t.Errorf("errors = %+v, want depth limit", resp.Errors)
+		}
+
+		resp = execute(t, h, `{ sessionEvents(lobbySessionId: "x", limit: 1000) {
+			edges { node { id lobbySessionId userId frameData timestamp createdAt updatedAt } cursor } } }`, nil)
+		if len(resp.Errors) == 0 || resp.Errors[0].Extensions["code"] != "COMPLEXITY_LIMIT_EXCEEDED" {
+			t.Errorf("errors = %+v, want complexity limit", resp.Errors)
+		}
+	})
+}
+
+// fakeLive is a LiveSource fed by the test
+type fakeLive struct {
+	frames chan LiveFrame
+}
+
+func (f *fakeLive) Observe(ctx context.Context, matchID string) <-chan LiveFrame {
+	return f.frames
+}
+
+func (f *fakeLive) LiveMatches() []*LiveMatch {
+	return nil
+}
+
+func TestHandler_Subscription(t *testing.T) {
+	live := &fakeLive{frames: make(chan LiveFrame, 4)}
+	srv := httptest.NewServer((&Resolver{Live: live}).Handler())
+	defer srv.Close()
+
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	read := func(wantType string) map[string]any {
+		t.Helper()
+		for {
+			var msg map[string]any
+			if err := conn.ReadJSON(&msg); err != nil {
+				t.Fatalf("ReadJSON() error = %v", err)
+			}
+			if msg["type"] == "ping" || msg["type"] == "ka" {
+				continue
+			}
+			if msg["type"] != wantType {
+				t.Fatalf("message = %v, want type %s", msg, wantType)
+			}
+			return msg
+		}
+	}
+
+	conn.WriteJSON(map[string]any{"type": "connection_init"})
+	read("connection_ack")
+
+	conn.WriteJSON(map[string]any{
+		"id":   "1",
+		"type": "subscribe",
+		"payload": map[string]any{
+			"query": `subscription($id: ID!) { matchEvents(matchId: $id, types: ["round_started"]) { __typename ... on RoundStarted { matchId roundNumber } } }`,
+			"variables": map[string]any{
+				"id": "match-1",
+			},
+		},
+	})
+
+	frame := &telemetry.LobbySessionStateFrame{
+		FrameIndex: 7,
+		Events: []*telemetry.LobbySessionEvent{
+			{Event: &telemetry.LobbySessionEvent_RoundStarted{RoundStarted: &telemetry.RoundStarted{RoundNumber: 2}}},
+		},
+	}
+	live.frames <- LiveFrame{MatchID: "match-1", Frame: frame}
+	live.frames <- LiveFrame{MatchID: "match-1"}
+
+	msg := read("next")
+	event := msg["payload"].(map[string]any)["data"].(map[string]any)["matchEvents"].(map[string]any)
+	if event["__typename"] != "RoundStarted" || event["matchId"] != "match-1" || event["roundNumber"] != float64(2) {
+		t.Errorf("event = %v, want round 2 started in match-1", event)
+	}
+
+	// The subscription completes when the match ends
+	read("complete")
+}
@@ -0,0 +1,106 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const (
+	// DefaultMaxDepth is the deepest field nesting accepted in an operation
+	DefaultMaxDepth = 10
+
+	// DefaultMaxComplexity is the highest operation complexity accepted. Paginated
+	// fields cost their page size times the complexity of their selection, so
+	// this bounds the number of frames a single operation reads from storage.
+	DefaultMaxComplexity = 5000
+
+	errDepthLimit = "DEPTH_LIMIT_EXCEEDED"
+)
+
+// complexity returns the complexity functions of the paginated fields
+func complexity() ComplexityRoot {
+	var c ComplexityRoot
+	c.Query.SessionEvents = func(childComplexity int, lobbySessionID string, limit *int, offset *int) int {
+		return pageComplexity(childComplexity, limit, offset)
+	}
+	c.LobbySession.Frames = func(childComplexity int, limit *int, offset *int) int {
+		return pageComplexity(childComplexity, limit, offset)
+	}
+	c.LobbySession.Events = func(childComplexity int, types []string, limit *int, offset *int) int {
+		return pageComplexity(childComplexity, limit, offset)
+	}
+	return c
+}
+
+func pageComplexity(childComplexity int, limit, offset *int) int {
+	size, _ := pageBounds(limit, offset)
+	return 1 + size*childComplexity
+}
+
+// DepthLimit rejects operations nesting fields deeper than Limit.
+// Introspection fields are not counted.
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = DepthLimit{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (d DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+// Validate implements graphql.HandlerExtension
+func (d DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	if d.Limit < 1 {
+		return fmt.Errorf("depth limit must be positive")
+	}
+	return nil
+}
+
+// MutateOperationContext implements graphql.OperationContextMutator
+func (d DepthLimit) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	if opCtx.Operation == nil {
+		return nil
+	}
+
+	if depth := selectionDepth(opCtx.Operation.SelectionSet); depth > d.Limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+// selectionDepth returns the deepest field nesting of a selection set
+func selectionDepth(set ast.SelectionSet) int {
+	var deepest int
+	for _, sel := range set {
+		var depth int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if len(sel.Name) > 1 && sel.Name[:2] == "__" {
+				continue
+			}
+			depth = 1 + selectionDepth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionDepth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				depth = selectionDepth(sel.Definition.SelectionSet)
+			}
+		}
+		if depth > deepest {
+			deepest = depth
+		}
+	}
+	return deepest
+}
@@ -0,0 +1,76 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// maxSubscriptionFPS is the highest frame rate a matchFrames subscription can request
+	maxSubscriptionFPS = 60
+
+	// liveMatchesRefresh is how often the liveMatches subscription resends the list
+	liveMatchesRefresh = 30 * time.Second
+)
+
+var errLiveUnavailable = errors.New("live streaming is not available")
+
+// LiveFrame is a frame delivered by a LiveSource
+type LiveFrame struct {
+	MatchID string
+	Frame   *telemetry.LobbySessionStateFrame // Nil once the match has ended
+}
+
+// LiveSource is the live frame fan-out that subscriptions are fed from
+type LiveSource interface {
+	// Observe delivers the frames broadcast for matchID, or for every match if
+	// matchID is empty, until ctx is done. Frames are dropped for an observer
+	// that does not keep up.
+	Observe(ctx context.Context, matchID string) <-chan LiveFrame
+
+	// LiveMatches returns the matches currently broadcasting frames
+	LiveMatches() []*LiveMatch
+}
+
+// observe validates the match ID and starts observing its frames
+func (r *Resolver) observe(ctx context.Context, matchID string) (<-chan LiveFrame, error) {
+	if r.Live == nil {
+		return nil, errLiveUnavailable
+	}
+	if !store.ValidSessionID(matchID) {
+		return nil, store.ErrInvalidSessionID
+	}
+	return r.Live.Observe(ctx, matchID), nil
+}
+
+func newMatchFrame(live LiveFrame) *MatchFrame {
+	frameData := map[string]any{}
+	if data, err := protojson.Marshal(live.Frame); err == nil {
+		_ = json.Unmarshal(data, &frameData)
+	}
+
+	var timestamp time.Time
+	if live.Frame.GetTimestamp() != nil {
+		timestamp = live.Frame.GetTimestamp().AsTime()
+	}
+
+	return &MatchFrame{
+		MatchID:    live.MatchID,
+		FrameIndex: int(live.Frame.GetFrameIndex()),
+		Timestamp:  timestamp,
+		FrameData:  frameData,
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
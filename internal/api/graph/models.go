@@ -0,0 +1,158 @@
+package graph
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SessionFrameDocument represents the MongoDB document structure
+type SessionFrameDocument = store.SessionFrameDocument
+
+const (
+	// defaultPageSize is the number of session events returned when no limit is given
+	defaultPageSize = 100
+
+	// maxPageSize is the largest number of session events returned per page
+	maxPageSize = 1000
+)
+
+// pageBounds applies the defaults and clamps the pagination arguments
+func pageBounds(limit, offset *int) (int, int) {
+	limitVal := defaultPageSize
+	offsetVal := 0
+	if limit != nil {
+		limitVal = *limit
+	}
+	if offset != nil && *offset > 0 {
+		offsetVal = *offset
+	}
+
+	// Clamp limit
+	if limitVal > maxPageSize {
+		limitVal = maxPageSize
+	}
+	if limitVal < 1 {
+		limitVal = 1
+	}
+	return limitVal, offsetVal
+}
+
+// newSessionEvent converts a stored frame document to its GraphQL type
+func newSessionEvent(doc *SessionFrameDocument) *SessionEvent {
+	// Convert frame to JSON map
+	var frameData map[string]any
+	if doc.Frame != nil {
+		frameJSON, err := protojson.Marshal(doc.Frame)
+		if err == nil {
+			// Use standard json package to unmarshal to map
+			_ = json.Unmarshal(frameJSON, &frameData)
+		}
+	}
+
+	return &SessionEvent{
+		ID:             doc.ID.Hex(),
+		LobbySessionID: doc.LobbySessionID,
+		UserID:         &doc.UserID,
+		FrameIndex:     int(doc.Frame.GetFrameIndex()),
+		FrameData:      frameData,
+		Timestamp:      doc.Timestamp,
+		CreatedAt:      doc.CreatedAt,
+		UpdatedAt:      doc.UpdatedAt,
+		frame:          doc.Frame,
+	}
+}
+
+func encodeCursor(offset int) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(string(data))
+}
+
+// GraphQL model types
+
+type LobbySession struct {
+	ID             string     `json:"id"`
+	LobbySessionID string     `json:"lobbySessionId"`
+	TotalEvents    int        `json:"totalEvents"`
+	CreatedAt      *time.Time `json:"createdAt"`
+	UpdatedAt      *time.Time `json:"updatedAt"`
+}
+
+type SessionEvent struct {
+	ID             string         `json:"id"`
+	LobbySessionID string         `json:"lobbySessionId"`
+	UserID         *string        `json:"userId"`
+	FrameIndex     int            `json:"frameIndex"`
+	FrameData      map[string]any `json:"frameData"`
+	Timestamp      time.Time      `json:"timestamp"`
+	CreatedAt      time.Time      `json:"createdAt"`
+	UpdatedAt      time.Time      `json:"updatedAt"`
+
+	frame *telemetry.LobbySessionStateFrame // Source of the typed fields
+}
+
+type SessionEventConnection struct {
+	Edges      []*SessionEventEdge `json:"edges"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+type SessionEventEdge struct {
+	Node   *SessionEvent `json:"node"`
+	Cursor string        `json:"cursor"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor"`
+	EndCursor       *string `json:"endCursor"`
+}
+
+type MatchFrame struct {
+	MatchID    string         `json:"matchId"`
+	FrameIndex int            `json:"frameIndex"`
+	Timestamp  time.Time      `json:"timestamp"`
+	FrameData  map[string]any `json:"frameData"`
+}
+
+type LiveMatch struct {
+	MatchID     string    `json:"matchId"`
+	MapName     *string   `json:"mapName"`
+	MatchType   *string   `json:"matchType"`
+	GameStatus  *string   `json:"gameStatus"`
+	StartedAt   time.Time `json:"startedAt"`
+	LastFrameAt time.Time `json:"lastFrameAt"`
+}
+
+type HealthStatus struct {
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	Database  string    `json:"database"`
+}
+
+type StoreSessionEventInput struct {
+	LobbySessionID string         `json:"lobbySessionId"`
+	UserID         *string        `json:"userId"`
+	FrameData      map[string]any `json:"frameData"`
+}
+
+type StoreSessionEventPayload struct {
+	Success bool          `json:"success"`
+	Event   *SessionEvent `json:"event"`
+	Error   *string       `json:"error"`
+
+	Duplicate bool `json:"duplicate"`
+}
@@ -0,0 +1,712 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Fields shared by all match events. The type name of each event is the
+// PascalCase form of its event type, e.g. GoalScored for "goal_scored".
+type Event interface {
+	IsEvent()
+	GetMatchID() string
+	GetFrameIndex() int
+	// Position of the event within its frame
+	GetEventIndex() int
+	GetTimestamp() time.Time
+}
+
+// An event detected in a match, one member per telemetry event variant
+type MatchEvent interface {
+	IsMatchEvent()
+}
+
+type DiscCaught struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+}
+
+func (DiscCaught) IsEvent()                {}
+func (this DiscCaught) GetMatchID() string { return this.MatchID }
+func (this DiscCaught) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this DiscCaught) GetEventIndex() int      { return this.EventIndex }
+func (this DiscCaught) GetTimestamp() time.Time { return this.Timestamp }
+
+func (DiscCaught) IsMatchEvent() {}
+
+type DiscPossessionChanged struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	// -1 if the disc is free
+	PlayerSlot int     `json:"playerSlot"`
+	Player     *Player `json:"player,omitempty"`
+	// -1 if the disc was free
+	PreviousPlayerSlot int     `json:"previousPlayerSlot"`
+	PreviousPlayer     *Player `json:"previousPlayer,omitempty"`
+}
+
+func (DiscPossessionChanged) IsEvent()                {}
+func (this DiscPossessionChanged) GetMatchID() string { return this.MatchID }
+func (this DiscPossessionChanged) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this DiscPossessionChanged) GetEventIndex() int      { return this.EventIndex }
+func (this DiscPossessionChanged) GetTimestamp() time.Time { return this.Timestamp }
+
+func (DiscPossessionChanged) IsMatchEvent() {}
+
+type DiscThrown struct {
+	MatchID      string        `json:"matchId"`
+	FrameIndex   int           `json:"frameIndex"`
+	EventIndex   int           `json:"eventIndex"`
+	Timestamp    time.Time     `json:"timestamp"`
+	PlayerSlot   int           `json:"playerSlot"`
+	Player       *Player       `json:"player,omitempty"`
+	ThrowDetails *ThrowDetails `json:"throwDetails,omitempty"`
+}
+
+func (DiscThrown) IsEvent()                {}
+func (this DiscThrown) GetMatchID() string { return this.MatchID }
+func (this DiscThrown) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this DiscThrown) GetEventIndex() int      { return this.EventIndex }
+func (this DiscThrown) GetTimestamp() time.Time { return this.Timestamp }
+
+func (DiscThrown) IsMatchEvent() {}
+
+type EmotePlayed struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+	Emote      EmoteType `json:"emote"`
+}
+
+func (EmotePlayed) IsEvent()                {}
+func (this EmotePlayed) GetMatchID() string { return this.MatchID }
+func (this EmotePlayed) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this EmotePlayed) GetEventIndex() int      { return this.EventIndex }
+func (this EmotePlayed) GetTimestamp() time.Time { return this.Timestamp }
+
+func (EmotePlayed) IsMatchEvent() {}
+
+type GoalScored struct {
+	MatchID      string        `json:"matchId"`
+	FrameIndex   int           `json:"frameIndex"`
+	EventIndex   int           `json:"eventIndex"`
+	Timestamp    time.Time     `json:"timestamp"`
+	ScoreDetails *ScoreDetails `json:"scoreDetails,omitempty"`
+}
+
+func (GoalScored) IsEvent()                {}
+func (this GoalScored) GetMatchID() string { return this.MatchID }
+func (this GoalScored) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this GoalScored) GetEventIndex() int      { return this.EventIndex }
+func (this GoalScored) GetTimestamp() time.Time { return this.Timestamp }
+
+func (GoalScored) IsMatchEvent() {}
+
+type MatchEnded struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	WinningTeam Role      `json:"winningTeam"`
+}
+
+func (MatchEnded) IsEvent()                {}
+func (this MatchEnded) GetMatchID() string { return this.MatchID }
+func (this MatchEnded) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this MatchEnded) GetEventIndex() int      { return this.EventIndex }
+func (this MatchEnded) GetTimestamp() time.Time { return this.Timestamp }
+
+func (MatchEnded) IsMatchEvent() {}
+
+// Mutation root for the EVR Data Recorder API
+type Mutation struct {
+}
+
+// Pause state of a round
+type PauseState struct {
+	PausedState         string  `json:"pausedState"`
+	UnpausedTeam        string  `json:"unpausedTeam"`
+	PausedRequestedTeam string  `json:"pausedRequestedTeam"`
+	UnpausedTimer       float64 `json:"unpausedTimer"`
+	PausedTimer         float64 `json:"pausedTimer"`
+}
+
+// A player in a match
+type Player struct {
+	Slot int `json:"slot"`
+	// Account number, as a string since it exceeds the Int range
+	AccountNumber string `json:"accountNumber"`
+	DisplayName   string `json:"displayName"`
+	Role          Role   `json:"role"`
+	JerseyNumber  int    `json:"jerseyNumber"`
+	Level         int    `json:"level"`
+	Ping          int    `json:"ping"`
+	Stats         *Stats `json:"stats"`
+}
+
+type PlayerAssist struct {
+	MatchID      string    `json:"matchId"`
+	FrameIndex   int       `json:"frameIndex"`
+	EventIndex   int       `json:"eventIndex"`
+	Timestamp    time.Time `json:"timestamp"`
+	PlayerSlot   int       `json:"playerSlot"`
+	Player       *Player   `json:"player,omitempty"`
+	TotalAssists int       `json:"totalAssists"`
+}
+
+func (PlayerAssist) IsEvent()                {}
+func (this PlayerAssist) GetMatchID() string { return this.MatchID }
+func (this PlayerAssist) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerAssist) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerAssist) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerAssist) IsMatchEvent() {}
+
+type PlayerBlock struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	PlayerSlot  int       `json:"playerSlot"`
+	Player      *Player   `json:"player,omitempty"`
+	TotalBlocks int       `json:"totalBlocks"`
+}
+
+func (PlayerBlock) IsEvent()                {}
+func (this PlayerBlock) GetMatchID() string { return this.MatchID }
+func (this PlayerBlock) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerBlock) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerBlock) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerBlock) IsMatchEvent() {}
+
+type PlayerGoal struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+	TotalGoals int       `json:"totalGoals"`
+	Points     int       `json:"points"`
+}
+
+func (PlayerGoal) IsEvent()                {}
+func (this PlayerGoal) GetMatchID() string { return this.MatchID }
+func (this PlayerGoal) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerGoal) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerGoal) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerGoal) IsMatchEvent() {}
+
+type PlayerInterception struct {
+	MatchID            string    `json:"matchId"`
+	FrameIndex         int       `json:"frameIndex"`
+	EventIndex         int       `json:"eventIndex"`
+	Timestamp          time.Time `json:"timestamp"`
+	PlayerSlot         int       `json:"playerSlot"`
+	Player             *Player   `json:"player,omitempty"`
+	TotalInterceptions int       `json:"totalInterceptions"`
+}
+
+func (PlayerInterception) IsEvent()                {}
+func (this PlayerInterception) GetMatchID() string { return this.MatchID }
+func (this PlayerInterception) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerInterception) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerInterception) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerInterception) IsMatchEvent() {}
+
+type PlayerJoined struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	Player     *Player   `json:"player"`
+	Role       Role      `json:"role"`
+}
+
+func (PlayerJoined) IsEvent()                {}
+func (this PlayerJoined) GetMatchID() string { return this.MatchID }
+func (this PlayerJoined) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerJoined) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerJoined) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerJoined) IsMatchEvent() {}
+
+type PlayerLeft struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	PlayerSlot  int       `json:"playerSlot"`
+	DisplayName string    `json:"displayName"`
+}
+
+func (PlayerLeft) IsEvent()                {}
+func (this PlayerLeft) GetMatchID() string { return this.MatchID }
+func (this PlayerLeft) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerLeft) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerLeft) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerLeft) IsMatchEvent() {}
+
+type PlayerPass struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	PlayerSlot  int       `json:"playerSlot"`
+	Player      *Player   `json:"player,omitempty"`
+	TotalPasses int       `json:"totalPasses"`
+}
+
+func (PlayerPass) IsEvent()                {}
+func (this PlayerPass) GetMatchID() string { return this.MatchID }
+func (this PlayerPass) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerPass) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerPass) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerPass) IsMatchEvent() {}
+
+type PlayerSave struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+	TotalSaves int       `json:"totalSaves"`
+}
+
+func (PlayerSave) IsEvent()                {}
+func (this PlayerSave) GetMatchID() string { return this.MatchID }
+func (this PlayerSave) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerSave) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerSave) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerSave) IsMatchEvent() {}
+
+type PlayerShotTaken struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+	TotalShots int       `json:"totalShots"`
+}
+
+func (PlayerShotTaken) IsEvent()                {}
+func (this PlayerShotTaken) GetMatchID() string { return this.MatchID }
+func (this PlayerShotTaken) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerShotTaken) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerShotTaken) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerShotTaken) IsMatchEvent() {}
+
+type PlayerSteal struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	PlayerSlot  int       `json:"playerSlot"`
+	Player      *Player   `json:"player,omitempty"`
+	TotalSteals int       `json:"totalSteals"`
+}
+
+func (PlayerSteal) IsEvent()                {}
+func (this PlayerSteal) GetMatchID() string { return this.MatchID }
+func (this PlayerSteal) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerSteal) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerSteal) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerSteal) IsMatchEvent() {}
+
+type PlayerStun struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+	TotalStuns int       `json:"totalStuns"`
+}
+
+func (PlayerStun) IsEvent()                {}
+func (this PlayerStun) GetMatchID() string { return this.MatchID }
+func (this PlayerStun) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerStun) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerStun) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerStun) IsMatchEvent() {}
+
+type PlayerSwitchedTeam struct {
+	MatchID    string    `json:"matchId"`
+	FrameIndex int       `json:"frameIndex"`
+	EventIndex int       `json:"eventIndex"`
+	Timestamp  time.Time `json:"timestamp"`
+	PlayerSlot int       `json:"playerSlot"`
+	Player     *Player   `json:"player,omitempty"`
+	NewRole    Role      `json:"newRole"`
+	PrevRole   Role      `json:"prevRole"`
+}
+
+func (PlayerSwitchedTeam) IsEvent()                {}
+func (this PlayerSwitchedTeam) GetMatchID() string { return this.MatchID }
+func (this PlayerSwitchedTeam) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this PlayerSwitchedTeam) GetEventIndex() int      { return this.EventIndex }
+func (this PlayerSwitchedTeam) GetTimestamp() time.Time { return this.Timestamp }
+
+func (PlayerSwitchedTeam) IsMatchEvent() {}
+
+// Query root for the EVR Data Recorder API
+type Query struct {
+}
+
+// A round of a match. End fields are null while the round is in progress and
+// start fields are null if it started before the session was recorded.
+type Round struct {
+	Number          int        `json:"number"`
+	StartFrameIndex *int       `json:"startFrameIndex,omitempty"`
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+	EndFrameIndex   *int       `json:"endFrameIndex,omitempty"`
+	EndedAt         *time.Time `json:"endedAt,omitempty"`
+	WinningTeam     *Role      `json:"winningTeam,omitempty"`
+}
+
+type RoundEnded struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	RoundNumber int       `json:"roundNumber"`
+	WinningTeam Role      `json:"winningTeam"`
+}
+
+func (RoundEnded) IsEvent()                {}
+func (this RoundEnded) GetMatchID() string { return this.MatchID }
+func (this RoundEnded) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this RoundEnded) GetEventIndex() int      { return this.EventIndex }
+func (this RoundEnded) GetTimestamp() time.Time { return this.Timestamp }
+
+func (RoundEnded) IsMatchEvent() {}
+
+type RoundPaused struct {
+	MatchID    string      `json:"matchId"`
+	FrameIndex int         `json:"frameIndex"`
+	EventIndex int         `json:"eventIndex"`
+	Timestamp  time.Time   `json:"timestamp"`
+	Pause      *PauseState `json:"pause,omitempty"`
+}
+
+func (RoundPaused) IsEvent()                {}
+func (this RoundPaused) GetMatchID() string { return this.MatchID }
+func (this RoundPaused) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this RoundPaused) GetEventIndex() int      { return this.EventIndex }
+func (this RoundPaused) GetTimestamp() time.Time { return this.Timestamp }
+
+func (RoundPaused) IsMatchEvent() {}
+
+type RoundStarted struct {
+	MatchID     string    `json:"matchId"`
+	FrameIndex  int       `json:"frameIndex"`
+	EventIndex  int       `json:"eventIndex"`
+	Timestamp   time.Time `json:"timestamp"`
+	RoundNumber int       `json:"roundNumber"`
+}
+
+func (RoundStarted) IsEvent()                {}
+func (this RoundStarted) GetMatchID() string { return this.MatchID }
+func (this RoundStarted) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this RoundStarted) GetEventIndex() int      { return this.EventIndex }
+func (this RoundStarted) GetTimestamp() time.Time { return this.Timestamp }
+
+func (RoundStarted) IsMatchEvent() {}
+
+type RoundUnpaused struct {
+	MatchID    string      `json:"matchId"`
+	FrameIndex int         `json:"frameIndex"`
+	EventIndex int         `json:"eventIndex"`
+	Timestamp  time.Time   `json:"timestamp"`
+	Pause      *PauseState `json:"pause,omitempty"`
+}
+
+func (RoundUnpaused) IsEvent()                {}
+func (this RoundUnpaused) GetMatchID() string { return this.MatchID }
+func (this RoundUnpaused) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this RoundUnpaused) GetEventIndex() int      { return this.EventIndex }
+func (this RoundUnpaused) GetTimestamp() time.Time { return this.Timestamp }
+
+func (RoundUnpaused) IsMatchEvent() {}
+
+// Details of a scored goal
+type ScoreDetails struct {
+	DiscSpeed      float64 `json:"discSpeed"`
+	Team           string  `json:"team"`
+	GoalType       string  `json:"goalType"`
+	PointAmount    int     `json:"pointAmount"`
+	DistanceThrown float64 `json:"distanceThrown"`
+	PersonScored   string  `json:"personScored"`
+	AssistScored   string  `json:"assistScored"`
+}
+
+// Score and clock of a match
+type Scoreboard struct {
+	BluePoints       int     `json:"bluePoints"`
+	OrangePoints     int     `json:"orangePoints"`
+	BlueRoundScore   int     `json:"blueRoundScore"`
+	OrangeRoundScore int     `json:"orangeRoundScore"`
+	TotalRoundCount  int     `json:"totalRoundCount"`
+	GameClock        float64 `json:"gameClock"`
+	GameClockDisplay string  `json:"gameClockDisplay"`
+	GameStatus       string  `json:"gameStatus"`
+}
+
+type ScoreboardUpdated struct {
+	MatchID          string    `json:"matchId"`
+	FrameIndex       int       `json:"frameIndex"`
+	EventIndex       int       `json:"eventIndex"`
+	Timestamp        time.Time `json:"timestamp"`
+	BluePoints       int       `json:"bluePoints"`
+	OrangePoints     int       `json:"orangePoints"`
+	BlueRoundScore   int       `json:"blueRoundScore"`
+	OrangeRoundScore int       `json:"orangeRoundScore"`
+	GameClockDisplay string    `json:"gameClockDisplay"`
+}
+
+func (ScoreboardUpdated) IsEvent()                {}
+func (this ScoreboardUpdated) GetMatchID() string { return this.MatchID }
+func (this ScoreboardUpdated) GetFrameIndex() int { return this.FrameIndex }
+
+// Position of the event within its frame
+func (this ScoreboardUpdated) GetEventIndex() int      { return this.EventIndex }
+func (this ScoreboardUpdated) GetTimestamp() time.Time { return this.Timestamp }
+
+func (ScoreboardUpdated) IsMatchEvent() {}
+
+// Statistics of a player or a team
+type Stats struct {
+	PossessionTime float64 `json:"possessionTime"`
+	Points         int     `json:"points"`
+	Saves          int     `json:"saves"`
+	Goals          int     `json:"goals"`
+	Stuns          int     `json:"stuns"`
+	Passes         int     `json:"passes"`
+	Catches        int     `json:"catches"`
+	Steals         int     `json:"steals"`
+	Blocks         int     `json:"blocks"`
+	Interceptions  int     `json:"interceptions"`
+	Assists        int     `json:"assists"`
+	ShotsTaken     int     `json:"shotsTaken"`
+}
+
+// Subscription root for the EVR Data Recorder API, served over the graphql-ws
+// WebSocket protocols
+type Subscription struct {
+}
+
+// A team in a match
+type Team struct {
+	Role          Role      `json:"role"`
+	Name          string    `json:"name"`
+	HasPossession bool      `json:"hasPossession"`
+	Stats         *Stats    `json:"stats"`
+	Players       []*Player `json:"players"`
+}
+
+// Details of a disc throw
+type ThrowDetails struct {
+	ArmSpeed                float64 `json:"armSpeed"`
+	TotalSpeed              float64 `json:"totalSpeed"`
+	OffAxisSpinDeg          float64 `json:"offAxisSpinDeg"`
+	WristThrowPenalty       float64 `json:"wristThrowPenalty"`
+	RotPerSec               float64 `json:"rotPerSec"`
+	PotSpeedFromRot         float64 `json:"potSpeedFromRot"`
+	SpeedFromArm            float64 `json:"speedFromArm"`
+	SpeedFromMovement       float64 `json:"speedFromMovement"`
+	SpeedFromWrist          float64 `json:"speedFromWrist"`
+	WristAlignToThrowDeg    float64 `json:"wristAlignToThrowDeg"`
+	ThrowAlignToMovementDeg float64 `json:"throwAlignToMovementDeg"`
+	OffAxisPenalty          float64 `json:"offAxisPenalty"`
+	ThrowMovePenalty        float64 `json:"throwMovePenalty"`
+}
+
+type EmoteType string
+
+const (
+	EmoteTypeUnspecified EmoteType = "UNSPECIFIED"
+	EmoteTypePrimary     EmoteType = "PRIMARY"
+	EmoteTypeSecondary   EmoteType = "SECONDARY"
+)
+
+var AllEmoteType = []EmoteType{
+	EmoteTypeUnspecified,
+	EmoteTypePrimary,
+	EmoteTypeSecondary,
+}
+
+func (e EmoteType) IsValid() bool {
+	switch e {
+	case EmoteTypeUnspecified, EmoteTypePrimary, EmoteTypeSecondary:
+		return true
+	}
+	return false
+}
+
+func (e EmoteType) String() string {
+	return string(e)
+}
+
+func (e *EmoteType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EmoteType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EmoteType", str)
+	}
+	return nil
+}
+
+func (e EmoteType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *EmoteType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e EmoteType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Role of a player or team in a match
+type Role string
+
+const (
+	RoleUnspecified       Role = "UNSPECIFIED"
+	RoleBlueTeam          Role = "BLUE_TEAM"
+	RoleOrangeTeam        Role = "ORANGE_TEAM"
+	RoleSpectator         Role = "SPECTATOR"
+	RoleSocialParticipant Role = "SOCIAL_PARTICIPANT"
+	RoleModerator         Role = "MODERATOR"
+)
+
+var AllRole = []Role{
+	RoleUnspecified,
+	RoleBlueTeam,
+	RoleOrangeTeam,
+	RoleSpectator,
+	RoleSocialParticipant,
+	RoleModerator,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUnspecified, RoleBlueTeam, RoleOrangeTeam, RoleSpectator, RoleSocialParticipant, RoleModerator:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -1,17 +1,29 @@
 package graph
 
+//go:generate go tool gqlgen generate
+
 import (
-	"go.mongodb.org/mongo-driver/mongo"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
 )
 
 // Resolver is the root resolver for the GraphQL schema
 type Resolver struct {
-	MongoClient *mongo.Client
+	Repository store.Repository
+
+	// Live feeds the subscriptions; they fail while it is nil
+	Live LiveSource
+
+	// Auth authorizes operations; every operation is allowed while it is nil
+	Auth Auth
+
+	// Limits protecting the storage backend; zero selects the defaults
+	MaxDepth      int
+	MaxComplexity int
 }
 
-// NewResolver creates a new resolver with the given MongoDB client
-func NewResolver(mongoClient *mongo.Client) *Resolver {
+// NewResolver creates a new resolver with the given session repository
+func NewResolver(repository store.Repository) *Resolver {
 	return &Resolver{
-		MongoClient: mongoClient,
+		Repository: repository,
 	}
 }
@@ -0,0 +1,395 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Frames is the resolver for the frames field.
+func (r *lobbySessionResolver) Frames(ctx context.Context, obj *LobbySession, limit *int, offset *int) (*SessionEventConnection, error) {
+	return r.Query().SessionEvents(ctx, obj.LobbySessionID, limit, offset)
+}
+
+// Events is the resolver for the events field.
+func (r *lobbySessionResolver) Events(ctx context.Context, obj *LobbySession, types []string, limit *int, offset *int) ([]MatchEvent, error) {
+	limitVal, offsetVal := pageBounds(limit, offset)
+	return r.eventsPage(ctx, obj.LobbySessionID, types, limitVal, offsetVal)
+}
+
+// Players is the resolver for the players field.
+func (r *lobbySessionResolver) Players(ctx context.Context, obj *LobbySession) ([]*Player, error) {
+	frame, err := r.latestFrame(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return framePlayers(frame), nil
+}
+
+// Teams is the resolver for the teams field.
+func (r *lobbySessionResolver) Teams(ctx context.Context, obj *LobbySession) ([]*Team, error) {
+	frame, err := r.latestFrame(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return frameTeams(frame), nil
+}
+
+// Scoreboard is the resolver for the scoreboard field.
+func (r *lobbySessionResolver) Scoreboard(ctx context.Context, obj *LobbySession) (*Scoreboard, error) {
+	frame, err := r.latestFrame(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	return newScoreboard(frame), nil
+}
+
+// Rounds is the resolver for the rounds field.
+func (r *lobbySessionResolver) Rounds(ctx context.Context, obj *LobbySession) ([]*Round, error) {
+	events, err := r.sessionEvents(ctx, obj.LobbySessionID, []string{"round_started", "round_ended"})
+	if err != nil {
+		return nil, err
+	}
+	return roundsFromEvents(events), nil
+}
+
+// StoreSessionEvent is the resolver for the storeSessionEvent field.
+func (r *mutationResolver) StoreSessionEvent(ctx context.Context, input StoreSessionEventInput) (*StoreSessionEventPayload, error) {
+	// Convert input.FrameData (map[string]any) to LobbySessionStateFrame
+	frameDataBytes, err := json.Marshal(input.FrameData)
+	if err != nil {
+		errMsg := fmt.Sprintf("failed to serialize frame data: %v", err)
+		return &StoreSessionEventPayload{
+			Success: false,
+			Error:   &errMsg,
+		}, nil
+	}
+
+	frame := &telemetry.LobbySessionStateFrame{}
+	if err := protojson.Unmarshal(frameDataBytes, frame); err != nil {
+		errMsg := fmt.Sprintf("failed to parse frame data: %v", err)
+		return &StoreSessionEventPayload{
+			Success: false,
+			Error:   &errMsg,
+		}, nil
+	}
+
+	nodeID, userID := "", ""
+	if input.UserID != nil {
+		userID = *input.UserID
+	}
+	if r.Auth != nil {
+		// The verified identity takes precedence over the input
+		nodeID, userID = r.Auth.Identity(ctx)
+	}
+
+	doc, err := store.NewSessionFrameDocument(input.LobbySessionID, nodeID, userID, frame)
+	if err != nil {
+		errMsg := err.Error()
+		return &StoreSessionEventPayload{
+			Success: false,
+			Error:   &errMsg,
+		}, nil
+	}
+
+	err = r.Repository.StoreFrame(ctx, doc)
+	if errors.Is(err, store.ErrEventsNotStored) {
+		// The frame is stored; its events can be rebuilt by the backfill
+		err = nil
+	}
+	if errors.Is(err, store.ErrDuplicateFrame) {
+		return &StoreSessionEventPayload{
+			Success:   true,
+			Duplicate: true,
+		}, nil
+	} else if err != nil {
+		errMsg := fmt.Sprintf("failed to store frame: %v", err)
+		return &StoreSessionEventPayload{
+			Success: false,
+			Error:   &errMsg,
+		}, nil
+	}
+
+	return &StoreSessionEventPayload{
+		Success: true,
+		Event: &SessionEvent{
+			ID:             doc.ID.Hex(),
+			LobbySessionID: doc.LobbySessionID,
+			UserID:         &doc.UserID,
+			FrameIndex:     int(frame.GetFrameIndex()),
+			FrameData:      input.FrameData,
+			Timestamp:      doc.Timestamp,
+			CreatedAt:      doc.CreatedAt,
+			UpdatedAt:      doc.UpdatedAt,
+			frame:          frame,
+		},
+	}, nil
+}
+
+// LobbySession is the resolver for the lobbySession field.
+func (r *queryResolver) LobbySession(ctx context.Context, id string) (*LobbySession, error) {
+	// Basic validation of the lobby session ID to avoid using arbitrary user-controlled values in queries
+	if !store.ValidSessionID(id) {
+		return nil, store.ErrInvalidSessionID
+	}
+
+	summary, err := r.Repository.Session(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query lobby session: %w", err)
+	}
+
+	if summary == nil {
+		return nil, nil
+	}
+
+	return &LobbySession{
+		ID:             id,
+		LobbySessionID: id,
+		TotalEvents:    int(summary.FrameCount),
+		CreatedAt:      &summary.CreatedAt,
+		UpdatedAt:      &summary.UpdatedAt,
+	}, nil
+}
+
+// SessionEvents is the resolver for the sessionEvents field.
+func (r *queryResolver) SessionEvents(ctx context.Context, lobbySessionID string, limit *int, offset *int) (*SessionEventConnection, error) {
+	if !store.ValidSessionID(lobbySessionID) {
+		return nil, store.ErrInvalidSessionID
+	}
+
+	limitVal, offsetVal := pageBounds(limit, offset)
+
+	frames, totalCount, err := r.Repository.FramesPage(ctx, lobbySessionID, "", int64(limitVal), int64(offsetVal))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session events: %w", err)
+	}
+
+	edges := make([]*SessionEventEdge, 0, len(frames))
+	for i, frame := range frames {
+		edges = append(edges, &SessionEventEdge{
+			Cursor: encodeCursor(offsetVal + i),
+			Node:   newSessionEvent(frame),
+		})
+	}
+
+	hasNextPage := int64(offsetVal+limitVal) < totalCount
+	hasPreviousPage := offsetVal > 0
+
+	var startCursor, endCursor *string
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &SessionEventConnection{
+		Edges: edges,
+		PageInfo: &PageInfo{
+			HasNextPage:     hasNextPage,
+			HasPreviousPage: hasPreviousPage,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+		TotalCount: int(totalCount),
+	}, nil
+}
+
+// Health is the resolver for the health field.
+func (r *queryResolver) Health(ctx context.Context) (*HealthStatus, error) {
+	dbStatus := "connected"
+	if err := r.Repository.Ping(ctx); err != nil {
+		dbStatus = "disconnected"
+	}
+
+	return &HealthStatus{
+		Status:    "healthy",
+		Timestamp: time.Now().UTC(),
+		Database:  dbStatus,
+	}, nil
+}
+
+// Players is the resolver for the players field.
+func (r *sessionEventResolver) Players(ctx context.Context, obj *SessionEvent) ([]*Player, error) {
+	return framePlayers(obj.frame), nil
+}
+
+// Teams is the resolver for the teams field.
+func (r *sessionEventResolver) Teams(ctx context.Context, obj *SessionEvent) ([]*Team, error) {
+	return frameTeams(obj.frame), nil
+}
+
+// Scoreboard is the resolver for the scoreboard field.
+func (r *sessionEventResolver) Scoreboard(ctx context.Context, obj *SessionEvent) (*Scoreboard, error) {
+	return newScoreboard(obj.frame), nil
+}
+
+// Events is the resolver for the events field.
+func (r *sessionEventResolver) Events(ctx context.Context, obj *SessionEvent, types []string) ([]MatchEvent, error) {
+	wanted, err := eventFilter(types)
+	if err != nil {
+		return nil, err
+	}
+	events := frameEvents(obj.LobbySessionID, obj.frame, wanted)
+	if events == nil {
+		events = []MatchEvent{}
+	}
+	return events, nil
+}
+
+// MatchFrames is the resolver for the matchFrames field.
+func (r *subscriptionResolver) MatchFrames(ctx context.Context, matchID string, fps *int) (<-chan *MatchFrame, error) {
+	frames, err := r.observe(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Frames arriving sooner than the interval after the last sent frame are skipped
+	var interval time.Duration
+	if fps != nil {
+		rate := min(max(*fps, 1), maxSubscriptionFPS)
+		interval = time.Second / time.Duration(rate)
+	}
+
+	out := make(chan *MatchFrame, 1)
+	go func() {
+		defer close(out)
+
+		var lastSent time.Time
+		for live := range frames {
+			if live.Frame == nil {
+				return
+			}
+			if interval > 0 && time.Since(lastSent) < interval {
+				continue
+			}
+
+			select {
+			case out <- newMatchFrame(live):
+				lastSent = time.Now()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// MatchEvents is the resolver for the matchEvents field.
+func (r *subscriptionResolver) MatchEvents(ctx context.Context, matchID string, types []string) (<-chan MatchEvent, error) {
+	wanted, err := eventFilter(types)
+	if err != nil {
+		return nil, err
+	}
+	frames, err := r.observe(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan MatchEvent, 16)
+	go func() {
+		defer close(out)
+
+		for live := range frames {
+			if live.Frame == nil {
+				return
+			}
+			for _, event := range frameEvents(live.MatchID, live.Frame, wanted) {
+				select {
+				case out <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// LiveMatches is the resolver for the liveMatches field.
+func (r *subscriptionResolver) LiveMatches(ctx context.Context) (<-chan []*LiveMatch, error) {
+	if r.Live == nil {
+		return nil, errLiveUnavailable
+	}
+	frames := r.Live.Observe(ctx, "")
+
+	out := make(chan []*LiveMatch, 1)
+	go func() {
+		defer close(out)
+
+		// Game status of each match in the last sent list
+		sent := make(map[string]string)
+		send := func() bool {
+			matches := r.Live.LiveMatches()
+			clear(sent)
+			for _, m := range matches {
+				sent[m.MatchID] = derefString(m.GameStatus)
+			}
+			select {
+			case out <- matches:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !send() {
+			return
+		}
+
+		// Matches going stale without ending are noticed on the next refresh
+		refresh := time.NewTicker(liveMatchesRefresh)
+		defer refresh.Stop()
+
+		for {
+			select {
+			case live, ok := <-frames:
+				if !ok {
+					return
+				}
+				status, known := sent[live.MatchID]
+				changed := live.Frame == nil && known ||
+					live.Frame != nil && (!known || status != live.Frame.GetSession().GetGameStatus())
+				if changed && !send() {
+					return
+				}
+			case <-refresh.C:
+				if !send() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// LobbySession returns LobbySessionResolver implementation.
+func (r *Resolver) LobbySession() LobbySessionResolver { return &lobbySessionResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// SessionEvent returns SessionEventResolver implementation.
+func (r *Resolver) SessionEvent() SessionEventResolver { return &sessionEventResolver{r} }
+
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
+type lobbySessionResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type sessionEventResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,308 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/rpc"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// grpcScopes maps the gRPC methods to the token scope they require
+var grpcScopes = map[string]string{
+	rpc.TelemetryService_IngestFrames_FullMethodName:   ScopeIngest,
+	rpc.TelemetryService_SubscribeMatch_FullMethodName: ScopeStreamSubscribe,
+	rpc.TelemetryService_GetSession_FullMethodName:     ScopeRead,
+	rpc.TelemetryService_ListMatches_FullMethodName:    ScopeRead,
+}
+
+// GRPCServer serves the TelemetryService. Frames go through the same limits,
+// storage and fan-out as the REST and WebSocket ingest of its Server.
+type GRPCServer struct {
+	rpc.UnimplementedTelemetryServiceServer
+
+	server *Server
+	grpc   *grpc.Server
+}
+
+// NewGRPCServer creates a gRPC server for server, using its authenticator and
+// TLS configuration
+func NewGRPCServer(server *Server) *GRPCServer {
+	g := &GRPCServer{server: server}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(g.unaryAuth),
+		grpc.ChainStreamInterceptor(g.streamAuth),
+		grpc.MaxRecvMsgSize(maxIngestBodySize),
+	}
+	if server.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(server.tlsConfig)))
+	}
+
+	g.grpc = grpc.NewServer(opts...)
+	rpc.RegisterTelemetryServiceServer(g.grpc, g)
+	return g
+}
+
+// Serve accepts connections on address until Stop is called
+func (g *GRPCServer) Serve(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	g.server.logger.Info("Starting gRPC server", "address", address)
+	return g.grpc.Serve(listener)
+}
+
+// grpcStopTimeout bounds how long Stop waits for calls to finish when ctx
+// has no deadline
+const grpcStopTimeout = 10 * time.Second
+
+// Stop stops the server, waiting for calls to finish until ctx is done
+func (g *GRPCServer) Stop(ctx context.Context) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, grpcStopTimeout)
+		defer cancel()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		g.grpc.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		// Match subscriptions last until their match ends
+		g.grpc.Stop()
+	}
+}
+
+// authenticate authenticates a call by its authorization metadata or client
+// certificate and authorizes it for the scope of its method
+func (g *GRPCServer) authenticate(ctx context.Context, method string) (context.Context, error) {
+	tokenString, tokenErr := "", errMissingToken
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			scheme, token, found := strings.Cut(values[0], " ")
+			if !found || scheme != "Bearer" {
+				return nil, status.Error(codes.Unauthenticated, "invalid authorization metadata format, expected 'Bearer <token>'")
+			}
+			tokenString, tokenErr = token, nil
+		}
+	}
+
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &info.State
+		}
+	}
+
+	ctx, err := g.server.auth.authenticateConn(ctx, tokenString, tokenErr, state)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	scope, ok := grpcScopes[method]
+	if !ok {
+		// Only the methods above are registered
+		scope = ScopeAdmin
+	}
+	if err := g.server.auth.Authorize(ctx, scope); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return ctx, nil
+}
+
+func (g *GRPCServer) unaryAuth(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := g.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (g *GRPCServer) streamAuth(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := g.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authenticatedStream carries the claims of a stream in its context
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// IngestFrames implements rpc.TelemetryServiceServer. Frames rejected by a
+// rate limit or for an invalid match ID are counted and skipped; a storage
+// failure ends the stream.
+func (g *GRPCServer) IngestFrames(stream grpc.ClientStreamingServer[telemetry.LobbySessionStateFrame, rpc.IngestFramesResponse]) error {
+	ctx := stream.Context()
+	node, userID := g.server.auth.Identity(ctx)
+
+	response := &rpc.IngestFramesResponse{}
+	for {
+		frame, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(response)
+		}
+		if err != nil {
+			return err
+		}
+
+		duplicate, err := g.server.storeFrame(ctx, node, userID, frame, proto.Size(frame))
+		var limitErr *RateLimitError
+		switch {
+		case errors.As(err, &limitErr):
+			response.RateLimited++
+		case errors.Is(err, errInvalidMatchID):
+			response.Rejected++
+		case err != nil:
+			g.server.logger.Error("Failed to store session frame", "error", err, "lobby_session_id", frame.GetSession().GetSessionId())
+			return status.Error(codes.Internal, "failed to store session frame")
+		case duplicate:
+			response.Duplicates++
+		default:
+			response.Stored++
+		}
+	}
+}
+
+// SubscribeMatch implements rpc.TelemetryServiceServer
+func (g *GRPCServer) SubscribeMatch(req *rpc.SubscribeMatchRequest, stream grpc.ServerStreamingServer[telemetry.LobbySessionStateFrame]) error {
+	if req.GetLobbySessionId() == "" {
+		return status.Error(codes.InvalidArgument, "lobby_session_id is required")
+	}
+	if g.server.streamHub == nil {
+		return status.Error(codes.Unavailable, "live streaming is not enabled")
+	}
+
+	for live := range g.server.streamHub.Observe(stream.Context(), req.GetLobbySessionId()) {
+		if live.Frame == nil {
+			// The match has ended
+			return nil
+		}
+		if err := stream.Send(live.Frame); err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+// GetSession implements rpc.TelemetryServiceServer
+func (g *GRPCServer) GetSession(ctx context.Context, req *rpc.GetSessionRequest) (*rpc.GetSessionResponse, error) {
+	sessionID := req.GetLobbySessionId()
+	if sessionID == "" {
+		return nil, status.Error(codes.InvalidArgument, "lobby_session_id is required")
+	}
+
+	summary, err := g.server.repository.Session(ctx, sessionID)
+	if errors.Is(err, store.ErrInvalidSessionID) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		g.server.logger.Error("Failed to retrieve session", "error", err, "lobby_session_id", sessionID)
+		return nil, status.Error(codes.Internal, "failed to retrieve session")
+	}
+	if summary == nil {
+		return nil, status.Errorf(codes.NotFound, "session %s not found", sessionID)
+	}
+
+	var eventType *string
+	if req.GetEventType() != "" {
+		eventType = &req.EventType
+	}
+	docs, totalCount, err := RetrieveSessionFramesPaginated(ctx, g.server.repository, sessionID, eventType, req.GetLimit(), req.GetOffset())
+	if err != nil {
+		g.server.logger.Error("Failed to retrieve session frames", "error", err, "lobby_session_id", sessionID)
+		return nil, status.Error(codes.Internal, "failed to retrieve session frames")
+	}
+
+	frames := make([]*telemetry.LobbySessionStateFrame, 0, len(docs))
+	for _, doc := range docs {
+		frames = append(frames, doc.Frame)
+	}
+
+	return &rpc.GetSessionResponse{
+		Session:    sessionSummaryProto(summary),
+		Frames:     frames,
+		TotalCount: totalCount,
+	}, nil
+}
+
+// ListMatches implements rpc.TelemetryServiceServer
+func (g *GRPCServer) ListMatches(ctx context.Context, req *rpc.ListMatchesRequest) (*rpc.ListMatchesResponse, error) {
+	response := &rpc.ListMatchesResponse{}
+
+	if g.server.streamHub != nil {
+		for _, match := range g.server.streamHub.LiveMatches() {
+			live := &rpc.LiveMatch{
+				LobbySessionId: match.MatchID,
+				StartedAt:      timestamppb.New(match.StartedAt),
+				LastFrameAt:    timestamppb.New(match.LastFrameAt),
+			}
+			if match.MapName != nil {
+				live.MapName = *match.MapName
+			}
+			if match.MatchType != nil {
+				live.MatchType = *match.MatchType
+			}
+			if match.GameStatus != nil {
+				live.GameStatus = *match.GameStatus
+			}
+			response.Live = append(response.Live, live)
+		}
+	}
+
+	if req.GetIncludeStored() {
+		limit := int64(req.GetLimit())
+		if limit <= 0 {
+			limit = 100
+		}
+		sessions, err := g.server.repository.RecentSessions(ctx, limit)
+		if err != nil {
+			g.server.logger.Error("Failed to list sessions", "error", err)
+			return nil, status.Error(codes.Internal, "failed to list sessions")
+		}
+		for _, summary := range sessions {
+			response.Stored = append(response.Stored, sessionSummaryProto(summary))
+		}
+	}
+
+	return response, nil
+}
+
+func sessionSummaryProto(summary *store.SessionSummary) *rpc.SessionSummary {
+	return &rpc.SessionSummary{
+		LobbySessionId: summary.LobbySessionID,
+		NodeId:         summary.NodeID,
+		MatchType:      summary.MatchType,
+		PrivateMatch:   summary.PrivateMatch,
+		FrameCount:     summary.FrameCount,
+		FirstTimestamp: timestamppb.New(summary.FirstTimestamp),
+		LastTimestamp:  timestamppb.New(summary.LastTimestamp),
+	}
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/rpc"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServer_IngestAndGetSession(t *testing.T) {
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	g := NewGRPCServer(NewServer(repo, &DefaultLogger{}, "test-secret"))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	go g.grpc.Serve(listener)
+	defer g.Stop(context.Background())
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	defer conn.Close()
+	client := rpc.NewTelemetryServiceClient(conn)
+
+	// Calls without a token are rejected
+	if _, err := client.ListMatches(context.Background(), &rpc.ListMatchesRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("ListMatches() without token error = %v, want Unauthenticated", err)
+	}
+
+	withToken := func(claims Claims) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+signTestToken(t, "test-secret", claims))
+	}
+
+	// A read token cannot ingest
+	stream, err := client.IngestFrames(withToken(Claims{NodeID: "node-a", Scope: ScopeRead}))
+	if err != nil {
+		t.Fatalf("IngestFrames() error = %v", err)
+	}
+	if _, err := stream.CloseAndRecv(); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("IngestFrames() with read token error = %v, want PermissionDenied", err)
+	}
+
+	sessionID := "5d6f1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	stream, err = client.IngestFrames(withToken(Claims{NodeID: "node-a", Scope: ScopeIngest}))
+	if err != nil {
+		t.Fatalf("IngestFrames() error = %v", err)
+	}
+	for _, i := range []uint32{1, 2, 2, 3} {
+		frame := &telemetry.LobbySessionStateFrame{FrameIndex: i, Session: &apigame.SessionResponse{SessionId: sessionID}}
+		if err := stream.Send(frame); err != nil && err != io.EOF {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	if err := stream.Send(&telemetry.LobbySessionStateFrame{FrameIndex: 4}); err != nil && err != io.EOF {
+		t.Fatalf("Send() error = %v", err)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatalf("CloseAndRecv() error = %v", err)
+	}
+	if resp.Stored != 3 || resp.Duplicates != 1 || resp.Rejected != 1 {
+		t.Errorf("IngestFrames() = %+v, want 3 stored, 1 duplicate, 1 rejected", resp)
+	}
+
+	session, err := client.GetSession(withToken(Claims{Scope: ScopeRead}), &rpc.GetSessionRequest{LobbySessionId: sessionID})
+	if err != nil {
+		t.Fatalf("GetSession() error = %v", err)
+	}
+	if len(session.Frames) != 3 || session.TotalCount != 3 || session.Session.GetNodeId() != "node-a" {
+		t.Errorf("GetSession() = %d frames of %d, node %q, want 3 of 3 from node-a", len(session.Frames), session.TotalCount, session.Session.GetNodeId())
+	}
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// IdempotencyKeyHeader names the request header that makes an ingest POST
+	// safe to retry
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayHeader is set on responses replayed for a repeated key
+	IdempotentReplayHeader = "Idempotent-Replayed"
+
+	idempotencyTTL     = 24 * time.Hour
+	idempotencyMaxKeys = 100000
+	idempotencyMaxKey  = 255
+)
+
+var (
+	errIdempotencyInProgress = errors.New("a request with this Idempotency-Key is in progress")
+	errIdempotencyMismatch   = errors.New("this Idempotency-Key was used for a different request body")
+)
+
+// idempotentResponse is the stored response to a request with an
+// Idempotency-Key; it is pending until the first request completes
+type idempotentResponse struct {
+	bodyHash    [sha256.Size]byte // Of the request body, which repeats must match
+	status      int
+	contentType string
+	body        []byte
+	pending     bool
+	expires     time.Time
+}
+
+// IdempotencyCache remembers the responses to ingest requests by node and
+// Idempotency-Key, so that a retried request is answered without storing its
+// frames again
+type IdempotencyCache struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	responses map[string]*idempotentResponse
+}
+
+// NewIdempotencyCache creates a cache that keeps responses for ttl
+func NewIdempotencyCache(ttl time.Duration) *IdempotencyCache {
+	return &IdempotencyCache{
+		ttl:       ttl,
+		responses: make(map[string]*idempotentResponse),
+	}
+}
+
+// begin claims key for a new request with a body of the given hash. It
+// returns the stored response if the key was used before, and
+// errIdempotencyMismatch if that was for a different body or
+// errIdempotencyInProgress if a request with the key is in flight.
+func (c *IdempotencyCache) begin(key string, bodyHash [sha256.Size]byte, now time.Time) (*idempotentResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if resp, found := c.responses[key]; found && now.Before(resp.expires) {
+		switch {
+		case resp.bodyHash != bodyHash:
+			return nil, errIdempotencyMismatch
+		case resp.pending:
+			return nil, errIdempotencyInProgress
+		}
+		return resp, nil
+	}
+
+	if len(c.responses) >= idempotencyMaxKeys {
+		c.prune(now)
+	}
+	c.responses[key] = &idempotentResponse{bodyHash: bodyHash, pending: true, expires: now.Add(c.ttl)}
+	return nil, nil
+}
+
+// finish stores the response to the request that claimed key. Failed
+// requests release the key so that they can be retried.
+func (c *IdempotencyCache) finish(key string, status int, contentType string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if status >= http.StatusInternalServerError || status == http.StatusTooManyRequests {
+		delete(c.responses, key)
+		return
+	}
+	if resp, ok := c.responses[key]; ok {
+		resp.status = status
+		resp.contentType = contentType
+		resp.body = body
+		resp.pending = false
+	}
+}
+
+// prune drops expired responses, then the oldest ones if the cache is still full
+func (c *IdempotencyCache) prune(now time.Time) {
+	var oldestKey string
+	var oldest time.Time
+	for key, resp := range c.responses {
+		if !now.Before(resp.expires) {
+			delete(c.responses, key)
+		} else if !resp.pending && (oldestKey == "" || resp.expires.Before(oldest)) {
+			oldestKey, oldest = key, resp.expires
+		}
+	}
+	if len(c.responses) >= idempotencyMaxKeys && oldestKey != "" {
+		delete(c.responses, oldestKey)
+	}
+}
+
+// recordingResponseWriter captures the response while writing it through
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *recordingResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// idempotent wraps an ingest handler so that requests carrying an
+// Idempotency-Key are processed once per node and key. Repeats get the first
+// response back with the Idempotent-Replayed header; a repeat that arrives
+// while the first request is still being processed gets 409, and a request
+// reusing the key with a different body gets 422.
+func (s *Server) idempotent(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || s.idempotency == nil {
+			next(w, r)
+			return
+		}
+		if len(key) > idempotencyMaxKey {
+			http.Error(w, "Idempotency-Key is too long", http.StatusBadRequest)
+			return
+		}
+
+		// The body is read ahead to tell a retry from a different request
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestBodySize))
+		if err != nil {
+			err = wrapBodyError(err)
+			http.Error(w, err.Error(), ingestBodyStatus(err))
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		node, _ := s.auth.Identity(r.Context())
+		cacheKey := node + "\x00" + key
+
+		resp, err := s.idempotency.begin(cacheKey, sha256.Sum256(body), time.Now())
+		switch {
+		case errors.Is(err, errIdempotencyMismatch):
+			http.Error(w, "Idempotency-Key was used for a different request body", http.StatusUnprocessableEntity)
+			return
+		case err != nil:
+			http.Error(w, "A request with this Idempotency-Key is in progress", http.StatusConflict)
+			return
+		}
+		if resp != nil {
+			if resp.contentType != "" {
+				w.Header().Set("Content-Type", resp.contentType)
+			}
+			w.Header().Set(IdempotentReplayHeader, "true")
+			w.WriteHeader(resp.status)
+			w.Write(resp.body)
+			return
+		}
+
+		rec := &recordingResponseWriter{ResponseWriter: w}
+		defer func() {
+			status := rec.status
+			if status == 0 {
+				status = http.StatusOK
+			}
+			s.idempotency.finish(cacheKey, status, rec.Header().Get("Content-Type"), rec.body.Bytes())
+		}()
+		next(rec, r)
+	}
+}
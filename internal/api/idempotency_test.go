@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+)
+
+func TestServer_IdempotentIngest(t *testing.T) {
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	server := NewServer(repo, &DefaultLogger{}, "test-secret")
+	token := signTestToken(t, "test-secret", Claims{NodeID: "node-a", Scope: ScopeIngest})
+
+	sessionID := "5d6f1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	post := func(t *testing.T, frameIndex, idempotencyKey string) (*httptest.ResponseRecorder, map[string]any) {
+		t.Helper()
+		body := `{"frame_index": ` + frameIndex + `, "session": {"sessionid": "` + sessionID + `"}}`
+		req := httptest.NewRequest(http.MethodPost, "/v3/lobby-session-events", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		if idempotencyKey != "" {
+			req.Header.Set(IdempotencyKeyHeader, idempotencyKey)
+		}
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		var resp map[string]any
+		json.Unmarshal(rec.Body.Bytes(), &resp)
+		return rec, resp
+	}
+
+	if rec, resp := post(t, "1", ""); rec.Code != http.StatusOK || resp["duplicate"] != nil {
+		t.Fatalf("first frame = %d %v, want 200 without duplicate", rec.Code, resp)
+	}
+	if rec, resp := post(t, "1", ""); rec.Code != http.StatusOK || resp["duplicate"] != true {
+		t.Fatalf("repeated frame = %d %v, want 200 duplicate", rec.Code, resp)
+	}
+
+	// A repeated key replays the first response, but only for the same body
+	if rec, _ := post(t, "2", "batch-1"); rec.Code != http.StatusOK || rec.Header().Get(IdempotentReplayHeader) != "" {
+		t.Fatalf("first keyed request = %d, replayed %q", rec.Code, rec.Header().Get(IdempotentReplayHeader))
+	}
+	rec, resp := post(t, "2", "batch-1")
+	if rec.Code != http.StatusOK || rec.Header().Get(IdempotentReplayHeader) != "true" || resp["duplicate"] != nil {
+		t.Errorf("replayed request = %d %v, replayed %q", rec.Code, resp, rec.Header().Get(IdempotentReplayHeader))
+	}
+	if rec, _ := post(t, "3", "batch-1"); rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("reused key with another body = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	frames, err := repo.Frames(context.Background(), sessionID)
+	if err != nil || len(frames) != 2 {
+		t.Fatalf("Frames() = %d frames, %v, want 2", len(frames), err)
+	}
+}
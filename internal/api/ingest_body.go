@@ -0,0 +1,217 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Content types accepted by the v3 ingest route. JSON and protobuf bodies
+// carry one frame; NDJSON and delimited protobuf bodies carry a batch.
+const (
+	ContentTypeJSON              = "application/json"
+	ContentTypeNDJSON            = "application/x-ndjson"
+	ContentTypeProtobuf          = "application/x-protobuf"
+	ContentTypeProtobufDelimited = "application/x-protobuf; delimited=true" // Frames prefixed by their varint length
+)
+
+// Content encodings accepted for ingest request bodies
+const (
+	EncodingGzip = "gzip"
+	EncodingZstd = "zstd"
+)
+
+// maxIngestBodySize caps an ingest body after decompression
+const maxIngestBodySize = 64 << 20
+
+var (
+	errUnsupportedMediaType = errors.New("unsupported content type or encoding")
+	errBodyTooLarge         = errors.New("request body too large")
+)
+
+// decodedFrame is a frame read from an ingest body with its encoded size,
+// which is charged against the ingest quotas
+type decodedFrame struct {
+	frame *telemetry.LobbySessionStateFrame
+	size  int
+}
+
+// decodeIngestBody reads the frames of a v3 ingest request, decompressing the
+// body according to its Content-Encoding. batch reports whether the body was
+// a batch format, which is answered with a StoreSessionEventsResponse.
+func decodeIngestBody(w http.ResponseWriter, r *http.Request) (frames []decodedFrame, batch bool, err error) {
+	body, err := ingestBodyReader(w, r)
+	if err != nil {
+		return nil, false, err
+	}
+	defer body.Close()
+
+	mediaType, params := ContentTypeJSON, map[string]string{}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mediaType, params, err = mime.ParseMediaType(ct); err != nil {
+			return nil, false, fmt.Errorf("%w: %v", errUnsupportedMediaType, err)
+		}
+	}
+
+	switch {
+	case mediaType == ContentTypeJSON:
+		data, err := readIngestBody(body)
+		if err != nil {
+			return nil, false, err
+		}
+		frame := &telemetry.LobbySessionStateFrame{}
+		if err := protojson.Unmarshal(data, frame); err != nil {
+			return nil, false, fmt.Errorf("invalid protobuf payload: %w", err)
+		}
+		return []decodedFrame{{frame, len(data)}}, false, nil
+
+	case mediaType == ContentTypeNDJSON:
+		frames, err := decodeNDJSON(body)
+		return frames, true, err
+
+	case mediaType == ContentTypeProtobuf && params["delimited"] == "true":
+		frames, err := decodeDelimited(body)
+		return frames, true, err
+
+	case mediaType == ContentTypeProtobuf:
+		data, err := readIngestBody(body)
+		if err != nil {
+			return nil, false, err
+		}
+		frame := &telemetry.LobbySessionStateFrame{}
+		if err := proto.Unmarshal(data, frame); err != nil {
+			return nil, false, fmt.Errorf("invalid protobuf payload: %w", err)
+		}
+		return []decodedFrame{{frame, len(data)}}, false, nil
+	}
+
+	return nil, false, fmt.Errorf("%w: %s", errUnsupportedMediaType, mediaType)
+}
+
+// ingestBodyReader returns the decompressed request body, limited to
+// maxIngestBodySize bytes before and after decompression
+func ingestBodyReader(w http.ResponseWriter, r *http.Request) (io.ReadCloser, error) {
+	raw := http.MaxBytesReader(w, r.Body, maxIngestBodySize)
+
+	var body io.ReadCloser
+	switch encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding"))); encoding {
+	case "", "identity":
+		body = raw
+	case EncodingGzip:
+		gz, err := gzip.NewReader(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip body: %w", err)
+		}
+		body = gz
+	case EncodingZstd:
+		zr, err := zstd.NewReader(raw, zstd.WithDecoderMaxMemory(maxIngestBodySize))
+		if err != nil {
+			return nil, fmt.Errorf("invalid zstd body: %w", err)
+		}
+		body = zr.IOReadCloser()
+	default:
+		return nil, fmt.Errorf("%w: encoding %s", errUnsupportedMediaType, encoding)
+	}
+
+	return &limitedBody{Reader: io.LimitReader(body, maxIngestBodySize+1), closer: body}, nil
+}
+
+// limitedBody reports errBodyTooLarge once more than maxIngestBodySize bytes
+// have been read
+type limitedBody struct {
+	io.Reader
+	closer io.Closer
+	n      int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	b.n += int64(n)
+	if b.n > maxIngestBodySize {
+		return n, errBodyTooLarge
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.closer.Close()
+}
+
+func readIngestBody(body io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, wrapBodyError(err)
+	}
+	return data, nil
+}
+
+// decodeNDJSON reads one protojson frame per line, skipping blank lines
+func decodeNDJSON(body io.Reader) ([]decodedFrame, error) {
+	var frames []decodedFrame
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxIngestBodySize)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		frame := &telemetry.LobbySessionStateFrame{}
+		if err := protojson.Unmarshal(data, frame); err != nil {
+			return nil, fmt.Errorf("invalid frame on line %d: %w", line, err)
+		}
+		frames = append(frames, decodedFrame{frame, len(data)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, wrapBodyError(err)
+	}
+	return frames, nil
+}
+
+// decodeDelimited reads frames prefixed by their varint-encoded length
+func decodeDelimited(body io.Reader) ([]decodedFrame, error) {
+	var frames []decodedFrame
+	reader := bufio.NewReader(body)
+	opts := protodelim.UnmarshalOptions{MaxSize: maxIngestBodySize}
+	for {
+		frame := &telemetry.LobbySessionStateFrame{}
+		if err := opts.UnmarshalFrom(reader, frame); errors.Is(err, io.EOF) {
+			return frames, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid frame %d: %w", len(frames), wrapBodyError(err))
+		}
+		frames = append(frames, decodedFrame{frame, proto.Size(frame)})
+	}
+}
+
+// wrapBodyError maps the read errors of an oversized body to errBodyTooLarge
+func wrapBodyError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return errBodyTooLarge
+	}
+	return err
+}
+
+// ingestBodyStatus returns the HTTP status for an error decoding an ingest body
+func ingestBodyStatus(err error) int {
+	switch {
+	case errors.Is(err, errUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, errBodyTooLarge):
+		return http.StatusRequestEntityTooLarge
+	default:
+		return http.StatusBadRequest
+	}
+}
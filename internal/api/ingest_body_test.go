@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+func TestClient_StoreSessionEvents(t *testing.T) {
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	ts := httptest.NewServer(NewServer(repo, &DefaultLogger{}, "test-secret"))
+	defer ts.Close()
+	token := signTestToken(t, "test-secret", Claims{NodeID: "node-a", Scope: ScopeIngest})
+
+	sessionID := "5d6f1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	frames := func(indexes ...uint32) []*telemetry.LobbySessionStateFrame {
+		var frames []*telemetry.LobbySessionStateFrame
+		for _, i := range indexes {
+			frames = append(frames, &telemetry.LobbySessionStateFrame{
+				FrameIndex: i,
+				Session:    &apigame.SessionResponse{SessionId: sessionID},
+			})
+		}
+		return frames
+	}
+
+	tests := []struct {
+		format, compression string
+		frames              []*telemetry.LobbySessionStateFrame
+		stored, duplicates  int
+	}{
+		{BatchFormatProtobuf, "", frames(1, 2, 3), 3, 0},
+		{BatchFormatProtobuf, EncodingZstd, frames(3, 4), 1, 1},
+		{BatchFormatNDJSON, EncodingGzip, frames(4, 5, 6), 2, 1},
+	}
+	for _, tt := range tests {
+		client := NewClient(ClientConfig{BaseURL: ts.URL, JWTToken: token, BatchFormat: tt.format, Compression: tt.compression})
+		resp, err := client.StoreSessionEvents(context.Background(), tt.frames, "")
+		if err != nil {
+			t.Fatalf("%s/%s: StoreSessionEvents() error = %v", tt.format, tt.compression, err)
+		}
+		if !resp.Success || resp.Stored != tt.stored || resp.Duplicates != tt.duplicates {
+			t.Errorf("%s/%s: response = %+v, want %d stored, %d duplicates", tt.format, tt.compression, resp, tt.stored, tt.duplicates)
+		}
+	}
+
+	stored, err := repo.Frames(context.Background(), sessionID)
+	if err != nil || len(stored) != 6 {
+		t.Fatalf("Frames() = %d frames, %v, want 6", len(stored), err)
+	}
+}
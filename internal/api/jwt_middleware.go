@@ -1,52 +1,296 @@
 package api
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
 )
 
-// JWTMiddleware validates JWT tokens from the Authorization header
-func JWTMiddleware(jwtSecret string, next http.HandlerFunc) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		// Extract token from Authorization header
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			http.Error(w, "Authorization header required", http.StatusUnauthorized)
-			return
-		}
+// Token scopes. A token carries them space-separated in its "scope" claim.
+const (
+	ScopeIngest          = "ingest"           // Store frames over HTTP, WebSocket and GraphQL mutations
+	ScopeRead            = "read"             // Query sessions, GraphQL queries and capture downloads
+	ScopeStreamSubscribe = "stream:subscribe" // Subscribe to live matches
+	ScopeAdmin           = "admin"            // Administrative routes; implies every other scope
+)
+
+// defaultNodeID is the node recorded for frames ingested with a token that has no node_id claim
+const defaultNodeID = "default-node"
+
+var (
+	errMissingToken = errors.New("authorization required")
+	errForbidden    = errors.New("token lacks the required scope")
+)
+
+// Claims are the verified claims of an API token
+type Claims struct {
+	NodeID string `json:"node_id,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+	Scope  string `json:"scope,omitempty"`
+	jwt.RegisteredClaims
+
+	// tokenHash is the SHA-256 of the token the claims were read from,
+	// empty for requests authenticated by a client certificate alone
+	tokenHash string
+}
+
+// TokenKey identifies the token of the claims for per-token limits: by its
+// jti claim, or by a hash of the token if it has none. It is empty for
+// requests authenticated by a client certificate alone.
+func (c *Claims) TokenKey() string {
+	if c.ID != "" {
+		return "jti:" + c.ID
+	}
+	if c.tokenHash != "" {
+		return "sha256:" + c.tokenHash
+	}
+	return ""
+}
+
+// Scopes returns the scopes granted by the token
+func (c *Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// HasScope reports whether the token grants scope
+func (c *Claims) HasScope(scope string) bool {
+	scopes := c.Scopes()
+	return slices.Contains(scopes, scope) || slices.Contains(scopes, ScopeAdmin)
+}
+
+// Node returns the node the token was issued to, or the default node
+func (c *Claims) Node() string {
+	if c.NodeID == "" {
+		return defaultNodeID
+	}
+	return c.NodeID
+}
 
-		// Check for Bearer token format
-		parts := strings.SplitN(authHeader, " ", 2)
-		if len(parts) != 2 || parts[0] != "Bearer" {
-			http.Error(w, "Invalid authorization header format. Expected 'Bearer <token>'", http.StatusUnauthorized)
-			return
+type claimsKey struct{}
+
+// ClaimsFromContext returns the claims of the token that authenticated the request
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// Authenticator verifies API tokens and enforces their scopes
+type Authenticator struct {
+	mu      sync.RWMutex
+	keys    map[string]*SigningKey // By kid; "" is the legacy jwt_secret
+	revoked *RevocationList
+
+	// Client certificate authentication; nil when disabled
+	certAuth *TLSConfig
+}
+
+// NewAuthenticator creates an authenticator. Tokens without a kid header are
+// verified with the HMAC secret, if one is given.
+func NewAuthenticator(secret string) *Authenticator {
+	a := &Authenticator{keys: make(map[string]*SigningKey)}
+	if secret != "" {
+		a.keys[""] = NewHMACKey("", []byte(secret))
+	}
+	return a
+}
+
+// AddKey accepts tokens signed with key, selected by its kid
+func (a *Authenticator) AddKey(key *SigningKey) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.keys[key.ID] = key
+}
+
+// SetRevocationList rejects tokens whose ID is in the list
+func (a *Authenticator) SetRevocationList(list *RevocationList) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.revoked = list
+}
+
+// SetClientCertAuth authenticates requests by their verified client
+// certificate. Certificates identify their node, overriding a token's node_id,
+// and grant the configured client scope when no token is sent.
+func (a *Authenticator) SetClientCertAuth(config TLSConfig) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if config.ClientScope == "" {
+		config.ClientScope = ScopeIngest
+	}
+	a.certAuth = &config
+}
+
+// Verify parses a token and returns its claims if it is valid
+func (a *Authenticator) Verify(tokenString string) (*Claims, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := a.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
 		}
+		// The algorithm is fixed by the key, never chosen by the token
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.verifyKey, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if a.revoked != nil && claims.ID != "" && a.revoked.IsRevoked(claims.ID) {
+		return nil, errRevoked
+	}
+	sum := sha256.Sum256([]byte(tokenString))
+	claims.tokenHash = hex.EncodeToString(sum[:])
+	return claims, nil
+}
+
+// Authenticate verifies a bearer token and returns ctx carrying its claims
+func (a *Authenticator) Authenticate(ctx context.Context, tokenString string) (context.Context, error) {
+	tokenString = strings.TrimPrefix(tokenString, "Bearer ")
+	if tokenString == "" {
+		return nil, errMissingToken
+	}
+	claims, err := a.Verify(tokenString)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
 
-		tokenString := parts[1]
+// AuthenticateRequest authenticates a request by its bearer token or its
+// verified client certificate and returns ctx carrying the claims
+func (a *Authenticator) AuthenticateRequest(r *http.Request) (context.Context, error) {
+	tokenString, err := bearerToken(r)
+	return a.authenticateConn(r.Context(), tokenString, err, r.TLS)
+}
 
-		// Parse and validate the token
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Verify the signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-			return []byte(jwtSecret), nil
-		})
+// authenticateConn authenticates by a bearer token, or by the verified client
+// certificate of the TLS connection if there is no token (tokenErr is
+// errMissingToken). A certificate also pins the node of a token.
+func (a *Authenticator) authenticateConn(ctx context.Context, tokenString string, tokenErr error, state *tls.ConnectionState) (context.Context, error) {
+	a.mu.RLock()
+	certAuth := a.certAuth
+	a.mu.RUnlock()
 
-		if err != nil {
-			http.Error(w, fmt.Sprintf("Invalid token: %v", err), http.StatusUnauthorized)
-			return
+	var cert *x509.Certificate
+	if certAuth != nil && state != nil && len(state.VerifiedChains) > 0 {
+		cert = state.VerifiedChains[0][0]
+	}
+
+	if tokenErr != nil {
+		if cert == nil || !errors.Is(tokenErr, errMissingToken) {
+			return nil, tokenErr
 		}
+		claims := &Claims{NodeID: certAuth.certNode(cert), Scope: certAuth.ClientScope}
+		return context.WithValue(ctx, claimsKey{}, claims), nil
+	}
+
+	ctx, err := a.Authenticate(ctx, tokenString)
+	if err != nil || cert == nil {
+		return ctx, err
+	}
+
+	// The certificate pins the node the token is used from
+	claims, _ := ClaimsFromContext(ctx)
+	pinned := *claims
+	pinned.NodeID = certAuth.certNode(cert)
+	return context.WithValue(ctx, claimsKey{}, &pinned), nil
+}
+
+// Authorize returns an error unless the caller in ctx was granted scope
+func (a *Authenticator) Authorize(ctx context.Context, scope string) error {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return errMissingToken
+	}
+	if scope != "" && !claims.HasScope(scope) {
+		return fmt.Errorf("%w %q", errForbidden, scope)
+	}
+	return nil
+}
+
+// AuthorizeOperation implements graph.Auth, mapping GraphQL operations to scopes
+func (a *Authenticator) AuthorizeOperation(ctx context.Context, operation string) error {
+	switch operation {
+	case "mutation":
+		return a.Authorize(ctx, ScopeIngest)
+	case "subscription":
+		return a.Authorize(ctx, ScopeStreamSubscribe)
+	default:
+		return a.Authorize(ctx, ScopeRead)
+	}
+}
+
+// Identity implements graph.Auth
+func (a *Authenticator) Identity(ctx context.Context) (nodeID, userID string) {
+	if claims, ok := ClaimsFromContext(ctx); ok {
+		return claims.Node(), claims.UserID
+	}
+	return defaultNodeID, ""
+}
 
-		if !token.Valid {
-			http.Error(w, "Token is not valid", http.StatusUnauthorized)
-			return
+// bearerToken extracts the token of a request from its Authorization header.
+// WebSocket upgrades and event streams may pass it in the access_token query
+// parameter instead, since browsers cannot set headers on them.
+func bearerToken(r *http.Request) (string, error) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		if websocket.IsWebSocketUpgrade(r) || wantsEventStream(r) {
+			if token := r.URL.Query().Get("access_token"); token != "" {
+				return token, nil
+			}
 		}
+		return "", errMissingToken
+	}
 
-		// Token is valid, proceed to the next handler
-		next(w, r)
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return "", fmt.Errorf("invalid authorization header format, expected 'Bearer <token>'")
+	}
+	return parts[1], nil
+}
+
+// JWTMiddleware requires a valid token or client certificate granting scope
+// on every request of the router, and stores its claims in the request
+// context. An empty scope only requires a valid token. CORS preflight
+// requests pass through.
+func JWTMiddleware(auth *Authenticator, scope string) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx, err := auth.AuthenticateRequest(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			if err := auth.Authorize(ctx, scope); err != nil {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
 	}
 }
@@ -0,0 +1,185 @@
+package api
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func signTestToken(t *testing.T, secret string, claims Claims) string {
+	t.Helper()
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(time.Hour))
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("SignedString() error = %v", err)
+	}
+	return token
+}
+
+func TestJWTMiddleware_Scopes(t *testing.T) {
+	const secret = "test-secret"
+	sessionID := "7c1e5a90-2b4d-4f3e-8a6c-9d0b1e2f3a4b"
+
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	server := NewServer(repo, &DefaultLogger{}, secret)
+
+	ingest := signTestToken(t, secret, Claims{NodeID: "node7", UserID: "user7", Scope: ScopeIngest})
+	read := signTestToken(t, secret, Claims{Scope: ScopeRead})
+	admin := signTestToken(t, secret, Claims{Scope: ScopeAdmin})
+	forged := signTestToken(t, "other-secret", Claims{Scope: ScopeAdmin})
+
+	frame := `{"session": {"sessionid": "` + sessionID + `"}}`
+	graphql := `{"query": "{ health { status } }"}`
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		body   string
+		want   int
+	}{
+		{"health is open", "GET", "/health", "", "", http.StatusOK},
+		{"missing token", "GET", "/v3/lobby-session-events/" + sessionID, "", "", http.StatusUnauthorized},
+		{"forged token", "GET", "/v3/lobby-session-events/" + sessionID, forged, "", http.StatusUnauthorized},
+		{"read token cannot ingest", "POST", "/v3/lobby-session-events", read, frame, http.StatusForbidden},
+		{"ingest token can ingest", "POST", "/v3/lobby-session-events", ingest, frame, http.StatusOK},
+		{"ingest token cannot read", "GET", "/lobby-session-events/" + sessionID, ingest, "", http.StatusForbidden},
+		{"read token can read", "GET", "/v1/lobby-session-events/" + sessionID, read, "", http.StatusOK},
+		{"admin token can read", "GET", "/v3/lobby-session-events/" + sessionID, admin, "", http.StatusOK},
+		{"graphql requires a token", "POST", "/v3/query", "", graphql, http.StatusUnauthorized},
+		{"graphql query with read token", "POST", "/v3/query", read, graphql, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-Node-ID", "spoofed-node")
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("%s %s = %d %q, want %d", tt.method, tt.path, rec.Code, rec.Body.String(), tt.want)
+			}
+		})
+	}
+
+	// The ingested frame is attributed to the token's identity, not the headers
+	frames, err := repo.Frames(context.Background(), sessionID)
+	if err != nil || len(frames) != 1 {
+		t.Fatalf("Frames() = %d frames, %v, want 1", len(frames), err)
+	}
+	if frames[0].NodeID != "node7" || frames[0].UserID != "user7" {
+		t.Errorf("frame identity = %q/%q, want node7/user7", frames[0].NodeID, frames[0].UserID)
+	}
+
+	// GraphQL operations are authorized by type
+	req := httptest.NewRequest("POST", "/v3/query", strings.NewReader(graphql))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+ingest)
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	if !strings.Contains(rec.Body.String(), `"code":"FORBIDDEN"`) {
+		t.Errorf("query with ingest token = %s, want FORBIDDEN", rec.Body.String())
+	}
+}
+
+func TestAuthenticator_KeysAndRevocation(t *testing.T) {
+	dir := t.TempDir()
+
+	_, private, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey() error = %v", err)
+	}
+	keyFile := filepath.Join(dir, "ed.pem")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := LoadSigningKeys([]JWTKey{
+		{ID: "ed", Algorithm: AlgorithmEdDSA, PrivateKeyFile: keyFile},
+		{ID: "old", Algorithm: AlgorithmHS256, Secret: "old-secret"},
+	})
+	if err != nil {
+		t.Fatalf("LoadSigningKeys() error = %v", err)
+	}
+	revoked, err := NewRevocationList(filepath.Join(dir, "revoked.json"))
+	if err != nil {
+		t.Fatalf("NewRevocationList() error = %v", err)
+	}
+
+	auth := NewAuthenticator("legacy-secret")
+	for _, key := range keys {
+		auth.AddKey(key)
+	}
+	auth.SetRevocationList(revoked)
+
+	edToken, err := IssueToken(keys[0], Claims{
+		NodeID:           "node1",
+		Scope:            ScopeRead,
+		RegisteredClaims: jwt.RegisteredClaims{ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour))},
+	})
+	if err != nil {
+		t.Fatalf("IssueToken() error = %v", err)
+	}
+	oldToken, err := IssueToken(keys[1], Claims{Scope: ScopeRead})
+	if err != nil {
+		t.Fatalf("IssueToken() error = %v", err)
+	}
+	legacy := signTestToken(t, "legacy-secret", Claims{Scope: ScopeRead})
+
+	// A token claiming the Ed25519 kid but signed with HMAC must not verify
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{Scope: ScopeAdmin})
+	confused.Header["kid"] = "ed"
+	confusedToken, _ := confused.SignedString([]byte("old-secret"))
+
+	for name, token := range map[string]string{"ed25519": edToken, "rotated hmac": oldToken, "no kid": legacy} {
+		if _, err := auth.Verify(token); err != nil {
+			t.Errorf("Verify(%s) error = %v", name, err)
+		}
+	}
+	// Tokens without a jti are told apart for per-token limits by their hash
+	if claims, err := auth.Verify(legacy); err != nil {
+		t.Errorf("Verify(no kid) error = %v", err)
+	} else if !strings.HasPrefix(claims.TokenKey(), "sha256:") {
+		t.Errorf("TokenKey() of a token without jti = %q, want a hash", claims.TokenKey())
+	}
+	if _, err := auth.Verify(confusedToken); err == nil {
+		t.Error("Verify() accepted an HMAC token with an EdDSA kid")
+	}
+
+	claims, err := auth.Verify(edToken)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if err := revoked.Revoke(claims.ID, claims.ExpiresAt.Time); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if _, err := auth.Verify(edToken); !errors.Is(err, errRevoked) {
+		t.Errorf("Verify(revoked) error = %v, want %v", err, errRevoked)
+	}
+	if _, err := auth.Verify(oldToken); err != nil {
+		t.Errorf("Verify(other token) error = %v", err)
+	}
+}
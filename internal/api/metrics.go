@@ -28,6 +28,16 @@ type Metrics struct {
 	// WebSocket metrics
 	WebSocketConnections prometheus.Gauge
 	WebSocketMessages    prometheus.Counter
+	SSEConnections       prometheus.Gauge
+
+	// Stream backpressure
+	StreamFramesDropped      *prometheus.CounterVec
+	StreamSubscribersLagging prometheus.Gauge
+	StreamSubscriberLag      prometheus.Histogram
+	StreamSlowDisconnects    prometheus.Counter
+
+	// Frame bus between instances
+	FrameBusPublishErrors prometheus.Counter
 
 	// API metrics
 	APIRequestDuration *prometheus.HistogramVec
@@ -102,6 +112,39 @@ func NewMetrics(namespace string) *Metrics {
 			Name:      "websocket_messages_total",
 			Help:      "Total number of WebSocket messages received",
 		}),
+		SSEConnections: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "sse_connections",
+			Help:      "Number of open Server-Sent Events streams",
+		}),
+
+		StreamFramesDropped: promauto.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "stream_frames_dropped_total",
+			Help:      "Total number of stream frames not sent to subscribers that fell behind, by policy",
+		}, []string{"policy"}),
+		StreamSubscribersLagging: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "stream_subscribers_lagging",
+			Help:      "Number of stream subscribers whose send buffer is full",
+		}),
+		StreamSubscriberLag: promauto.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "stream_subscriber_lag_seconds",
+			Help:      "Histogram of how long stream subscribers stayed behind before catching up",
+			Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}),
+		StreamSlowDisconnects: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "stream_slow_consumer_disconnects_total",
+			Help:      "Total number of stream connections closed for staying behind",
+		}),
+
+		FrameBusPublishErrors: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "frame_bus_publish_errors_total",
+			Help:      "Total number of frame bus messages that failed to publish to the other instances",
+		}),
 
 		APIRequestDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: namespace,
@@ -217,6 +260,23 @@ func (m *Metrics) RecordWebSocketMessage() {
 	m.WebSocketMessages.Inc()
 }
 
+// RecordStreamDrops records frames not sent to a stream subscriber
+func (m *Metrics) RecordStreamDrops(policy string, n int) {
+	m.StreamFramesDropped.WithLabelValues(policy).Add(float64(n))
+}
+
+// RecordSlowConsumerDisconnect records a stream connection closed for
+// staying behind
+func (m *Metrics) RecordSlowConsumerDisconnect() {
+	m.StreamSlowDisconnects.Inc()
+}
+
+// RecordFrameBusPublishError records a frame bus message that failed to
+// publish
+func (m *Metrics) RecordFrameBusPublishError() {
+	m.FrameBusPublishErrors.Inc()
+}
+
 // RecordRateLimitExceeded records a rate limit exceeded event
 func (m *Metrics) RecordRateLimitExceeded() {
 	m.RateLimitExceeded.Inc()
@@ -3,58 +3,152 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-// MigrationStats holds statistics about the migration process
-type MigrationStats struct {
-	TotalDocuments    int64
-	MigratedDocuments int64
-	SkippedDocuments  int64
-	FailedDocuments   int64
-	StartTime         time.Time
-	EndTime           time.Time
+// migrations is the ordered list of schema migrations for the session data.
+// New migrations must be appended with the next version number.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "add_document_metadata",
+		Pending: func(ctx context.Context, db *mongo.Database) (int64, error) {
+			return db.Collection(store.FramesCollectionName).CountDocuments(ctx, legacyMetadataFilter)
+		},
+		Up: migrateDocumentMetadata,
+		// Down is not supported: the original _id values are not kept
+	},
+	{
+		Version: 2,
+		Name:    "binary_frames",
+		Pending: func(ctx context.Context, db *mongo.Database) (int64, error) {
+			return db.Collection(store.FramesCollectionName).CountDocuments(ctx, bson.M{"schema_version": bson.M{"$ne": store.FrameSchemaVersion}})
+		},
+		Up:   migrateFramesToBinary,
+		Down: migrateFramesToJSON,
+	},
+	{
+		Version: 3,
+		Name:    "typed_events",
+		Pending: func(ctx context.Context, db *mongo.Database) (int64, error) {
+			return db.Collection(store.FramesCollectionName).CountDocuments(ctx, bson.M{"event_types.0": bson.M{"$exists": true}})
+		},
+		Up: func(ctx context.Context, db *mongo.Database, logger Logger) error {
+			_, err := backfillEvents(ctx, db, "", logger)
+			return err
+		},
+		Down: func(ctx context.Context, db *mongo.Database, logger Logger) error {
+			if err := db.Collection(store.EventsCollectionName).Drop(ctx); err != nil {
+				return fmt.Errorf("failed to drop %s: %w", store.EventsCollectionName, err)
+			}
+			return nil
+		},
+	},
+	{
+		Version: 4,
+		Name:    "event_frame_key_index",
+		Up:      migrateEventKeyIndex,
+		Down:    revertEventKeyIndex,
+	},
 }
 
-// MigrateSchema performs a one-time migration from the legacy schema to v3 schema
-// This adds _id, timestamp, created_at, and updated_at fields to existing documents
-func MigrateSchema(ctx context.Context, mongoClient *mongo.Client, logger Logger) (*MigrationStats, error) {
-	if logger == nil {
-		logger = &DefaultLogger{}
+// legacyEventIndexName is the unique index on the events collection that
+// keyed events by frame index alone, replaced by eventKeyIndex in migration 4
+const legacyEventIndexName = "lobby_session_id_1_frame_index_1_event_index_1"
+
+// eventKeyIndexName is the name of eventKeyIndex, the default one for its keys
+const eventKeyIndexName = "lobby_session_id_1_node_id_1_frame_key_1_event_index_1"
+
+// eventKeyIndex is the unique index of the events collection: one document
+// per event position within a frame, keyed like the frames. Events of frames
+// stored before frame_key existed are left out of the index.
+func eventKeyIndex() mongo.IndexModel {
+	return mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "lobby_session_id", Value: 1},
+			{Key: "node_id", Value: 1},
+			{Key: "frame_key", Value: 1},
+			{Key: "event_index", Value: 1},
+		},
+		Options: options.Index().
+			SetName(eventKeyIndexName).
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"frame_key": bson.M{"$exists": true}}),
 	}
+}
 
-	stats := &MigrationStats{
-		StartTime: time.Now(),
+// migrateEventKeyIndex replaces the unique index on frame index alone, which
+// rejected the events of frames from other nodes and of agents that restarted
+// their frame index, with eventKeyIndex
+func migrateEventKeyIndex(ctx context.Context, db *mongo.Database, logger Logger) error {
+	indexes := db.Collection(store.EventsCollectionName).Indexes()
+	if _, err := indexes.DropOne(ctx, legacyEventIndexName); err != nil && !isIndexNotFound(err) {
+		return fmt.Errorf("failed to drop %s index %s: %w", store.EventsCollectionName, legacyEventIndexName, err)
 	}
-
-	collection := mongoClient.Database(sessionEventDatabaseName).Collection(sessionEventCollectionName)
-
-	// Count total documents
-	totalCount, err := collection.CountDocuments(ctx, bson.M{})
+	name, err := indexes.CreateOne(ctx, eventKeyIndex())
 	if err != nil {
-		return nil, fmt.Errorf("failed to count documents: %w", err)
+		return fmt.Errorf("failed to create %s index: %w", store.EventsCollectionName, err)
 	}
-	stats.TotalDocuments = totalCount
-
-	logger.Info("Starting schema migration", "total_documents", totalCount)
+	logger.Info("Replaced event index", "dropped", legacyEventIndexName, "created", name)
+	return nil
+}
 
-	// Find documents without the new fields (created_at is used as marker)
-	filter := bson.M{
-		"$or": []bson.M{
-			{"created_at": bson.M{"$exists": false}},
-			{"_id": bson.M{"$type": "string"}}, // Documents with string _id need migration
+// revertEventKeyIndex restores the unique index on frame index alone. It fails
+// if events of several nodes or agent restarts share a frame index by then.
+func revertEventKeyIndex(ctx context.Context, db *mongo.Database, logger Logger) error {
+	indexes := db.Collection(store.EventsCollectionName).Indexes()
+	name, err := indexes.CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "lobby_session_id", Value: 1},
+			{Key: "frame_index", Value: 1},
+			{Key: "event_index", Value: 1},
 		},
+		Options: options.Index().SetUnique(true).SetName(legacyEventIndexName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create %s index %s: %w", store.EventsCollectionName, legacyEventIndexName, err)
+	}
+
+	if _, err := indexes.DropOne(ctx, eventKeyIndexName); err != nil && !isIndexNotFound(err) {
+		return fmt.Errorf("failed to drop %s index %s: %w", store.EventsCollectionName, eventKeyIndexName, err)
 	}
+	logger.Info("Restored event index", "created", name, "dropped", eventKeyIndexName)
+	return nil
+}
+
+// isIndexNotFound reports whether err is from dropping an index or
+// collection that does not exist
+func isIndexNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && (cmdErr.Code == 26 || cmdErr.Code == 27) // NamespaceNotFound, IndexNotFound
+}
 
-	cursor, err := collection.Find(ctx, filter)
+// legacyMetadataFilter matches documents without the v3 fields (created_at is used as marker)
+var legacyMetadataFilter = bson.M{
+	"$or": []bson.M{
+		{"created_at": bson.M{"$exists": false}},
+		{"_id": bson.M{"$type": "string"}}, // Documents with string _id need migration
+	},
+}
+
+// migrateDocumentMetadata adds _id, timestamp, created_at, and updated_at fields to
+// documents written before the v3 schema
+func migrateDocumentMetadata(ctx context.Context, db *mongo.Database, logger Logger) error {
+	collection := db.Collection(store.FramesCollectionName)
+
+	var migrated, failed int64
+
+	cursor, err := collection.Find(ctx, legacyMetadataFilter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query documents for migration: %w", err)
+		return fmt.Errorf("failed to query documents for migration: %w", err)
 	}
 	defer cursor.Close(ctx)
 
@@ -65,7 +159,7 @@ func MigrateSchema(ctx context.Context, mongoClient *mongo.Client, logger Logger
 		var doc bson.M
 		if err := cursor.Decode(&doc); err != nil {
 			logger.Error("Failed to decode document", "error", err)
-			stats.FailedDocuments++
+			failed++
 			continue
 		}
 
@@ -120,9 +214,9 @@ func MigrateSchema(ctx context.Context, mongoClient *mongo.Client, logger Logger
 			result, err := collection.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false))
 			if err != nil {
 				logger.Error("Batch write failed", "error", err)
-				stats.FailedDocuments += int64(len(batch))
+				failed += int64(len(batch))
 			} else {
-				stats.MigratedDocuments += result.ModifiedCount + result.InsertedCount
+				migrated += result.ModifiedCount + result.InsertedCount
 			}
 			batch = batch[:0]
 		}
@@ -133,23 +227,160 @@ func MigrateSchema(ctx context.Context, mongoClient *mongo.Client, logger Logger
 		result, err := collection.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false))
 		if err != nil {
 			logger.Error("Final batch write failed", "error", err)
-			stats.FailedDocuments += int64(len(batch))
+			failed += int64(len(batch))
 		} else {
-			stats.MigratedDocuments += result.ModifiedCount + result.InsertedCount
+			migrated += result.ModifiedCount + result.InsertedCount
+		}
+	}
+
+	logger.Info("Added document metadata", "migrated", migrated, "failed", failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%d documents could not be migrated", failed)
+	}
+	return nil
+}
+
+// migrateFramesToBinary rewrites documents older than the v4 schema so that their
+// frame is stored as zstd-compressed protobuf bytes. Documents are processed in
+// _id order and each batch is written before the next is read, so an interrupted
+// run can simply be restarted: converted documents no longer match the filter.
+func migrateFramesToBinary(ctx context.Context, db *mongo.Database, logger Logger) error {
+	collection := db.Collection(store.FramesCollectionName)
+	var converted, failed int64
+	batchSize := int64(100)
+	pending := bson.M{"$ne": store.FrameSchemaVersion}
+
+	remaining, err := collection.CountDocuments(ctx, bson.M{"schema_version": pending})
+	if err != nil {
+		return fmt.Errorf("failed to count documents: %w", err)
+	}
+	logger.Info("Converting frames to binary storage", "documents", remaining)
+
+	var lastID any
+	for {
+		batchFilter := bson.M{"schema_version": pending}
+		if lastID != nil {
+			batchFilter["_id"] = bson.M{"$gt": lastID}
+		}
+
+		opts := options.Find().
+			SetSort(bson.D{{Key: "_id", Value: 1}}).
+			SetLimit(batchSize)
+
+		cursor, err := collection.Find(ctx, batchFilter, opts)
+		if err != nil {
+			return fmt.Errorf("failed to query documents for conversion: %w", err)
+		}
+
+		var batch []mongo.WriteModel
+		count := 0
+		for cursor.Next(ctx) {
+			count++
+			lastID = cursor.Current.Lookup("_id")
+
+			var doc SessionFrameDocument
+			if err := cursor.Decode(&doc); err != nil || doc.Frame == nil {
+				logger.Error("Failed to decode frame for conversion", "id", lastID, "error", err)
+				failed++
+				continue
+			}
+
+			doc.UpdatedAt = time.Now().UTC()
+			batch = append(batch, mongo.NewReplaceOneModel().
+				SetFilter(bson.M{"_id": doc.ID}).
+				SetReplacement(&doc))
+		}
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to iterate documents for conversion: %w", err)
+		}
+
+		if len(batch) > 0 {
+			result, err := collection.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false))
+			if err != nil {
+				return fmt.Errorf("failed to write converted frames: %w", err)
+			}
+			converted += result.ModifiedCount
+			logger.Debug("Converted frame batch", "converted", converted)
+		}
+
+		if int64(count) < batchSize {
+			break
+		}
+	}
+
+	logger.Info("Converted frames to binary storage", "converted", converted, "failed", failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%d documents could not be converted", failed)
+	}
+	return nil
+}
+
+// migrateFramesToJSON reverts migrateFramesToBinary, storing frames as protojson
+// strings under the frame field again
+func migrateFramesToJSON(ctx context.Context, db *mongo.Database, logger Logger) error {
+	collection := db.Collection(store.FramesCollectionName)
+	var reverted int64
+
+	cursor, err := collection.Find(ctx, bson.M{"schema_version": store.FrameSchemaVersion})
+	if err != nil {
+		return fmt.Errorf("failed to query documents for conversion: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	batchSize := 100
+	var batch []mongo.WriteModel
+
+	flush := func() error {
+		result, err := collection.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return fmt.Errorf("failed to write converted frames: %w", err)
 		}
+		reverted += result.ModifiedCount
+		batch = batch[:0]
+		return nil
 	}
 
-	stats.EndTime = time.Now()
-	stats.SkippedDocuments = stats.TotalDocuments - stats.MigratedDocuments - stats.FailedDocuments
+	for cursor.Next(ctx) {
+		var doc SessionFrameDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode frame %v: %w", cursor.Current.Lookup("_id"), err)
+		}
+
+		frameJSON, err := FrameToJSON(doc.Frame)
+		if err != nil {
+			return fmt.Errorf("failed to convert frame %s to JSON: %w", doc.ID.Hex(), err)
+		}
 
-	logger.Info("Schema migration completed",
-		"migrated", stats.MigratedDocuments,
-		"skipped", stats.SkippedDocuments,
-		"failed", stats.FailedDocuments,
-		"duration", stats.EndTime.Sub(stats.StartTime),
-	)
+		batch = append(batch, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": doc.ID}).
+			SetUpdate(bson.M{
+				"$set":   bson.M{"frame": string(frameJSON)},
+				"$unset": bson.M{"frame_data": "", "schema_version": ""},
+			}))
 
-	return stats, nil
+		if len(batch) >= batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return fmt.Errorf("failed to iterate documents for conversion: %w", err)
+	}
+
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("Converted frames to protojson storage", "converted", reverted)
+	return nil
 }
 
 // extractTimestampFromFrame attempts to extract a timestamp from the frame data JSON
@@ -189,33 +420,3 @@ func extractTimestampFromFrame(doc bson.M) time.Time {
 
 	return time.Time{}
 }
-
-// ValidateMigration checks that all documents have been properly migrated
-func ValidateMigration(ctx context.Context, mongoClient *mongo.Client, logger Logger) error {
-	if logger == nil {
-		logger = &DefaultLogger{}
-	}
-
-	collection := mongoClient.Database(sessionEventDatabaseName).Collection(sessionEventCollectionName)
-
-	// Check for documents missing new fields
-	filter := bson.M{
-		"$or": []bson.M{
-			{"created_at": bson.M{"$exists": false}},
-			{"timestamp": bson.M{"$exists": false}},
-			{"updated_at": bson.M{"$exists": false}},
-		},
-	}
-
-	count, err := collection.CountDocuments(ctx, filter)
-	if err != nil {
-		return fmt.Errorf("failed to validate migration: %w", err)
-	}
-
-	if count > 0 {
-		return fmt.Errorf("migration incomplete: %d documents still missing required fields", count)
-	}
-
-	logger.Info("Migration validation passed")
-	return nil
-}
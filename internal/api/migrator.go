@@ -0,0 +1,458 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/gofrs/uuid/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// schemaMigrationsCollectionName records one document per applied migration
+	schemaMigrationsCollectionName = "schema_migrations"
+
+	// schemaLockCollectionName holds the lock taken while migrations run
+	schemaLockCollectionName = "schema_migrations_lock"
+
+	schemaLockID  = "migrate"
+	schemaLockTTL = 15 * time.Minute
+
+	// schemaLockRenewInterval is how often the lock is renewed while
+	// migrations run, well within schemaLockTTL
+	schemaLockRenewInterval = schemaLockTTL / 3
+)
+
+var (
+	// ErrSchemaBehind is returned when the database has pending migrations
+	ErrSchemaBehind = errors.New("database schema is behind, run `agent migrate`")
+
+	// ErrMigrationLocked is returned when another process holds the migration lock
+	ErrMigrationLocked = errors.New("migrations are locked by another process")
+
+	// ErrMigrationLockLost is returned when the migration lock could not be
+	// renewed while a migration ran
+	ErrMigrationLockLost = errors.New("migration lock lost")
+)
+
+// MigrationFunc applies or reverts a single migration
+type MigrationFunc func(ctx context.Context, db *mongo.Database, logger Logger) error
+
+// Migration is a numbered, optionally reversible schema change
+type Migration struct {
+	Version int
+	Name    string
+
+	// Pending counts the documents the migration would touch (used for dry runs)
+	Pending func(ctx context.Context, db *mongo.Database) (int64, error)
+
+	Up   MigrationFunc
+	Down MigrationFunc // nil if the migration cannot be reverted
+}
+
+// MigrationStatus describes a migration and whether it has been applied
+type MigrationStatus struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	Pending   int64      `json:"pending,omitempty"` // Only filled in for dry runs
+}
+
+// appliedMigration is the document stored in schema_migrations
+type appliedMigration struct {
+	Version   int           `bson:"_id"`
+	Name      string        `bson:"name"`
+	AppliedAt time.Time     `bson:"applied_at"`
+	Duration  time.Duration `bson:"duration"`
+}
+
+// Migrator applies and reverts schema migrations on a database
+type Migrator struct {
+	db         *mongo.Database
+	migrations []Migration
+	logger     Logger
+	owner      string
+}
+
+// NewMigrator creates a migrator for the session data migrations
+func NewMigrator(db *mongo.Database, logger Logger) *Migrator {
+	if logger == nil {
+		logger = &DefaultLogger{}
+	}
+
+	hostname, _ := os.Hostname()
+
+	sorted := make([]Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	return &Migrator{
+		db:         db,
+		migrations: sorted,
+		logger:     logger,
+		owner:      fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), uuid.Must(uuid.NewV4()).String()),
+	}
+}
+
+// LatestVersion returns the highest known migration version
+func (m *Migrator) LatestVersion() int {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// CurrentVersion returns the highest applied migration version
+func (m *Migrator) CurrentVersion(ctx context.Context) (int, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	current := 0
+	for version := range applied {
+		if version > current {
+			current = version
+		}
+	}
+	return current, nil
+}
+
+// Status returns the state of every known migration
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+		}
+		if record, ok := applied[migration.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = &record.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// CheckSchema returns ErrSchemaBehind if any migration has not been applied.
+// A database without any session frames is brought up to date by recording
+// all migrations as applied, since there is nothing to convert.
+func (m *Migrator) CheckSchema(ctx context.Context) error {
+	current, err := m.CurrentVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if current == 0 {
+		empty, err := m.empty(ctx)
+		if err != nil {
+			return err
+		}
+		if empty {
+			if current, err = m.baseline(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if current < m.LatestVersion() {
+		return fmt.Errorf("%w (current version %d, latest %d)", ErrSchemaBehind, current, m.LatestVersion())
+	}
+	return nil
+}
+
+// Up applies pending migrations up to and including target (0 means all).
+// With dryRun set, nothing is changed and the pending migrations are returned
+// with the number of documents they would touch.
+func (m *Migrator) Up(ctx context.Context, target int, dryRun bool) ([]MigrationStatus, error) {
+	if target <= 0 {
+		target = m.LatestVersion()
+	}
+
+	plan := func(applied map[int]appliedMigration) ([]Migration, error) {
+		var plan []Migration
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; !ok && migration.Version <= target {
+				plan = append(plan, migration)
+			}
+		}
+		return plan, nil
+	}
+
+	return m.run(ctx, plan, dryRun, func(ctx context.Context, migration Migration) error {
+		start := time.Now()
+		if err := migration.Up(ctx, m.db, m.logger); err != nil {
+			return err
+		}
+		return m.record(ctx, migration, time.Since(start))
+	})
+}
+
+// Down reverts applied migrations above target, newest first
+func (m *Migrator) Down(ctx context.Context, target int, dryRun bool) ([]MigrationStatus, error) {
+	if target < 0 {
+		return nil, fmt.Errorf("invalid target version %d", target)
+	}
+
+	plan := func(applied map[int]appliedMigration) ([]Migration, error) {
+		var plan []Migration
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok || migration.Version <= target {
+				continue
+			}
+			if migration.Down == nil {
+				return nil, fmt.Errorf("migration %d (%s) cannot be reverted", migration.Version, migration.Name)
+			}
+			plan = append(plan, migration)
+		}
+		return plan, nil
+	}
+
+	return m.run(ctx, plan, dryRun, func(ctx context.Context, migration Migration) error {
+		if err := migration.Down(ctx, m.db, m.logger); err != nil {
+			return err
+		}
+		_, err := m.db.Collection(schemaMigrationsCollectionName).DeleteOne(ctx, bson.M{"_id": migration.Version})
+		if err != nil {
+			return fmt.Errorf("failed to remove migration record: %w", err)
+		}
+		return nil
+	})
+}
+
+// run plans the migrations to run from the applied ones and executes them
+// while holding the migration lock. The plan is made again once the lock is
+// held, so that it cannot be outdated by another process migrating meanwhile.
+func (m *Migrator) run(ctx context.Context, planner func(map[int]appliedMigration) ([]Migration, error), dryRun bool, step func(context.Context, Migration) error) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planner(applied)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		statuses := make([]MigrationStatus, 0, len(plan))
+		for _, migration := range plan {
+			status := MigrationStatus{Version: migration.Version, Name: migration.Name}
+			if migration.Pending != nil {
+				pending, err := migration.Pending(ctx, m.db)
+				if err != nil {
+					return nil, fmt.Errorf("failed to count pending documents for migration %d: %w", migration.Version, err)
+				}
+				status.Pending = pending
+			}
+			statuses = append(statuses, status)
+		}
+		return statuses, nil
+	}
+
+	if len(plan) == 0 {
+		return []MigrationStatus{}, nil
+	}
+
+	if err := m.lock(ctx); err != nil {
+		return nil, err
+	}
+	defer m.unlock()
+
+	// Migrations can outlast the lock, so it is renewed while they run
+	ctx, release := m.hold(ctx)
+	defer release()
+
+	// Plan again now that no other process can be migrating
+	if applied, err = m.applied(ctx); err != nil {
+		return nil, err
+	}
+	if plan, err = planner(applied); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(plan))
+	for _, migration := range plan {
+		m.logger.Info("Running migration", "version", migration.Version, "name", migration.Name)
+		err := step(ctx, migration)
+		if cause := context.Cause(ctx); errors.Is(cause, ErrMigrationLockLost) {
+			err = cause
+		}
+		if err != nil {
+			return statuses, fmt.Errorf("migration %d (%s) failed: %w", migration.Version, migration.Name, err)
+		}
+
+		now := time.Now().UTC()
+		statuses = append(statuses, MigrationStatus{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			Applied:   true,
+			AppliedAt: &now,
+		})
+	}
+
+	return statuses, nil
+}
+
+// hold renews the migration lock every schemaLockRenewInterval until release
+// is called. If the lock cannot be renewed, the returned context is cancelled
+// with ErrMigrationLockLost as its cause, aborting the running migration.
+func (m *Migrator) hold(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(schemaLockRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := m.lock(ctx); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					m.logger.Error("Failed to renew migration lock, aborting", "error", err)
+					cancel(fmt.Errorf("%w: %w", ErrMigrationLockLost, err))
+					return
+				}
+			}
+		}
+	}()
+
+	return ctx, func() {
+		close(done)
+		<-stopped
+		cancel(nil)
+	}
+}
+
+// applied returns the applied migrations keyed by version
+func (m *Migrator) applied(ctx context.Context) (map[int]appliedMigration, error) {
+	cursor, err := m.db.Collection(schemaMigrationsCollectionName).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query schema migrations: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var records []appliedMigration
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, fmt.Errorf("failed to decode schema migrations: %w", err)
+	}
+
+	applied := make(map[int]appliedMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+	return applied, nil
+}
+
+// record marks a migration as applied
+func (m *Migrator) record(ctx context.Context, migration Migration, duration time.Duration) error {
+	record := appliedMigration{
+		Version:   migration.Version,
+		Name:      migration.Name,
+		AppliedAt: time.Now().UTC(),
+		Duration:  duration,
+	}
+
+	_, err := m.db.Collection(schemaMigrationsCollectionName).ReplaceOne(ctx,
+		bson.M{"_id": migration.Version}, record, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("failed to record migration: %w", err)
+	}
+	return nil
+}
+
+// empty reports whether the database holds no session frames
+func (m *Migrator) empty(ctx context.Context) (bool, error) {
+	count, err := m.db.Collection(store.FramesCollectionName).EstimatedDocumentCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to count session frames: %w", err)
+	}
+	return count == 0, nil
+}
+
+// baseline records every migration as applied without running it, holding
+// the migration lock, and returns the resulting version. A database that was
+// migrated or received frames before the lock was acquired is left as it is.
+func (m *Migrator) baseline(ctx context.Context) (int, error) {
+	if err := m.lock(ctx); err != nil {
+		return 0, err
+	}
+	defer m.unlock()
+
+	current, err := m.CurrentVersion(ctx)
+	if err != nil || current != 0 {
+		return current, err
+	}
+	empty, err := m.empty(ctx)
+	if err != nil || !empty {
+		return 0, err
+	}
+
+	for _, migration := range m.migrations {
+		if err := m.record(ctx, migration, 0); err != nil {
+			return 0, err
+		}
+	}
+	m.logger.Info("Initialized schema migrations for empty database", "version", m.LatestVersion())
+	return m.LatestVersion(), nil
+}
+
+// lock acquires or extends the migration lock. A lock that has expired, for
+// example because its owner crashed, is taken over.
+func (m *Migrator) lock(ctx context.Context) error {
+	now := time.Now().UTC()
+	filter := bson.M{
+		"_id": schemaLockID,
+		"$or": []bson.M{
+			{"owner": m.owner},
+			{"expires_at": bson.M{"$lt": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"owner":      m.owner,
+		"expires_at": now.Add(schemaLockTTL),
+	}}
+
+	_, err := m.db.Collection(schemaLockCollectionName).UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		// The lock document exists and belongs to someone else
+		return ErrMigrationLocked
+	}
+	if err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	return nil
+}
+
+// unlock releases the migration lock if it is still held by this migrator
+func (m *Migrator) unlock() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := m.db.Collection(schemaLockCollectionName).DeleteOne(ctx, bson.M{"_id": schemaLockID, "owner": m.owner}); err != nil {
+		m.logger.Warn("Failed to release migration lock", "error", err)
+	}
+}
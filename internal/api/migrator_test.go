@@ -0,0 +1,24 @@
+package api
+
+import "testing"
+
+func TestMigrations_Ordered(t *testing.T) {
+	seen := make(map[int]bool)
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("migration %q has version %d, want %d", migration.Name, migration.Version, i+1)
+		}
+		if seen[migration.Version] {
+			t.Errorf("duplicate migration version %d", migration.Version)
+		}
+		seen[migration.Version] = true
+
+		if migration.Name == "" || migration.Up == nil {
+			t.Errorf("migration %d must have a name and an up step", migration.Version)
+		}
+	}
+
+	if got := NewMigrator(nil, nil).LatestVersion(); got != len(migrations) {
+		t.Errorf("LatestVersion() = %d, want %d", got, len(migrations))
+	}
+}
@@ -32,40 +32,6 @@ type PlayerLookupService struct {
 	rateLimiter *rateLimiter
 }
 
-// rateLimiter implements a simple token bucket rate limiter
-type rateLimiter struct {
-	tokens     float64
-	maxTokens  float64
-	refillRate float64 // tokens per second
-	lastRefill time.Time
-	mu         sync.Mutex
-}
-
-func newRateLimiter(maxTokens float64, refillRate float64) *rateLimiter {
-	return &rateLimiter{
-		tokens:     maxTokens,
-		maxTokens:  maxTokens,
-		refillRate: refillRate,
-		lastRefill: time.Now(),
-	}
-}
-
-func (r *rateLimiter) Allow() bool {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-
-	now := time.Now()
-	elapsed := now.Sub(r.lastRefill).Seconds()
-	r.tokens = min(r.maxTokens, r.tokens+elapsed*r.refillRate)
-	r.lastRefill = now
-
-	if r.tokens >= 1 {
-		r.tokens--
-		return true
-	}
-	return false
-}
-
 // PlayerLookupConfig holds configuration for the player lookup service
 type PlayerLookupConfig struct {
 	BaseURL        string        // Base URL for the player lookup API
@@ -0,0 +1,239 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Names of the ingest limits reported to clients
+const (
+	limitNodeRate    = "node rate limit"
+	limitTokenRate   = "token rate limit"
+	limitDailyFrames = "daily frame quota"
+	limitDailyBytes  = "daily byte quota"
+)
+
+// rateLimiter implements a simple token bucket rate limiter
+type rateLimiter struct {
+	tokens     float64
+	maxTokens  float64
+	refillRate float64 // tokens per second
+	lastRefill time.Time
+	mu         sync.Mutex
+}
+
+func newRateLimiter(maxTokens float64, refillRate float64) *rateLimiter {
+	return &rateLimiter{
+		tokens:     maxTokens,
+		maxTokens:  maxTokens,
+		refillRate: refillRate,
+		lastRefill: time.Now(),
+	}
+}
+
+func (r *rateLimiter) Allow() bool {
+	ok, _ := r.reserve(time.Now())
+	return ok
+}
+
+// reserve takes a token if one is available, otherwise it returns how long
+// until the next one is
+func (r *rateLimiter) reserve(now time.Time) (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ok, wait := r.check(now)
+	if ok {
+		r.tokens--
+	}
+	return ok, wait
+}
+
+// available reports whether a token is available without taking it,
+// otherwise how long until the next one is
+func (r *rateLimiter) available(now time.Time) (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.check(now)
+}
+
+// take takes a token that available reported
+func (r *rateLimiter) take() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens--
+}
+
+// check refills the bucket and reports whether it holds a token. r.mu must be held.
+func (r *rateLimiter) check(now time.Time) (bool, time.Duration) {
+	elapsed := now.Sub(r.lastRefill).Seconds()
+	r.tokens = min(r.maxTokens, r.tokens+elapsed*r.refillRate)
+	r.lastRefill = now
+
+	if r.tokens >= 1 {
+		return true, 0
+	}
+	return false, time.Duration((1 - r.tokens) / r.refillRate * float64(time.Second))
+}
+
+// IngestLimits configures the limits on ingested frames. Zero disables a limit.
+type IngestLimits struct {
+	NodeHz      float64 // Frames per second per node
+	TokenHz     float64 // Frames per second per token (by its jti claim, or a hash of the token without one)
+	Burst       float64 // Frames accepted at once above the rate (default: one second's worth)
+	DailyFrames int64   // Frames per node per UTC day
+	DailyBytes  int64   // Payload bytes per node per UTC day
+}
+
+// RateLimitError is returned for frames rejected by an ingest limit
+type RateLimitError struct {
+	Limit      string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s exceeded, retry after %s", e.Limit, e.RetryAfter.Round(time.Second))
+}
+
+// RetryAfterSeconds returns the wait as whole seconds, for Retry-After headers
+func (e *RateLimitError) RetryAfterSeconds() int {
+	return int(math.Ceil(e.RetryAfter.Seconds()))
+}
+
+// dailyUsage counts a node's ingest during one UTC day
+type dailyUsage struct {
+	frames int64
+	bytes  int64
+}
+
+// IngestLimiter enforces IngestLimits for every ingest path of the server.
+// Rates and quotas are counted in memory by each server process: replicas
+// behind a load balancer each allow a node the full limits, and a restart
+// starts the day's quotas again.
+type IngestLimiter struct {
+	limits  IngestLimits
+	metrics *Metrics
+
+	mu     sync.Mutex
+	nodes  map[string]*rateLimiter
+	tokens map[string]*rateLimiter
+	usage  map[string]*dailyUsage
+	day    time.Time
+}
+
+// NewIngestLimiter creates a limiter. metrics may be nil.
+func NewIngestLimiter(limits IngestLimits, metrics *Metrics) *IngestLimiter {
+	return &IngestLimiter{
+		limits:  limits,
+		metrics: metrics,
+		nodes:   make(map[string]*rateLimiter),
+		tokens:  make(map[string]*rateLimiter),
+		usage:   make(map[string]*dailyUsage),
+	}
+}
+
+// bucket returns the token bucket for key, creating it at the given rate
+func (l *IngestLimiter) bucket(buckets map[string]*rateLimiter, key string, hz float64) *rateLimiter {
+	limiter, ok := buckets[key]
+	if !ok {
+		burst := l.limits.Burst
+		if burst < 1 {
+			burst = max(hz, 1)
+		}
+		limiter = newRateLimiter(burst, hz)
+		buckets[key] = limiter
+	}
+	return limiter
+}
+
+// Allow checks one frame of size bytes from node, sent with the token in
+// ctx, against the limits and returns a *RateLimitError if it exceeds one.
+// An allowed frame takes from the rate limits; it counts toward the daily
+// quotas once it is stored, by Charge.
+func (l *IngestLimiter) Allow(ctx context.Context, node string, size int) error {
+	err := l.allow(ctx, node, int64(size), time.Now())
+	if err != nil && l.metrics != nil {
+		l.metrics.RecordRateLimitExceeded()
+	}
+	return err
+}
+
+// Charge counts a stored frame of size bytes toward the daily quotas of node
+func (l *IngestLimiter) Charge(node string, size int) {
+	l.charge(node, int64(size), time.Now())
+}
+
+// rollover starts a new day of quotas if now is past the current one, and
+// returns the time until the next. l.mu must be held.
+func (l *IngestLimiter) rollover(now time.Time) time.Duration {
+	// Quotas reset at midnight UTC; idle buckets are dropped with them
+	day := now.UTC().Truncate(24 * time.Hour)
+	if !day.Equal(l.day) {
+		l.day = day
+		clear(l.usage)
+		clear(l.nodes)
+		clear(l.tokens)
+	}
+	return day.Add(24 * time.Hour).Sub(now)
+}
+
+func (l *IngestLimiter) allow(ctx context.Context, node string, size int64, now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	nextDay := l.rollover(now)
+
+	var usage dailyUsage
+	if u, ok := l.usage[node]; ok {
+		usage = *u
+	}
+	if l.limits.DailyFrames > 0 && usage.frames >= l.limits.DailyFrames {
+		return &RateLimitError{Limit: limitDailyFrames, RetryAfter: nextDay}
+	}
+	if l.limits.DailyBytes > 0 && usage.bytes+size > l.limits.DailyBytes {
+		return &RateLimitError{Limit: limitDailyBytes, RetryAfter: nextDay}
+	}
+
+	// Every limit is checked before any token is taken, so a frame rejected
+	// by one limit does not use up another
+	var buckets []*rateLimiter
+	if l.limits.NodeHz > 0 {
+		bucket := l.bucket(l.nodes, node, l.limits.NodeHz)
+		if ok, wait := bucket.available(now); !ok {
+			return &RateLimitError{Limit: limitNodeRate, RetryAfter: wait}
+		}
+		buckets = append(buckets, bucket)
+	}
+	if claims, ok := ClaimsFromContext(ctx); ok && claims.TokenKey() != "" && l.limits.TokenHz > 0 {
+		bucket := l.bucket(l.tokens, claims.TokenKey(), l.limits.TokenHz)
+		if ok, wait := bucket.available(now); !ok {
+			return &RateLimitError{Limit: limitTokenRate, RetryAfter: wait}
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	for _, bucket := range buckets {
+		bucket.take()
+	}
+	return nil
+}
+
+func (l *IngestLimiter) charge(node string, size int64, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollover(now)
+
+	usage, ok := l.usage[node]
+	if !ok {
+		usage = &dailyUsage{}
+		l.usage[node] = usage
+	}
+	usage.frames++
+	usage.bytes += size
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestIngestLimiter(t *testing.T) {
+	now := time.Date(2026, 10, 18, 23, 59, 0, 0, time.UTC)
+	token := context.WithValue(context.Background(), claimsKey{}, &Claims{RegisteredClaims: jwt.RegisteredClaims{ID: "token1"}})
+
+	limitOf := func(err error) string {
+		var limitErr *RateLimitError
+		if errors.As(err, &limitErr) {
+			return limitErr.Limit
+		}
+		return ""
+	}
+
+	t.Run("node and token rates", func(t *testing.T) {
+		l := NewIngestLimiter(IngestLimits{NodeHz: 2, TokenHz: 1}, nil)
+		if err := l.allow(token, "node1", 10, now); err != nil {
+			t.Fatalf("first frame: %v", err)
+		}
+		if got := limitOf(l.allow(token, "node1", 10, now)); got != limitTokenRate {
+			t.Errorf("second frame with token = %q, want %q", got, limitTokenRate)
+		}
+		// The frame rejected by the token rate did not take from the node rate
+		if err := l.allow(context.Background(), "node1", 10, now); err != nil {
+			t.Errorf("third frame from node: %v", err)
+		}
+		if got := limitOf(l.allow(context.Background(), "node1", 10, now)); got != limitNodeRate {
+			t.Errorf("fourth frame from node = %q, want %q", got, limitNodeRate)
+		}
+		if err := l.allow(context.Background(), "node2", 10, now); err != nil {
+			t.Errorf("other node: %v", err)
+		}
+		if err := l.allow(token, "node1", 10, now.Add(time.Second)); err != nil {
+			t.Errorf("after refill: %v", err)
+		}
+	})
+
+	t.Run("tokens without jti", func(t *testing.T) {
+		l := NewIngestLimiter(IngestLimits{TokenHz: 1}, nil)
+		withToken := func(hash string) context.Context {
+			return context.WithValue(context.Background(), claimsKey{}, &Claims{tokenHash: hash})
+		}
+		if err := l.allow(withToken("a"), "node1", 10, now); err != nil {
+			t.Fatalf("first frame: %v", err)
+		}
+		if got := limitOf(l.allow(withToken("a"), "node2", 10, now)); got != limitTokenRate {
+			t.Errorf("second frame with token = %q, want %q", got, limitTokenRate)
+		}
+		if err := l.allow(withToken("b"), "node1", 10, now); err != nil {
+			t.Errorf("other token: %v", err)
+		}
+	})
+
+	t.Run("daily quotas", func(t *testing.T) {
+		l := NewIngestLimiter(IngestLimits{DailyFrames: 2, DailyBytes: 25}, nil)
+		if err := l.allow(token, "node1", 10, now); err != nil {
+			t.Fatalf("first frame: %v", err)
+		}
+		l.charge("node1", 10, now)
+		err := l.allow(token, "node1", 20, now)
+		if got := limitOf(err); got != limitDailyBytes {
+			t.Fatalf("oversized frame = %q, want %q", got, limitDailyBytes)
+		}
+		var limitErr *RateLimitError
+		if errors.As(err, &limitErr); limitErr.RetryAfter != time.Minute {
+			t.Errorf("RetryAfter = %v, want time to midnight", limitErr.RetryAfter)
+		}
+		if err := l.allow(token, "node1", 10, now); err != nil {
+			t.Fatalf("second frame: %v", err)
+		}
+		// Frames that were allowed but not stored are not charged
+		if err := l.allow(token, "node1", 1, now); err != nil {
+			t.Fatalf("uncharged frames: %v", err)
+		}
+		l.charge("node1", 10, now)
+		if got := limitOf(l.allow(token, "node1", 1, now)); got != limitDailyFrames {
+			t.Errorf("third frame = %q, want %q", got, limitDailyFrames)
+		}
+		if err := l.allow(token, "node1", 10, now.Add(time.Minute)); err != nil {
+			t.Errorf("next day: %v", err)
+		}
+	})
+}
@@ -0,0 +1,339 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// retentionInterval is how often expired sessions are archived and deleted
+	retentionInterval = 15 * time.Minute
+
+	// archiveBatchSize is the number of frames read per page while archiving
+	archiveBatchSize = 500
+)
+
+// RetentionRule sets how long sessions matching it are kept in the session
+// store. Empty match fields match any session; the first matching rule wins.
+type RetentionRule struct {
+	NodeID       string `json:"node_id,omitempty" yaml:"node_id,omitempty"`
+	MatchType    string `json:"match_type,omitempty" yaml:"match_type,omitempty"`
+	PrivateMatch *bool  `json:"private_match,omitempty" yaml:"private_match,omitempty"`
+	Retention    string `json:"retention" yaml:"retention"` // Duration string, "0" keeps sessions forever
+}
+
+// matches reports whether the rule applies to a session
+func (r *RetentionRule) matches(session *store.SessionSummary) bool {
+	if r.NodeID != "" && r.NodeID != session.NodeID {
+		return false
+	}
+	if r.MatchType != "" && r.MatchType != session.MatchType {
+		return false
+	}
+	if r.PrivateMatch != nil && *r.PrivateMatch != session.PrivateMatch {
+		return false
+	}
+	return true
+}
+
+// RetentionPolicy decides how long each session is kept
+type RetentionPolicy struct {
+	rules      []RetentionRule
+	maxAges    []time.Duration
+	defaultAge time.Duration
+}
+
+// NewRetentionPolicy creates a policy from a default retention and a list of
+// rules. A retention of zero or an empty string keeps sessions forever.
+func NewRetentionPolicy(defaultRetention string, rules []RetentionRule) (*RetentionPolicy, error) {
+	defaultAge, err := parseRetention(defaultRetention)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session retention: %w", err)
+	}
+
+	p := &RetentionPolicy{
+		rules:      rules,
+		maxAges:    make([]time.Duration, len(rules)),
+		defaultAge: defaultAge,
+	}
+	for i, rule := range rules {
+		if p.maxAges[i], err = parseRetention(rule.Retention); err != nil {
+			return nil, fmt.Errorf("invalid retention for rule %d: %w", i, err)
+		}
+	}
+	return p, nil
+}
+
+func parseRetention(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("retention must not be negative")
+	}
+	return d, nil
+}
+
+// RetentionFor returns how long a session is kept, zero meaning forever
+func (p *RetentionPolicy) RetentionFor(session *store.SessionSummary) time.Duration {
+	for i := range p.rules {
+		if p.rules[i].matches(session) {
+			return p.maxAges[i]
+		}
+	}
+	return p.defaultAge
+}
+
+// Enabled reports whether the policy expires any sessions at all
+func (p *RetentionPolicy) Enabled() bool {
+	return p.shortest() > 0
+}
+
+// shortest returns the shortest non-zero retention of the policy
+func (p *RetentionPolicy) shortest() time.Duration {
+	shortest := p.defaultAge
+	for _, d := range p.maxAges {
+		if d > 0 && (shortest == 0 || d < shortest) {
+			shortest = d
+		}
+	}
+	return shortest
+}
+
+// ExpiringSession describes a session that is due to expire
+type ExpiringSession struct {
+	LobbySessionID string    `json:"lobby_session_id"`
+	NodeID         string    `json:"node_id,omitempty"`
+	MatchType      string    `json:"match_type,omitempty"`
+	PrivateMatch   bool      `json:"private_match"`
+	FrameCount     int64     `json:"frame_count"`
+	LastUpdated    time.Time `json:"last_updated"`
+	Retention      string    `json:"retention"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// RetentionManager archives expired sessions to the capture store and then
+// deletes them from the session store, so hot queries only cover recent
+// sessions while the full frames are kept on disk.
+type RetentionManager struct {
+	repository store.Repository
+	storage    *StorageManager
+	policy     *RetentionPolicy
+	logger     Logger
+	ticker     *time.Ticker
+	stopCh     chan struct{}
+}
+
+// NewRetentionManager creates a retention manager archiving into storage
+func NewRetentionManager(repository store.Repository, storage *StorageManager, policy *RetentionPolicy, logger Logger) *RetentionManager {
+	return &RetentionManager{
+		repository: repository,
+		storage:    storage,
+		policy:     policy,
+		logger:     logger,
+		stopCh:     make(chan struct{}),
+	}
+}
+
+// Start begins enforcing the policy periodically
+func (m *RetentionManager) Start(ctx context.Context) {
+	m.ticker = time.NewTicker(retentionInterval)
+
+	go func() {
+		m.enforce(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.stopCh:
+				return
+			case <-m.ticker.C:
+				m.enforce(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops enforcing the policy
+func (m *RetentionManager) Stop() {
+	close(m.stopCh)
+	if m.ticker != nil {
+		m.ticker.Stop()
+	}
+}
+
+// Expiring returns the sessions that expire within the given duration from
+// now, ordered by expiry. Sessions already past their retention are included.
+func (m *RetentionManager) Expiring(ctx context.Context, within time.Duration) ([]ExpiringSession, error) {
+	if !m.policy.Enabled() {
+		return []ExpiringSession{}, nil
+	}
+
+	now := time.Now().UTC()
+	deadline := now.Add(within)
+
+	// No session updated after this can expire before the deadline
+	candidates, err := m.repository.Sessions(ctx, deadline.Add(-m.policy.shortest()))
+	if err != nil {
+		return nil, err
+	}
+
+	expiring := []ExpiringSession{}
+	for _, session := range candidates {
+		retention := m.policy.RetentionFor(session)
+		if retention == 0 {
+			continue
+		}
+
+		expiresAt := session.UpdatedAt.Add(retention)
+		if expiresAt.After(deadline) {
+			continue
+		}
+
+		expiring = append(expiring, ExpiringSession{
+			LobbySessionID: session.LobbySessionID,
+			NodeID:         session.NodeID,
+			MatchType:      session.MatchType,
+			PrivateMatch:   session.PrivateMatch,
+			FrameCount:     session.FrameCount,
+			LastUpdated:    session.UpdatedAt,
+			Retention:      retention.String(),
+			ExpiresAt:      expiresAt,
+		})
+	}
+
+	// Candidates are ordered by last update; differing retentions can reorder them
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ExpiresAt.Before(expiring[j].ExpiresAt)
+	})
+	return expiring, nil
+}
+
+// enforce archives and deletes every session past its retention
+func (m *RetentionManager) enforce(ctx context.Context) {
+	expired, err := m.Expiring(ctx, 0)
+	if err != nil {
+		m.logger.Error("failed to list expired sessions", "error", err)
+		return
+	}
+
+	var deleted int
+	for _, session := range expired {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := m.expire(ctx, session); err != nil {
+			m.logger.Error("failed to expire session", "lobby_session_id", session.LobbySessionID, "error", err)
+			continue
+		}
+		deleted++
+	}
+
+	if deleted > 0 {
+		m.logger.Info("session retention completed", "expired", deleted)
+	}
+}
+
+// expire archives a session to the capture store and deletes it from the
+// session store. The session is kept if it changed while being archived.
+func (m *RetentionManager) expire(ctx context.Context, session ExpiringSession) error {
+	var (
+		page   []*store.SessionFrameDocument
+		offset int64
+	)
+	next := func() (*telemetry.LobbySessionStateFrame, error) {
+		for len(page) == 0 {
+			frames, _, err := m.repository.FramesPage(ctx, session.LobbySessionID, "", archiveBatchSize, offset)
+			if err != nil {
+				return nil, err
+			}
+			if len(frames) == 0 {
+				return nil, nil
+			}
+			page = frames
+			offset += int64(len(frames))
+		}
+
+		doc := page[0]
+		page = page[1:]
+		if doc.Frame == nil {
+			return nil, fmt.Errorf("frame %s has no frame data", doc.ID.Hex())
+		}
+		return doc.Frame, nil
+	}
+
+	// The earlier captures are only replaced if the archive has every frame
+	unchanged := func() error {
+		current, err := m.repository.Session(ctx, session.LobbySessionID)
+		if err != nil {
+			return err
+		}
+		if current == nil || current.FrameCount != offset || !current.UpdatedAt.Equal(session.LastUpdated) {
+			return fmt.Errorf("session changed while being archived")
+		}
+		return nil
+	}
+
+	path, err := m.storage.ArchiveMatch(session.LobbySessionID, next, unchanged)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := m.repository.DeleteSession(ctx, session.LobbySessionID)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("expired session",
+		"lobby_session_id", session.LobbySessionID,
+		"frames", deleted,
+		"archive", path)
+	return nil
+}
+
+// RegisterRoutes registers the retention routes
+func (m *RetentionManager) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/api/v3/retention/expiring", m.handleExpiring).Methods("GET")
+}
+
+// handleExpiring reports the sessions due to expire within the duration given
+// by the "within" query parameter (default 24h)
+func (m *RetentionManager) handleExpiring(w http.ResponseWriter, r *http.Request) {
+	within := 24 * time.Hour
+	if s := r.URL.Query().Get("within"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d < 0 {
+			http.Error(w, "invalid within duration", http.StatusBadRequest)
+			return
+		}
+		within = d
+	}
+
+	sessions, err := m.Expiring(r.Context(), within)
+	if err != nil {
+		m.logger.Error("failed to list expiring sessions", "error", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]interface{}{
+		"within":   within.String(),
+		"sessions": sessions,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-capture/v3/pkg/codecs"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+func TestRetentionManager(t *testing.T) {
+	ctx := context.Background()
+
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	storage, err := NewStorageManager(t.TempDir(), time.Hour, 1<<30, &DefaultLogger{})
+	if err != nil {
+		t.Fatalf("NewStorageManager() error = %v", err)
+	}
+
+	now := time.Now().UTC()
+	sessions := []struct {
+		id      string
+		private bool
+		updated time.Time
+	}{
+		{"old-public", false, now.Add(-48 * time.Hour)},
+		{"recent-private", true, now.Add(-30 * time.Minute)},
+		{"recent-public", false, now},
+	}
+	for _, s := range sessions {
+		for i := 0; i < 3; i++ {
+			frame := &telemetry.LobbySessionStateFrame{FrameIndex: uint32(i)}
+			frame.Session = &apigame.SessionResponse{PrivateMatch: s.private}
+			doc, err := store.NewSessionFrameDocument(s.id, "node1", "", frame)
+			if err != nil {
+				t.Fatalf("NewSessionFrameDocument() error = %v", err)
+			}
+			doc.UpdatedAt = s.updated
+			if err := repo.StoreFrame(ctx, doc); err != nil {
+				t.Fatalf("StoreFrame() error = %v", err)
+			}
+		}
+	}
+
+	private := true
+	policy, err := NewRetentionPolicy("24h", []RetentionRule{
+		{PrivateMatch: &private, Retention: "1h"},
+	})
+	if err != nil {
+		t.Fatalf("NewRetentionPolicy() error = %v", err)
+	}
+	m := NewRetentionManager(repo, storage, policy, &DefaultLogger{})
+
+	expiring, err := m.Expiring(ctx, time.Hour)
+	if err != nil {
+		t.Fatalf("Expiring() error = %v", err)
+	}
+	if len(expiring) != 2 || expiring[0].LobbySessionID != "old-public" || expiring[1].LobbySessionID != "recent-private" {
+		t.Fatalf("Expiring(1h) = %+v, want old-public and recent-private", expiring)
+	}
+	if expiring[1].Retention != "1h0m0s" || !expiring[1].PrivateMatch {
+		t.Errorf("recent-private = %+v, want 1h private retention", expiring[1])
+	}
+
+	m.enforce(ctx)
+
+	if summary, err := repo.Session(ctx, "old-public"); err != nil || summary != nil {
+		t.Errorf("old-public still stored after enforce: %+v, %v", summary, err)
+	}
+	for _, id := range []string{"recent-private", "recent-public"} {
+		if summary, err := repo.Session(ctx, id); err != nil || summary == nil {
+			t.Errorf("%s removed by enforce: %v", id, err)
+		}
+	}
+
+	path, err := storage.GetMatchFile("old-public")
+	if err != nil {
+		t.Fatalf("GetMatchFile() error = %v", err)
+	}
+	if filepath.Dir(path) != storage.archiveDir {
+		t.Errorf("archive written to %s, want the archive directory", path)
+	}
+
+	// Capture limits do not remove archives
+	old := now.Add(-2 * storage.retention)
+	if err := os.Chtimes(path, old, old); err != nil {
+		t.Fatal(err)
+	}
+	storage.cleanup()
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("archive removed by capture cleanup: %v", err)
+	}
+	reader, err := codecs.NewNevrCapReader(path)
+	if err != nil {
+		t.Fatalf("NewNevrCapReader() error = %v", err)
+	}
+	defer reader.Close()
+
+	if header, err := reader.ReadHeader(); err != nil || header.GetCaptureId() != "old-public" {
+		t.Fatalf("ReadHeader() = %v, %v", header, err)
+	}
+
+	var frames int
+	for {
+		frame, err := reader.ReadFrame()
+		if err != nil {
+			break
+		}
+		if frame.GetFrameIndex() != uint32(frames) {
+			t.Errorf("archived frame %d has index %d", frames, frame.GetFrameIndex())
+		}
+		frames++
+	}
+	if frames != 3 {
+		t.Errorf("archive has %d frames, want 3", frames)
+	}
+}
+
+func TestStorageManager_ArchiveMatchCommit(t *testing.T) {
+	storage, err := NewStorageManager(t.TempDir(), time.Hour, 1<<30, &DefaultLogger{})
+	if err != nil {
+		t.Fatalf("NewStorageManager() error = %v", err)
+	}
+	if err := storage.WriteFrame("match-a", &telemetry.LobbySessionStateFrame{FrameIndex: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := storage.CloseMatch("match-a"); err != nil {
+		t.Fatal(err)
+	}
+	capture, err := storage.GetMatchFile("match-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sent := false
+	next := func() (*telemetry.LobbySessionStateFrame, error) {
+		if sent {
+			return nil, nil
+		}
+		sent = true
+		return &telemetry.LobbySessionStateFrame{FrameIndex: 1}, nil
+	}
+
+	// A failed commit keeps the capture and leaves no archive behind
+	changed := errors.New("session changed")
+	if _, err := storage.ArchiveMatch("match-a", next, func() error { return changed }); !errors.Is(err, changed) {
+		t.Fatalf("ArchiveMatch() error = %v, want the commit error", err)
+	}
+	if _, err := os.Stat(capture); err != nil {
+		t.Errorf("capture removed after a failed commit: %v", err)
+	}
+	if archives, _ := filepath.Glob(filepath.Join(storage.archiveDir, "*")); len(archives) != 0 {
+		t.Errorf("archive directory = %v after a failed commit, want it empty", archives)
+	}
+
+	sent = false
+	path, err := storage.ArchiveMatch("match-a", next, func() error { return nil })
+	if err != nil {
+		t.Fatalf("ArchiveMatch() error = %v", err)
+	}
+	if _, err := os.Stat(capture); !os.IsNotExist(err) {
+		t.Errorf("capture kept after the archive was committed: %v", err)
+	}
+	if got, _ := storage.GetMatchFile("match-a"); got != path {
+		t.Errorf("GetMatchFile() = %s, want the archive %s", got, path)
+	}
+}
@@ -0,0 +1,693 @@
+//*
+// The nevr-agent gRPC API.
+//
+// Calls authenticate with the same tokens as the REST API, sent as
+// "authorization: Bearer <token>" metadata, or with a client certificate.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: agent/v1/agent.proto
+
+package rpc
+
+import (
+	v1 "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// IngestFramesResponse counts the frames of an IngestFrames stream.
+type IngestFramesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Frames stored.
+	Stored int64 `protobuf:"varint,1,opt,name=stored,proto3" json:"stored,omitempty"`
+	// Frames that were already stored.
+	Duplicates int64 `protobuf:"varint,2,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
+	// Frames with an invalid lobby session ID.
+	Rejected int64 `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Frames dropped by a rate limit or quota.
+	RateLimited   int64 `protobuf:"varint,4,opt,name=rate_limited,json=rateLimited,proto3" json:"rate_limited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestFramesResponse) Reset() {
+	*x = IngestFramesResponse{}
+	mi := &file_agent_v1_agent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestFramesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestFramesResponse) ProtoMessage() {}
+
+func (x *IngestFramesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestFramesResponse.ProtoReflect.Descriptor instead.
+func (*IngestFramesResponse) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IngestFramesResponse) GetStored() int64 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+func (x *IngestFramesResponse) GetDuplicates() int64 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+func (x *IngestFramesResponse) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *IngestFramesResponse) GetRateLimited() int64 {
+	if x != nil {
+		return x.RateLimited
+	}
+	return 0
+}
+
+type SubscribeMatchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LobbySessionId string                 `protobuf:"bytes,1,opt,name=lobby_session_id,json=lobbySessionId,proto3" json:"lobby_session_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscribeMatchRequest) Reset() {
+	*x = SubscribeMatchRequest{}
+	mi := &file_agent_v1_agent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMatchRequest) ProtoMessage() {}
+
+func (x *SubscribeMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMatchRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMatchRequest) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubscribeMatchRequest) GetLobbySessionId() string {
+	if x != nil {
+		return x.LobbySessionId
+	}
+	return ""
+}
+
+type GetSessionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LobbySessionId string                 `protobuf:"bytes,1,opt,name=lobby_session_id,json=lobbySessionId,proto3" json:"lobby_session_id,omitempty"`
+	// Only return frames carrying this event type.
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// Page of frames to return; limit defaults to 100.
+	Limit         int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionRequest) Reset() {
+	*x = GetSessionRequest{}
+	mi := &file_agent_v1_agent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionRequest) ProtoMessage() {}
+
+func (x *GetSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionRequest) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSessionRequest) GetLobbySessionId() string {
+	if x != nil {
+		return x.LobbySessionId
+	}
+	return ""
+}
+
+func (x *GetSessionRequest) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *GetSessionRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSessionRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetSessionResponse struct {
+	state   protoimpl.MessageState       `protogen:"open.v1"`
+	Session *SessionSummary              `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+	Frames  []*v1.LobbySessionStateFrame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	// Frames matching the request, across all pages.
+	TotalCount    int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSessionResponse) Reset() {
+	*x = GetSessionResponse{}
+	mi := &file_agent_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionResponse) ProtoMessage() {}
+
+func (x *GetSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionResponse) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSessionResponse) GetSession() *SessionSummary {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *GetSessionResponse) GetFrames() []*v1.LobbySessionStateFrame {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *GetSessionResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// SessionSummary describes a stored session.
+type SessionSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LobbySessionId string                 `protobuf:"bytes,1,opt,name=lobby_session_id,json=lobbySessionId,proto3" json:"lobby_session_id,omitempty"`
+	NodeId         string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	MatchType      string                 `protobuf:"bytes,3,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	PrivateMatch   bool                   `protobuf:"varint,4,opt,name=private_match,json=privateMatch,proto3" json:"private_match,omitempty"`
+	FrameCount     int64                  `protobuf:"varint,5,opt,name=frame_count,json=frameCount,proto3" json:"frame_count,omitempty"`
+	FirstTimestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=first_timestamp,json=firstTimestamp,proto3" json:"first_timestamp,omitempty"`
+	LastTimestamp  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SessionSummary) Reset() {
+	*x = SessionSummary{}
+	mi := &file_agent_v1_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionSummary) ProtoMessage() {}
+
+func (x *SessionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionSummary.ProtoReflect.Descriptor instead.
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SessionSummary) GetLobbySessionId() string {
+	if x != nil {
+		return x.LobbySessionId
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *SessionSummary) GetPrivateMatch() bool {
+	if x != nil {
+		return x.PrivateMatch
+	}
+	return false
+}
+
+func (x *SessionSummary) GetFrameCount() int64 {
+	if x != nil {
+		return x.FrameCount
+	}
+	return 0
+}
+
+func (x *SessionSummary) GetFirstTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstTimestamp
+	}
+	return nil
+}
+
+func (x *SessionSummary) GetLastTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return nil
+}
+
+type ListMatchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also list stored sessions, most recently updated first.
+	IncludeStored bool `protobuf:"varint,1,opt,name=include_stored,json=includeStored,proto3" json:"include_stored,omitempty"`
+	// Maximum stored sessions to list; defaults to 100.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesRequest) Reset() {
+	*x = ListMatchesRequest{}
+	mi := &file_agent_v1_agent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesRequest) ProtoMessage() {}
+
+func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMatchesRequest) GetIncludeStored() bool {
+	if x != nil {
+		return x.IncludeStored
+	}
+	return false
+}
+
+func (x *ListMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Live          []*LiveMatch           `protobuf:"bytes,1,rep,name=live,proto3" json:"live,omitempty"`
+	Stored        []*SessionSummary      `protobuf:"bytes,2,rep,name=stored,proto3" json:"stored,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesResponse) Reset() {
+	*x = ListMatchesResponse{}
+	mi := &file_agent_v1_agent_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesResponse) ProtoMessage() {}
+
+func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMatchesResponse) GetLive() []*LiveMatch {
+	if x != nil {
+		return x.Live
+	}
+	return nil
+}
+
+func (x *ListMatchesResponse) GetStored() []*SessionSummary {
+	if x != nil {
+		return x.Stored
+	}
+	return nil
+}
+
+// LiveMatch describes a match currently broadcasting frames.
+type LiveMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LobbySessionId string                 `protobuf:"bytes,1,opt,name=lobby_session_id,json=lobbySessionId,proto3" json:"lobby_session_id,omitempty"`
+	MapName        string                 `protobuf:"bytes,2,opt,name=map_name,json=mapName,proto3" json:"map_name,omitempty"`
+	MatchType      string                 `protobuf:"bytes,3,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	GameStatus     string                 `protobuf:"bytes,4,opt,name=game_status,json=gameStatus,proto3" json:"game_status,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	LastFrameAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_frame_at,json=lastFrameAt,proto3" json:"last_frame_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LiveMatch) Reset() {
+	*x = LiveMatch{}
+	mi := &file_agent_v1_agent_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveMatch) ProtoMessage() {}
+
+func (x *LiveMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveMatch.ProtoReflect.Descriptor instead.
+func (*LiveMatch) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LiveMatch) GetLobbySessionId() string {
+	if x != nil {
+		return x.LobbySessionId
+	}
+	return ""
+}
+
+func (x *LiveMatch) GetMapName() string {
+	if x != nil {
+		return x.MapName
+	}
+	return ""
+}
+
+func (x *LiveMatch) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *LiveMatch) GetGameStatus() string {
+	if x != nil {
+		return x.GameStatus
+	}
+	return ""
+}
+
+func (x *LiveMatch) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *LiveMatch) GetLastFrameAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFrameAt
+	}
+	return nil
+}
+
+var File_agent_v1_agent_proto protoreflect.FileDescriptor
+
+const file_agent_v1_agent_proto_rawDesc = "" +
+	"\n" +
+	"\x14agent/v1/agent.proto\x12\bagent.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ctelemetry/v1/telemetry.proto\"\x8d\x01\n" +
+	"\x14IngestFramesResponse\x12\x16\n" +
+	"\x06stored\x18\x01 \x01(\x03R\x06stored\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x02 \x01(\x03R\n" +
+	"duplicates\x12\x1a\n" +
+	"\brejected\x18\x03 \x01(\x03R\brejected\x12!\n" +
+	"\frate_limited\x18\x04 \x01(\x03R\vrateLimited\"A\n" +
+	"\x15SubscribeMatchRequest\x12(\n" +
+	"\x10lobby_session_id\x18\x01 \x01(\tR\x0elobbySessionId\"\x8a\x01\n" +
+	"\x11GetSessionRequest\x12(\n" +
+	"\x10lobby_session_id\x18\x01 \x01(\tR\x0elobbySessionId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\"\xa7\x01\n" +
+	"\x12GetSessionResponse\x122\n" +
+	"\asession\x18\x01 \x01(\v2\x18.agent.v1.SessionSummaryR\asession\x12<\n" +
+	"\x06frames\x18\x02 \x03(\v2$.telemetry.v1.LobbySessionStateFrameR\x06frames\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\"\xc0\x02\n" +
+	"\x0eSessionSummary\x12(\n" +
+	"\x10lobby_session_id\x18\x01 \x01(\tR\x0elobbySessionId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x03 \x01(\tR\tmatchType\x12#\n" +
+	"\rprivate_match\x18\x04 \x01(\bR\fprivateMatch\x12\x1f\n" +
+	"\vframe_count\x18\x05 \x01(\x03R\n" +
+	"frameCount\x12C\n" +
+	"\x0ffirst_timestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0efirstTimestamp\x12A\n" +
+	"\x0elast_timestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rlastTimestamp\"Q\n" +
+	"\x12ListMatchesRequest\x12%\n" +
+	"\x0einclude_stored\x18\x01 \x01(\bR\rincludeStored\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"p\n" +
+	"\x13ListMatchesResponse\x12'\n" +
+	"\x04live\x18\x01 \x03(\v2\x13.agent.v1.LiveMatchR\x04live\x120\n" +
+	"\x06stored\x18\x02 \x03(\v2\x18.agent.v1.SessionSummaryR\x06stored\"\x8b\x02\n" +
+	"\tLiveMatch\x12(\n" +
+	"\x10lobby_session_id\x18\x01 \x01(\tR\x0elobbySessionId\x12\x19\n" +
+	"\bmap_name\x18\x02 \x01(\tR\amapName\x12\x1d\n" +
+	"\n" +
+	"match_type\x18\x03 \x01(\tR\tmatchType\x12\x1f\n" +
+	"\vgame_status\x18\x04 \x01(\tR\n" +
+	"gameStatus\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12>\n" +
+	"\rlast_frame_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vlastFrameAt2\xda\x02\n" +
+	"\x10TelemetryService\x12V\n" +
+	"\fIngestFrames\x12$.telemetry.v1.LobbySessionStateFrame\x1a\x1e.agent.v1.IngestFramesResponse(\x01\x12Y\n" +
+	"\x0eSubscribeMatch\x12\x1f.agent.v1.SubscribeMatchRequest\x1a$.telemetry.v1.LobbySessionStateFrame0\x01\x12G\n" +
+	"\n" +
+	"GetSession\x12\x1b.agent.v1.GetSessionRequest\x1a\x1c.agent.v1.GetSessionResponse\x12J\n" +
+	"\vListMatches\x12\x1c.agent.v1.ListMatchesRequest\x1a\x1d.agent.v1.ListMatchesResponseB9Z7github.com/echotools/nevr-agent/v4/internal/api/rpc;rpcb\x06proto3"
+
+var (
+	file_agent_v1_agent_proto_rawDescOnce sync.Once
+	file_agent_v1_agent_proto_rawDescData []byte
+)
+
+func file_agent_v1_agent_proto_rawDescGZIP() []byte {
+	file_agent_v1_agent_proto_rawDescOnce.Do(func() {
+		file_agent_v1_agent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_agent_v1_agent_proto_rawDesc), len(file_agent_v1_agent_proto_rawDesc)))
+	})
+	return file_agent_v1_agent_proto_rawDescData
+}
+
+var file_agent_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_agent_v1_agent_proto_goTypes = []any{
+	(*IngestFramesResponse)(nil),      // 0: agent.v1.IngestFramesResponse
+	(*SubscribeMatchRequest)(nil),     // 1: agent.v1.SubscribeMatchRequest
+	(*GetSessionRequest)(nil),         // 2: agent.v1.GetSessionRequest
+	(*GetSessionResponse)(nil),        // 3: agent.v1.GetSessionResponse
+	(*SessionSummary)(nil),            // 4: agent.v1.SessionSummary
+	(*ListMatchesRequest)(nil),        // 5: agent.v1.ListMatchesRequest
+	(*ListMatchesResponse)(nil),       // 6: agent.v1.ListMatchesResponse
+	(*LiveMatch)(nil),                 // 7: agent.v1.LiveMatch
+	(*v1.LobbySessionStateFrame)(nil), // 8: telemetry.v1.LobbySessionStateFrame
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+}
+var file_agent_v1_agent_proto_depIdxs = []int32{
+	4,  // 0: agent.v1.GetSessionResponse.session:type_name -> agent.v1.SessionSummary
+	8,  // 1: agent.v1.GetSessionResponse.frames:type_name -> telemetry.v1.LobbySessionStateFrame
+	9,  // 2: agent.v1.SessionSummary.first_timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 3: agent.v1.SessionSummary.last_timestamp:type_name -> google.protobuf.Timestamp
+	7,  // 4: agent.v1.ListMatchesResponse.live:type_name -> agent.v1.LiveMatch
+	4,  // 5: agent.v1.ListMatchesResponse.stored:type_name -> agent.v1.SessionSummary
+	9,  // 6: agent.v1.LiveMatch.started_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: agent.v1.LiveMatch.last_frame_at:type_name -> google.protobuf.Timestamp
+	8,  // 8: agent.v1.TelemetryService.IngestFrames:input_type -> telemetry.v1.LobbySessionStateFrame
+	1,  // 9: agent.v1.TelemetryService.SubscribeMatch:input_type -> agent.v1.SubscribeMatchRequest
+	2,  // 10: agent.v1.TelemetryService.GetSession:input_type -> agent.v1.GetSessionRequest
+	5,  // 11: agent.v1.TelemetryService.ListMatches:input_type -> agent.v1.ListMatchesRequest
+	0,  // 12: agent.v1.TelemetryService.IngestFrames:output_type -> agent.v1.IngestFramesResponse
+	8,  // 13: agent.v1.TelemetryService.SubscribeMatch:output_type -> telemetry.v1.LobbySessionStateFrame
+	3,  // 14: agent.v1.TelemetryService.GetSession:output_type -> agent.v1.GetSessionResponse
+	6,  // 15: agent.v1.TelemetryService.ListMatches:output_type -> agent.v1.ListMatchesResponse
+	12, // [12:16] is the sub-list for method output_type
+	8,  // [8:12] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_agent_v1_agent_proto_init() }
+func file_agent_v1_agent_proto_init() {
+	if File_agent_v1_agent_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_v1_agent_proto_rawDesc), len(file_agent_v1_agent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_agent_v1_agent_proto_goTypes,
+		DependencyIndexes: file_agent_v1_agent_proto_depIdxs,
+		MessageInfos:      file_agent_v1_agent_proto_msgTypes,
+	}.Build()
+	File_agent_v1_agent_proto = out.File
+	file_agent_v1_agent_proto_goTypes = nil
+	file_agent_v1_agent_proto_depIdxs = nil
+}
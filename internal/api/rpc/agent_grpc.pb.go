@@ -0,0 +1,259 @@
+//*
+// The nevr-agent gRPC API.
+//
+// Calls authenticate with the same tokens as the REST API, sent as
+// "authorization: Bearer <token>" metadata, or with a client certificate.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: agent/v1/agent.proto
+
+package rpc
+
+import (
+	context "context"
+	v1 "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TelemetryService_IngestFrames_FullMethodName   = "/agent.v1.TelemetryService/IngestFrames"
+	TelemetryService_SubscribeMatch_FullMethodName = "/agent.v1.TelemetryService/SubscribeMatch"
+	TelemetryService_GetSession_FullMethodName     = "/agent.v1.TelemetryService/GetSession"
+	TelemetryService_ListMatches_FullMethodName    = "/agent.v1.TelemetryService/ListMatches"
+)
+
+// TelemetryServiceClient is the client API for TelemetryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TelemetryService ingests, stores and streams lobby session frames.
+type TelemetryServiceClient interface {
+	// IngestFrames stores a stream of frames sent by one node. Requires the
+	// ingest scope.
+	IngestFrames(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[v1.LobbySessionStateFrame, IngestFramesResponse], error)
+	// SubscribeMatch streams the frames of a live match as they are ingested,
+	// until the match ends. Requires the stream:subscribe scope.
+	SubscribeMatch(ctx context.Context, in *SubscribeMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.LobbySessionStateFrame], error)
+	// GetSession returns a stored session and a page of its frames. Requires
+	// the read scope.
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	// ListMatches lists the live matches and, optionally, the stored sessions.
+	// Requires the read scope.
+	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error)
+}
+
+type telemetryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelemetryServiceClient(cc grpc.ClientConnInterface) TelemetryServiceClient {
+	return &telemetryServiceClient{cc}
+}
+
+func (c *telemetryServiceClient) IngestFrames(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[v1.LobbySessionStateFrame, IngestFramesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TelemetryService_ServiceDesc.Streams[0], TelemetryService_IngestFrames_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[v1.LobbySessionStateFrame, IngestFramesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TelemetryService_IngestFramesClient = grpc.ClientStreamingClient[v1.LobbySessionStateFrame, IngestFramesResponse]
+
+func (c *telemetryServiceClient) SubscribeMatch(ctx context.Context, in *SubscribeMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.LobbySessionStateFrame], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TelemetryService_ServiceDesc.Streams[1], TelemetryService_SubscribeMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribeMatchRequest, v1.LobbySessionStateFrame]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TelemetryService_SubscribeMatchClient = grpc.ServerStreamingClient[v1.LobbySessionStateFrame]
+
+func (c *telemetryServiceClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, TelemetryService_GetSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchesResponse)
+	err := c.cc.Invoke(ctx, TelemetryService_ListMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelemetryServiceServer is the server API for TelemetryService service.
+// All implementations must embed UnimplementedTelemetryServiceServer
+// for forward compatibility.
+//
+// TelemetryService ingests, stores and streams lobby session frames.
+type TelemetryServiceServer interface {
+	// IngestFrames stores a stream of frames sent by one node. Requires the
+	// ingest scope.
+	IngestFrames(grpc.ClientStreamingServer[v1.LobbySessionStateFrame, IngestFramesResponse]) error
+	// SubscribeMatch streams the frames of a live match as they are ingested,
+	// until the match ends. Requires the stream:subscribe scope.
+	SubscribeMatch(*SubscribeMatchRequest, grpc.ServerStreamingServer[v1.LobbySessionStateFrame]) error
+	// GetSession returns a stored session and a page of its frames. Requires
+	// the read scope.
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	// ListMatches lists the live matches and, optionally, the stored sessions.
+	// Requires the read scope.
+	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
+	mustEmbedUnimplementedTelemetryServiceServer()
+}
+
+// UnimplementedTelemetryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTelemetryServiceServer struct{}
+
+func (UnimplementedTelemetryServiceServer) IngestFrames(grpc.ClientStreamingServer[v1.LobbySessionStateFrame, IngestFramesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method IngestFrames not implemented")
+}
+func (UnimplementedTelemetryServiceServer) SubscribeMatch(*SubscribeMatchRequest, grpc.ServerStreamingServer[v1.LobbySessionStateFrame]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMatch not implemented")
+}
+func (UnimplementedTelemetryServiceServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedTelemetryServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatches not implemented")
+}
+func (UnimplementedTelemetryServiceServer) mustEmbedUnimplementedTelemetryServiceServer() {}
+func (UnimplementedTelemetryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTelemetryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelemetryServiceServer will
+// result in compilation errors.
+type UnsafeTelemetryServiceServer interface {
+	mustEmbedUnimplementedTelemetryServiceServer()
+}
+
+func RegisterTelemetryServiceServer(s grpc.ServiceRegistrar, srv TelemetryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTelemetryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TelemetryService_ServiceDesc, srv)
+}
+
+func _TelemetryService_IngestFrames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TelemetryServiceServer).IngestFrames(&grpc.GenericServerStream[v1.LobbySessionStateFrame, IngestFramesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TelemetryService_IngestFramesServer = grpc.ClientStreamingServer[v1.LobbySessionStateFrame, IngestFramesResponse]
+
+func _TelemetryService_SubscribeMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServiceServer).SubscribeMatch(m, &grpc.GenericServerStream[SubscribeMatchRequest, v1.LobbySessionStateFrame]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TelemetryService_SubscribeMatchServer = grpc.ServerStreamingServer[v1.LobbySessionStateFrame]
+
+func _TelemetryService_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelemetryService_GetSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TelemetryService_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TelemetryService_ServiceDesc is the grpc.ServiceDesc for TelemetryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TelemetryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "agent.v1.TelemetryService",
+	HandlerType: (*TelemetryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSession",
+			Handler:    _TelemetryService_GetSession_Handler,
+		},
+		{
+			MethodName: "ListMatches",
+			Handler:    _TelemetryService_ListMatches_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestFrames",
+			Handler:       _TelemetryService_IngestFrames_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SubscribeMatch",
+			Handler:       _TelemetryService_SubscribeMatch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "agent/v1/agent.proto",
+}
@@ -2,20 +2,24 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/echotools/nevr-agent/v4/internal/amqp"
 	"github.com/echotools/nevr-agent/v4/internal/api/graph"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
 	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
 	"github.com/gofrs/uuid/v5"
 	"github.com/gorilla/mux"
 	"github.com/rs/cors"
-	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -28,13 +32,20 @@ var jsonMarshaler = &protojson.MarshalOptions{
 
 // Server represents the HTTP server for session events
 type Server struct {
-	mongoClient     *mongo.Client
+	repository      store.Repository
 	router          *mux.Router
 	logger          Logger
 	graphqlResolver *graph.Resolver
 	corsHandler     *cors.Cors
 	amqpPublisher   *amqp.Publisher
-	jwtSecret       string
+	streamHub       *StreamHub
+	storage         *StorageManager
+	retention       *RetentionManager
+	auth            *Authenticator
+	tlsConfig       *tls.Config
+	limiter         *IngestLimiter
+	metrics         *Metrics
+	idempotency     *IdempotencyCache
 }
 
 // Logger interface for abstracting logging
@@ -69,8 +80,49 @@ func (s *Server) SetAMQPPublisher(publisher *amqp.Publisher) {
 	s.amqpPublisher = publisher
 }
 
-// NewServer creates a new session events HTTP server
-func NewServer(mongoClient *mongo.Client, logger Logger, jwtSecret string) *Server {
+// SetStreamHub sets the hub that ingested frames are broadcast to, registers
+// its streaming routes and feeds the GraphQL subscriptions from it
+func (s *Server) SetStreamHub(hub *StreamHub) {
+	s.streamHub = hub
+	s.graphqlResolver.Live = hub
+	hub.RegisterRoutes(s.scoped(ScopeStreamSubscribe))
+}
+
+// SetStorageManager sets the capture storage that ingested frames are written to
+// and registers the match download routes
+func (s *Server) SetStorageManager(storage *StorageManager) {
+	s.storage = storage
+	NewMatchRetrievalHandler(storage, s.logger, "").RegisterRoutes(s.scoped(ScopeRead))
+}
+
+// SetRetentionManager sets the session retention manager and registers its routes
+func (s *Server) SetRetentionManager(retention *RetentionManager) {
+	s.retention = retention
+	retention.RegisterRoutes(s.scoped(ScopeAdmin))
+}
+
+// SetIngestLimiter applies rate limits and quotas to ingested frames
+func (s *Server) SetIngestLimiter(limiter *IngestLimiter) {
+	s.limiter = limiter
+}
+
+// SetMetrics records ingest and connection metrics
+func (s *Server) SetMetrics(metrics *Metrics) {
+	s.metrics = metrics
+}
+
+// SetTLSConfig serves HTTPS with the configuration. With client certificate
+// authentication enabled, verified certificates authenticate their node.
+func (s *Server) SetTLSConfig(tlsConfig *tls.Config, config TLSConfig) {
+	s.tlsConfig = tlsConfig
+	if config.ClientCAFile != "" {
+		s.auth.SetClientCertAuth(config)
+	}
+}
+
+// NewServer creates a new session events HTTP server. Every route except the
+// health check requires a token signed with jwtSecret.
+func NewServer(repository store.Repository, logger Logger, jwtSecret string) *Server {
 	if logger == nil {
 		logger = &DefaultLogger{}
 	}
@@ -79,13 +131,15 @@ func NewServer(mongoClient *mongo.Client, logger Logger, jwtSecret string) *Serv
 	router.StrictSlash(true) // Handle trailing slashes consistently
 
 	s := &Server{
-		mongoClient:     mongoClient,
+		repository:      repository,
 		router:          router,
 		logger:          logger,
-		graphqlResolver: graph.NewResolver(mongoClient),
+		graphqlResolver: graph.NewResolver(repository),
 		corsHandler:     createCORSHandler(),
-		jwtSecret:       jwtSecret,
+		auth:            NewAuthenticator(jwtSecret),
+		idempotency:     NewIdempotencyCache(idempotencyTTL),
 	}
+	s.graphqlResolver.Auth = s.auth
 
 	s.setupRoutes()
 	return s
@@ -110,8 +164,8 @@ func createCORSHandler() *cors.Cors {
 	return cors.New(cors.Options{
 		AllowedOrigins:   allowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Node-ID", "X-User-ID"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-Node-ID", "X-User-ID", IdempotencyKeyHeader, "Last-Event-ID"},
+		ExposedHeaders:   []string{"Link", IdempotentReplayHeader},
 		AllowCredentials: true,
 		MaxAge:           300, // Maximum value not ignored by any major browser
 	})
@@ -122,38 +176,44 @@ func (s *Server) setupRoutes() {
 	// Health check (unversioned)
 	s.router.HandleFunc("/health", s.healthHandler).Methods("GET")
 
+	s.router.Use(s.corsOptionsMiddleware)
+
+	// Routes are grouped by the token scope they require; the node and user
+	// of ingested frames are taken from the verified token claims
+	ingest := s.scoped(ScopeIngest)
+	read := s.scoped(ScopeRead)
+
 	// ============================================
 	// v1 API - Legacy endpoints (backward compatible)
 	// ============================================
-	v1 := s.router.PathPrefix("/v1").Subrouter()
-	v1.Use(s.corsOptionsMiddleware)
-	v1.HandleFunc("/lobby-session-events", s.storeSessionEventHandler).Methods("POST")
-	v1.HandleFunc("/lobby-session-events/{lobby_session_id}", s.getSessionEventsHandlerV1).Methods("GET")
+	ingest.HandleFunc("/v1/lobby-session-events", s.idempotent(s.storeSessionEventHandler)).Methods("POST")
+	read.HandleFunc("/v1/lobby-session-events/{lobby_session_id}", s.getSessionEventsHandlerV1).Methods("GET")
 
 	// Legacy routes without version prefix (deprecated, redirects to v1)
-	s.router.Use(s.corsOptionsMiddleware)
-	s.router.HandleFunc("/lobby-session-events", s.storeSessionEventHandler).Methods("POST")
-	s.router.HandleFunc("/lobby-session-events/{lobby_session_id}", s.getSessionEventsHandlerV1).Methods("GET")
+	ingest.HandleFunc("/lobby-session-events", s.idempotent(s.storeSessionEventHandler)).Methods("POST")
+	read.HandleFunc("/lobby-session-events/{lobby_session_id}", s.getSessionEventsHandlerV1).Methods("GET")
 
 	// ============================================
 	// v3 API - New GraphQL and REST endpoints
 	// ============================================
-	v3 := s.router.PathPrefix("/v3").Subrouter()
-	v3.Use(s.corsOptionsMiddleware)
 
-	// GraphQL endpoint
-	v3.Handle("/query", s.graphqlResolver.Handler()).Methods("POST")
-	v3.Handle("/graphql", s.graphqlResolver.Handler()).Methods("POST")
+	// GraphQL endpoint; operations are authorized by type (queries need read,
+	// mutations ingest and subscriptions stream:subscribe)
+	graphqlHandler := s.graphqlResolver.Handler()
+	authenticated := s.scoped("")
+	authenticated.Handle("/v3/query", graphqlHandler).Methods("GET", "POST")
+	authenticated.Handle("/v3/graphql", graphqlHandler).Methods("GET", "POST")
 
-	// GraphQL Playground (development tool)
-	v3.Handle("/playground", graph.PlaygroundHandler("/v3/query")).Methods("GET")
+	// GraphQL Playground (development tool); its requests carry their own token
+	s.router.Handle("/v3/playground", graph.PlaygroundHandler("/v3/query")).Methods("GET")
 
-	// v3 REST endpoints (optional, for those who prefer REST over GraphQL)
-	v3.HandleFunc("/lobby-session-events", s.storeSessionEventHandlerV3).Methods("POST")
-	v3.HandleFunc("/lobby-session-events/{lobby_session_id}", s.getSessionEventsHandlerV3).Methods("GET")
+	// v3 REST endpoints (optional, for those who prefer REST over GraphQL);
+	// ingest POSTs with an Idempotency-Key are processed once per node and key
+	ingest.HandleFunc("/v3/lobby-session-events", s.idempotent(s.storeSessionEventHandlerV3)).Methods("POST")
+	read.HandleFunc("/v3/lobby-session-events/{lobby_session_id}", s.getSessionEventsHandlerV3).Methods("GET")
 
-	// WebSocket stream endpoint with JWT authentication
-	v3.HandleFunc("/stream", JWTMiddleware(s.jwtSecret, s.WebSocketStreamHandler)).Methods("GET")
+	// WebSocket ingest stream
+	ingest.HandleFunc("/v3/stream", s.WebSocketStreamHandler).Methods("GET")
 
 	// Add a NotFoundHandler for debugging unmatched routes
 	s.router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -168,13 +228,21 @@ func (s *Server) setupRoutes() {
 	})
 }
 
+// scoped returns a router whose routes require a token granting scope, or
+// only a valid token if scope is empty
+func (s *Server) scoped(scope string) *mux.Router {
+	r := s.router.NewRoute().Subrouter()
+	r.Use(JWTMiddleware(s.auth, scope))
+	return r
+}
+
 // corsOptionsMiddleware handles CORS preflight OPTIONS requests
 func (s *Server) corsOptionsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodOptions {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-Node-ID, X-User-ID")
+			w.Header().Set("Access-Control-Allow-Headers", "Accept, Authorization, Content-Type, X-Node-ID, X-User-ID, Idempotency-Key, Last-Event-ID")
 			w.WriteHeader(http.StatusOK)
 			return
 		}
@@ -190,8 +258,6 @@ func (s *Server) storeSessionEventHandler(w http.ResponseWriter, r *http.Request
 		"path", r.URL.Path,
 		"content_type", r.Header.Get("Content-Type"))
 
-	ctx := r.Context()
-
 	var payload json.RawMessage
 	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		s.logger.Error("Failed to decode request body", "error", err)
@@ -207,32 +273,140 @@ func (s *Server) storeSessionEventHandler(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	// Extract node from request headers or use a default value
-	node := r.Header.Get("X-Node-ID")
-	if node == "" {
-		node = "default-node" // You might want to configure this
+	s.storeSingleFrame(w, r, msg, len(payload))
+}
+
+// storeSingleFrame stores one frame from an ingest request and replies with a
+// StoreSessionEventResponse
+func (s *Server) storeSingleFrame(w http.ResponseWriter, r *http.Request, msg *telemetry.LobbySessionStateFrame, size int) {
+	ctx := r.Context()
+	node, userID := s.auth.Identity(ctx)
+	lobbySessionID := msg.GetSession().GetSessionId()
+
+	// Frames already stored are acknowledged so that retries succeed
+	duplicate, err := s.storeFrame(ctx, node, userID, msg, size)
+	var limitErr *RateLimitError
+	switch {
+	case errors.As(err, &limitErr):
+		writeRateLimitError(w, err)
+		return
+	case errors.Is(err, errInvalidMatchID):
+		s.logger.Error("Invalid match ID", "lobby_session_id", lobbySessionID, "node", node)
+		http.Error(w, "Invalid match ID in payload", http.StatusBadRequest)
+		return
+	case err != nil:
+		s.logger.Error("Failed to store session frame", "error", err, "lobby_session_id", lobbySessionID)
+		http.Error(w, "Failed to store session frame", http.StatusInternalServerError)
+		return
+	}
+
+	// Return success response
+	response := map[string]any{
+		"success":          true,
+		"lobby_session_id": lobbySessionID,
+	}
+	if duplicate {
+		response["duplicate"] = true
 	}
 
-	// Extract user ID from request headers
-	userID := r.Header.Get("X-User-ID")
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Error("Failed to encode response", "error", err)
+		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
 
-	lobbySessionID := msg.GetSession().GetSessionId()
+	s.logger.Debug("Stored session frame", "session_uuid", lobbySessionID)
+}
+
+// storeFrame applies the ingest limits to a frame of size bytes from node,
+// validates its match ID and ingests it. Frames that are already stored
+// report duplicate instead of an error, and are not charged to the quotas.
+func (s *Server) storeFrame(ctx context.Context, node, userID string, frame *telemetry.LobbySessionStateFrame, size int) (duplicate bool, err error) {
+	if err := s.allowIngest(ctx, node, size); err != nil {
+		return false, err
+	}
+
+	lobbySessionID := frame.GetSession().GetSessionId()
 	matchID := MatchID{
 		UUID: uuid.FromStringOrNil(lobbySessionID),
 		Node: node,
 	}
-
 	if !matchID.IsValid() {
-		s.logger.Error("Invalid match ID", "lobby_session_id", lobbySessionID, "node", node)
-		http.Error(w, "Invalid match ID in payload", http.StatusBadRequest)
-		return
+		return false, fmt.Errorf("%w: %q", errInvalidMatchID, lobbySessionID)
 	}
 
-	// Store the frame to MongoDB
-	if err := StoreSessionFrame(ctx, s.mongoClient, lobbySessionID, userID, msg); err != nil {
-		s.logger.Error("Failed to store session frame", "error", err, "lobby_session_id", lobbySessionID)
-		http.Error(w, "Failed to store session frame", http.StatusInternalServerError)
-		return
+	err = s.ingestFrame(ctx, node, userID, frame)
+	if errors.Is(err, store.ErrDuplicateFrame) {
+		return true, nil
+	}
+	if err == nil && s.limiter != nil {
+		// Only stored frames count toward the daily quotas
+		s.limiter.Charge(node, size)
+	}
+	return false, err
+}
+
+// allowIngest applies the ingest limits to a frame of size bytes from node
+func (s *Server) allowIngest(ctx context.Context, node string, size int) error {
+	if s.limiter == nil {
+		return nil
+	}
+	if err := s.limiter.Allow(ctx, node, size); err != nil {
+		s.logger.Warn("Rejected frame", "node", node, "error", err)
+		return err
+	}
+	return nil
+}
+
+// writeRateLimitError replies 429 with the time the client should wait
+func writeRateLimitError(w http.ResponseWriter, err error) {
+	var limitErr *RateLimitError
+	if errors.As(err, &limitErr) {
+		w.Header().Set("Retry-After", strconv.Itoa(limitErr.RetryAfterSeconds()))
+	}
+	http.Error(w, err.Error(), http.StatusTooManyRequests)
+}
+
+var errInvalidMatchID = errors.New("invalid match ID")
+
+// ingestFrame stores a frame and fans it out to live stream subscribers,
+// capture storage and AMQP. A frame that is already stored returns
+// store.ErrDuplicateFrame and is not fanned out again.
+func (s *Server) ingestFrame(ctx context.Context, node, userID string, frame *telemetry.LobbySessionStateFrame) error {
+	lobbySessionID := frame.GetSession().GetSessionId()
+
+	if err := StoreSessionFrame(ctx, s.repository, lobbySessionID, node, userID, frame); errors.Is(err, store.ErrEventsNotStored) {
+		// The frame is stored; its events can be rebuilt by the backfill
+		s.logger.Warn("Failed to store frame events", "error", err, "lobby_session_id", lobbySessionID)
+	} else if err != nil {
+		return err
+	}
+
+	if s.metrics != nil {
+		s.metrics.RecordFrame(len(frame.GetEvents()) > 0)
+	}
+
+	if s.streamHub != nil {
+		s.streamHub.BroadcastFrame(lobbySessionID, node, frame)
+	}
+
+	if s.storage != nil {
+		if err := s.storage.WriteFrame(lobbySessionID, frame); err != nil {
+			// Captures are best-effort, the frame is already stored
+			s.logger.Warn("Failed to write capture frame", "error", err, "lobby_session_id", lobbySessionID)
+		}
+	}
+
+	if hasMatchEnded(frame) {
+		if s.streamHub != nil {
+			s.streamHub.CloseMatch(lobbySessionID)
+		}
+		if s.storage != nil {
+			if err := s.storage.CloseMatch(lobbySessionID); err != nil {
+				s.logger.Warn("Failed to close capture file", "error", err, "lobby_session_id", lobbySessionID)
+			}
+		}
 	}
 
 	// Publish to AMQP if publisher is available
@@ -241,7 +415,7 @@ func (s *Server) storeSessionEventHandler(w http.ResponseWriter, r *http.Request
 			Type:           "session.frame",
 			LobbySessionID: lobbySessionID,
 			UserID:         userID,
-			Timestamp:      msg.Timestamp.AsTime(),
+			Timestamp:      frame.Timestamp.AsTime(),
 		}
 		if err := s.amqpPublisher.Publish(ctx, amqpEvent); err != nil {
 			// Log error but don't fail the request - AMQP is best-effort
@@ -249,20 +423,17 @@ func (s *Server) storeSessionEventHandler(w http.ResponseWriter, r *http.Request
 		}
 	}
 
-	// Return success response
-	response := map[string]any{
-		"success":          true,
-		"lobby_session_id": lobbySessionID,
-	}
+	return nil
+}
 
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		s.logger.Error("Failed to encode response", "error", err)
-		http.Error(w, "Failed to encode response", http.StatusInternalServerError)
-		return
+// hasMatchEnded reports whether the frame carries a match_ended event
+func hasMatchEnded(frame *telemetry.LobbySessionStateFrame) bool {
+	for _, evt := range frame.GetEvents() {
+		if evt.GetMatchEnded() != nil {
+			return true
+		}
 	}
-
-	s.logger.Debug("Stored session frame", "session_uuid", lobbySessionID)
+	return false
 }
 
 // getSessionEventsHandlerV1 handles GET requests to retrieve session events (v1 legacy format)
@@ -276,8 +447,8 @@ func (s *Server) getSessionEventsHandlerV1(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Retrieve frames from MongoDB
-	frames, err := RetrieveSessionFramesBySessionID(ctx, s.mongoClient, sessionID)
+	// Retrieve frames from the repository
+	frames, err := RetrieveSessionFramesBySessionID(ctx, s.repository, sessionID)
 	if err != nil {
 		s.logger.Error("Failed to retrieve session frames", "error", err, "lobby_session_id", sessionID)
 		http.Error(w, "Failed to retrieve session frames", http.StatusInternalServerError)
@@ -332,8 +503,8 @@ func (s *Server) getSessionEventsHandlerV3(w http.ResponseWriter, r *http.Reques
 		eventType = &et
 	}
 
-	// Retrieve frames from MongoDB with pagination
-	frames, totalCount, err := RetrieveSessionFramesPaginated(ctx, s.mongoClient, sessionID, eventType, 100, 0)
+	// Retrieve frames from the repository with pagination
+	frames, totalCount, err := RetrieveSessionFramesPaginated(ctx, s.repository, sessionID, eventType, 100, 0)
 	if err != nil {
 		s.logger.Error("Failed to retrieve session frames", "error", err, "lobby_session_id", sessionID)
 		http.Error(w, "Failed to retrieve session frames", http.StatusInternalServerError)
@@ -358,10 +529,58 @@ func (s *Server) getSessionEventsHandlerV3(w http.ResponseWriter, r *http.Reques
 	s.logger.Debug("Retrieved session frames (v3)", "lobby_session_id", sessionID, "count", len(frames))
 }
 
-// storeSessionEventHandlerV3 handles POST requests to store session events (v3 format)
+// storeSessionEventHandlerV3 handles POST requests to store session events
+// (v3 format). Besides a single JSON frame it accepts a single binary
+// protobuf frame, NDJSON and length-delimited protobuf batches, and gzip or
+// zstd compressed bodies.
 func (s *Server) storeSessionEventHandlerV3(w http.ResponseWriter, r *http.Request) {
-	// v3 uses the same storage logic but returns more detailed response
-	s.storeSessionEventHandler(w, r)
+	frames, batch, err := decodeIngestBody(w, r)
+	if err != nil {
+		s.logger.Error("Failed to decode request body", "error", err, "content_type", r.Header.Get("Content-Type"))
+		http.Error(w, err.Error(), ingestBodyStatus(err))
+		return
+	}
+	if !batch {
+		s.storeSingleFrame(w, r, frames[0].frame, frames[0].size)
+		return
+	}
+
+	ctx := r.Context()
+	node, userID := s.auth.Identity(ctx)
+
+	// Frames are stored in order. A rate limit stops the batch with 429;
+	// resending it is safe since the frames already stored are acknowledged
+	// as duplicates.
+	response := &StoreSessionEventsResponse{Success: true}
+	for i, f := range frames {
+		duplicate, err := s.storeFrame(ctx, node, userID, f.frame, f.size)
+		var limitErr *RateLimitError
+		switch {
+		case errors.As(err, &limitErr):
+			writeRateLimitError(w, err)
+			return
+		case errors.Is(err, errInvalidMatchID):
+			response.Success = false
+			response.Rejected++
+			response.Errors = append(response.Errors, BatchFrameError{Index: i, Error: err.Error()})
+		case err != nil:
+			s.logger.Error("Failed to store session frame", "error", err, "lobby_session_id", f.frame.GetSession().GetSessionId())
+			http.Error(w, "Failed to store session frame", http.StatusInternalServerError)
+			return
+		case duplicate:
+			response.Duplicates++
+		default:
+			response.Stored++
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		s.logger.Error("Failed to encode response", "error", err)
+		return
+	}
+
+	s.logger.Debug("Stored session frame batch", "stored", response.Stored, "duplicates", response.Duplicates, "rejected", response.Rejected)
 }
 
 // healthHandler handles health check requests
@@ -369,9 +588,9 @@ func (s *Server) healthHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
 
-	// Check MongoDB connection
-	if err := s.mongoClient.Ping(ctx, nil); err != nil {
-		s.logger.Error("MongoDB health check failed", "error", err)
+	// Check storage connection
+	if err := s.repository.Ping(ctx); err != nil {
+		s.logger.Error("Storage health check failed", "error", err)
 		http.Error(w, "Database connection failed", http.StatusServiceUnavailable)
 		return
 	}
@@ -381,6 +600,18 @@ func (s *Server) healthHandler(w http.ResponseWriter, r *http.Request) {
 		"timestamp": time.Now().UTC().Format(time.RFC3339),
 	}
 
+	// Without the frame bus this instance still ingests and serves its own
+	// matches, but not those of the other instances
+	if s.streamHub != nil {
+		if connected, ok := s.streamHub.FrameBusConnected(); ok {
+			response["frame_bus"] = "connected"
+			if !connected {
+				response["status"] = "degraded"
+				response["frame_bus"] = "disconnected"
+			}
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
@@ -412,6 +643,7 @@ func (s *Server) StartWithContext(ctx context.Context, address string) error {
 	server := &http.Server{
 		Addr:         address,
 		Handler:      s,
+		TLSConfig:    s.tlsConfig,
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  60 * time.Second,
@@ -419,7 +651,14 @@ func (s *Server) StartWithContext(ctx context.Context, address string) error {
 
 	// Start server in a goroutine
 	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if s.tlsConfig != nil {
+			// Certificates come from the TLS config, which reloads them
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
 			s.logger.Error("Server failed to start", "error", err)
 		}
 	}()
@@ -452,6 +691,22 @@ type SessionResponseV3 struct {
 	TotalCount       int64                   `json:"total_count"`
 }
 
+// StoreSessionEventsResponse answers a batch ingest request. Success is false
+// if any frame was rejected.
+type StoreSessionEventsResponse struct {
+	Success    bool              `json:"success"`
+	Stored     int               `json:"stored"`
+	Duplicates int               `json:"duplicates"`
+	Rejected   int               `json:"rejected"`
+	Errors     []BatchFrameError `json:"errors,omitempty"`
+}
+
+// BatchFrameError describes a frame of a batch that was rejected
+type BatchFrameError struct {
+	Index int    `json:"index"` // Position of the frame in the batch
+	Error string `json:"error"`
+}
+
 // SessionEventResponseEntry represents a simple session event object (v1 format)
 type SessionEventResponseEntry struct {
 	UserID    string          `json:"user_id,omitempty"`
@@ -3,10 +3,13 @@ package api
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/echotools/nevr-agent/v4/internal/amqp"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/gofrs/uuid/v5"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -14,16 +17,27 @@ import (
 
 // Config represents the configuration for the session events service
 type Config struct {
+	// Storage backend: "mongo" (default) or "embedded"
+	StorageBackend string `json:"storage_backend" yaml:"storage_backend"`
+	DataDir        string `json:"data_dir" yaml:"data_dir"` // Used by the embedded backend
+
 	// MongoDB configuration
 	MongoURI       string `json:"mongo_uri" yaml:"mongo_uri"`
 	DatabaseName   string `json:"database_name" yaml:"database_name"`
 	CollectionName string `json:"collection_name" yaml:"collection_name"`
 
 	// HTTP server configuration
-	ServerAddress string `json:"server_address" yaml:"server_address"`
+	ServerAddress string    `json:"server_address" yaml:"server_address"`
+	TLS           TLSConfig `json:"tls" yaml:"tls"` // Optional HTTPS and client certificate authentication
+
+	// gRPC server address; empty disables the gRPC API. It shares the TLS
+	// configuration and authentication of the HTTP server.
+	GRPCAddress string `json:"grpc_address" yaml:"grpc_address"`
 
 	// JWT configuration
-	JWTSecret string `json:"jwt_secret" yaml:"jwt_secret"`
+	JWTSecret         string   `json:"jwt_secret" yaml:"jwt_secret"` // Verifies tokens without a kid header
+	JWTKeys           []JWTKey `json:"jwt_keys" yaml:"jwt_keys"`
+	JWTRevocationFile string   `json:"jwt_revocation_file" yaml:"jwt_revocation_file"`
 
 	// AMQP configuration
 	AMQPURI       string `json:"amqp_uri" yaml:"amqp_uri"`
@@ -35,8 +49,36 @@ type Config struct {
 	CaptureRetention string `json:"capture_retention" yaml:"capture_retention"` // Duration string
 	CaptureMaxSize   int64  `json:"capture_max_size" yaml:"capture_max_size"`   // Max bytes
 
-	// Rate limiting
-	MaxStreamHz int `json:"max_stream_hz" yaml:"max_stream_hz"`
+	// Session retention; expired sessions are archived to ArchiveDir first
+	ArchiveDir            string          `json:"archive_dir" yaml:"archive_dir"`             // Default: "archive" in CaptureDir
+	SessionRetention      string          `json:"session_retention" yaml:"session_retention"` // Duration string, empty keeps sessions forever
+	SessionRetentionRules []RetentionRule `json:"session_retention_rules" yaml:"session_retention_rules"`
+
+	// Ingest rate limits and quotas; zero disables a limit. MaxStreamHz also
+	// caps the frame rate of stream subscribers.
+	MaxStreamHz      int   `json:"max_stream_hz" yaml:"max_stream_hz"`
+	TokenMaxStreamHz int   `json:"token_max_stream_hz" yaml:"token_max_stream_hz"` // Ingest per token
+	IngestBurst      int   `json:"ingest_burst" yaml:"ingest_burst"`               // Frames accepted at once above the rate
+	DailyFrameQuota  int64 `json:"daily_frame_quota" yaml:"daily_frame_quota"`     // Frames per node per UTC day
+	DailyByteQuota   int64 `json:"daily_byte_quota" yaml:"daily_byte_quota"`       // Payload bytes per node per UTC day
+
+	// Frames between keyframes for stream subscribers on the binary delta
+	// protocol; zero uses the default of 60
+	StreamKeyframeInterval int `json:"stream_keyframe_interval" yaml:"stream_keyframe_interval"`
+
+	// What happens to live frames for stream subscribers whose send buffer
+	// is full: drop (default), coalesce or disconnect once behind for
+	// StreamSlowConsumerTimeout
+	StreamSlowConsumerPolicy  string `json:"stream_slow_consumer_policy" yaml:"stream_slow_consumer_policy"`
+	StreamSlowConsumerTimeout string `json:"stream_slow_consumer_timeout" yaml:"stream_slow_consumer_timeout"` // Duration string, default 10s
+
+	// Frame bus between API server instances, so that each serves every
+	// live match: empty for none, or "amqp" to use AMQPURI. InstanceID
+	// identifies this instance on the bus; it defaults to the host name
+	// with a random suffix.
+	StreamBus         string `json:"stream_bus" yaml:"stream_bus"`
+	StreamBusExchange string `json:"stream_bus_exchange" yaml:"stream_bus_exchange"`
+	InstanceID        string `json:"instance_id" yaml:"instance_id"`
 
 	// Metrics
 	MetricsAddr string `json:"metrics_addr" yaml:"metrics_addr"`
@@ -68,7 +110,19 @@ func DefaultConfig() *Config {
 
 	jwtSecret := os.Getenv("EVR_APISERVER_JWT_SECRET")
 
+	storageBackend := os.Getenv("EVR_APISERVER_STORAGE_BACKEND")
+	if storageBackend == "" {
+		storageBackend = store.BackendMongo
+	}
+
+	dataDir := os.Getenv("EVR_APISERVER_DATA_DIR")
+	if dataDir == "" {
+		dataDir = "./data"
+	}
+
 	return &Config{
+		StorageBackend:   storageBackend,
+		DataDir:          dataDir,
 		MongoURI:         mongoURI,
 		DatabaseName:     sessionEventDatabaseName,
 		CollectionName:   sessionEventCollectionName,
@@ -89,24 +143,58 @@ func DefaultConfig() *Config {
 
 // Validate validates the configuration
 func (c *Config) Validate() error {
-	if c.MongoURI == "" {
-		return fmt.Errorf("mongo_uri is required")
-	}
-	if c.DatabaseName == "" {
-		return fmt.Errorf("database_name is required")
-	}
-	if c.CollectionName == "" {
-		return fmt.Errorf("collection_name is required")
+	switch c.StorageBackend {
+	case "", store.BackendMongo:
+		if c.MongoURI == "" {
+			return fmt.Errorf("mongo_uri is required")
+		}
+		if c.DatabaseName == "" {
+			return fmt.Errorf("database_name is required")
+		}
+		if c.CollectionName == "" {
+			return fmt.Errorf("collection_name is required")
+		}
+	case store.BackendEmbedded:
+		if c.DataDir == "" {
+			return fmt.Errorf("data_dir is required for the embedded storage backend")
+		}
+	default:
+		return fmt.Errorf("unknown storage_backend %q", c.StorageBackend)
 	}
 	if c.ServerAddress == "" {
 		return fmt.Errorf("server_address is required")
 	}
-	if c.JWTSecret == "" {
-		return fmt.Errorf("jwt_secret is required")
+	if c.JWTSecret == "" && len(c.JWTKeys) == 0 {
+		return fmt.Errorf("jwt_secret or jwt_keys is required")
+	}
+	if _, err := LoadSigningKeys(c.JWTKeys); err != nil {
+		return err
+	}
+	if err := c.TLS.Validate(); err != nil {
+		return err
 	}
 	if c.AMQPEnabled && c.AMQPURI == "" {
 		return fmt.Errorf("amqp_uri is required when AMQP is enabled")
 	}
+	policy, err := NewRetentionPolicy(c.SessionRetention, c.SessionRetentionRules)
+	if err != nil {
+		return err
+	}
+	if policy.Enabled() && c.CaptureDir == "" {
+		return fmt.Errorf("capture_dir is required to archive expired sessions")
+	}
+	if _, _, err := ParseSlowConsumerPolicy(c.StreamSlowConsumerPolicy, c.StreamSlowConsumerTimeout); err != nil {
+		return err
+	}
+	switch c.StreamBus {
+	case "":
+	case StreamBusAMQP:
+		if c.AMQPURI == "" {
+			return fmt.Errorf("amqp_uri is required for the amqp stream bus")
+		}
+	default:
+		return fmt.Errorf("unknown stream_bus %q", c.StreamBus)
+	}
 	return nil
 }
 
@@ -114,8 +202,15 @@ func (c *Config) Validate() error {
 type Service struct {
 	config        *Config
 	mongoClient   *mongo.Client
+	repository    store.Repository
 	server        *Server
 	amqpPublisher *amqp.Publisher
+	streamHub     *StreamHub
+	storage       *StorageManager
+	retention     *RetentionManager
+	metrics       *Metrics
+	metricsServer *http.Server
+	grpcServer    *GRPCServer
 	logger        Logger
 }
 
@@ -139,18 +234,19 @@ func NewService(config *Config, logger Logger) (*Service, error) {
 	}, nil
 }
 
-// Initialize initializes the service (connects to MongoDB, creates indexes, etc.)
+// Initialize initializes the service (connects to storage, creates indexes, etc.)
 func (s *Service) Initialize(ctx context.Context) error {
-	// Connect to MongoDB
-	mongoClient, err := s.connectMongoDB(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to connect to MongoDB: %w", err)
-	}
-	s.mongoClient = mongoClient
-
-	// Create indexes
-	if err := s.createIndexes(ctx); err != nil {
-		return fmt.Errorf("failed to create indexes: %w", err)
+	if s.config.StorageBackend == store.BackendEmbedded {
+		repository, err := store.NewEmbeddedRepository(s.config.DataDir)
+		if err != nil {
+			return fmt.Errorf("failed to open embedded storage: %w", err)
+		}
+		s.repository = repository
+		s.logger.Info("Using embedded storage", "data_dir", s.config.DataDir)
+	} else {
+		if err := s.initializeMongoDB(ctx); err != nil {
+			return err
+		}
 	}
 
 	// Initialize AMQP publisher if enabled
@@ -172,17 +268,144 @@ func (s *Service) Initialize(ctx context.Context) error {
 	}
 
 	// Create HTTP server
-	s.server = NewServer(s.mongoClient, s.logger, s.config.JWTSecret)
+	s.server = NewServer(s.repository, s.logger, s.config.JWTSecret)
+
+	// Export Prometheus metrics if an address is configured
+	if s.config.MetricsAddr != "" {
+		s.metrics = NewMetrics("")
+		s.server.SetMetrics(s.metrics)
+	}
+
+	// Enforce ingest rate limits and quotas
+	s.server.SetIngestLimiter(NewIngestLimiter(IngestLimits{
+		NodeHz:      float64(s.config.MaxStreamHz),
+		TokenHz:     float64(s.config.TokenMaxStreamHz),
+		Burst:       float64(s.config.IngestBurst),
+		DailyFrames: s.config.DailyFrameQuota,
+		DailyBytes:  s.config.DailyByteQuota,
+	}, s.metrics))
+
+	// Accept tokens signed with the configured keys and reject revoked ones
+	keys, err := LoadSigningKeys(s.config.JWTKeys)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		s.server.auth.AddKey(key)
+	}
+	if s.config.JWTRevocationFile != "" {
+		revoked, err := NewRevocationList(s.config.JWTRevocationFile)
+		if err != nil {
+			return err
+		}
+		s.server.auth.SetRevocationList(revoked)
+	}
+
+	// Serve HTTPS, reloading certificates when their files change
+	if s.config.TLS.Enabled() {
+		tlsConfig, err := NewServerTLSConfig(s.config.TLS, s.logger)
+		if err != nil {
+			return fmt.Errorf("failed to configure TLS: %w", err)
+		}
+		s.server.SetTLSConfig(tlsConfig, s.config.TLS)
+		s.logger.Info("TLS enabled", "cert_file", s.config.TLS.CertFile, "client_ca_file", s.config.TLS.ClientCAFile)
+	}
 
 	// Set the AMQP publisher on the server if available
 	if s.amqpPublisher != nil {
 		s.server.SetAMQPPublisher(s.amqpPublisher)
 	}
 
+	// Write ingested frames to capture files if a capture directory is configured
+	if s.config.CaptureDir != "" {
+		retention, err := time.ParseDuration(s.config.CaptureRetention)
+		if err != nil {
+			return fmt.Errorf("invalid capture_retention: %w", err)
+		}
+
+		storage, err := NewStorageManager(s.config.CaptureDir, retention, s.config.CaptureMaxSize, s.logger)
+		if err != nil {
+			return fmt.Errorf("failed to create capture storage: %w", err)
+		}
+		storage.SetArchiveDir(s.config.ArchiveDir)
+		s.storage = storage
+		s.server.SetStorageManager(storage)
+
+		// Archive and remove sessions past their retention
+		policy, err := NewRetentionPolicy(s.config.SessionRetention, s.config.SessionRetentionRules)
+		if err != nil {
+			return err
+		}
+		s.retention = NewRetentionManager(s.repository, storage, policy, s.logger)
+		s.server.SetRetentionManager(s.retention)
+	}
+
+	// Broadcast ingested frames to live stream subscribers
+	s.streamHub = NewStreamHub(s.storage, s.logger, s.metrics, s.config.MaxStreamHz, nil)
+	s.streamHub.SetKeyframeInterval(s.config.StreamKeyframeInterval)
+	slowPolicy, slowTimeout, err := ParseSlowConsumerPolicy(s.config.StreamSlowConsumerPolicy, s.config.StreamSlowConsumerTimeout)
+	if err != nil {
+		return err
+	}
+	s.streamHub.SetSlowConsumerPolicy(slowPolicy, slowTimeout)
+
+	// Share live frames with the other instances
+	if s.config.StreamBus == StreamBusAMQP {
+		bus := amqp.NewFrameBus(s.config.AMQPURI, s.config.StreamBusExchange, s.logger)
+		if err := bus.Connect(ctx); err != nil {
+			return fmt.Errorf("failed to connect stream bus: %w", err)
+		}
+		if err := s.streamHub.SetFrameBus(bus, s.instanceID()); err != nil {
+			bus.Close()
+			return fmt.Errorf("failed to subscribe to stream bus: %w", err)
+		}
+	}
+	s.server.SetStreamHub(s.streamHub)
+
+	// Serve the gRPC API from the same server, with its auth and storage
+	if s.config.GRPCAddress != "" {
+		s.grpcServer = NewGRPCServer(s.server)
+	}
+
 	s.logger.Info("Session events service initialized successfully")
 	return nil
 }
 
+// instanceID returns the ID of this instance on the stream bus
+func (s *Service) instanceID() string {
+	if s.config.InstanceID != "" {
+		return s.config.InstanceID
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		host = "apiserver"
+	}
+	return host + "-" + uuid.Must(uuid.NewV4()).String()[:8]
+}
+
+// initializeMongoDB connects to MongoDB, checks the schema version and creates indexes
+func (s *Service) initializeMongoDB(ctx context.Context) error {
+	mongoClient, err := s.connectMongoDB(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to MongoDB: %w", err)
+	}
+	s.mongoClient = mongoClient
+
+	// Refuse to run against a database with pending migrations
+	migrator := NewMigrator(s.mongoClient.Database(s.config.DatabaseName), s.logger)
+	if err := migrator.CheckSchema(ctx); err != nil {
+		return fmt.Errorf("failed to check database schema: %w", err)
+	}
+
+	// Create indexes
+	if err := s.createIndexes(ctx); err != nil {
+		return fmt.Errorf("failed to create indexes: %w", err)
+	}
+
+	s.repository = store.NewMongoRepository(s.mongoClient, s.config.DatabaseName)
+	return nil
+}
+
 // connectMongoDB establishes a connection to MongoDB
 func (s *Service) connectMongoDB(ctx context.Context) (*mongo.Client, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.config.MongoTimeout)
@@ -261,16 +484,128 @@ func (s *Service) createIndexes(ctx context.Context) error {
 		return fmt.Errorf("failed to create lobby_session_id+event_types+timestamp index: %w", err)
 	}
 
+	// Create indexes on the fields extracted from v4 frame documents
+	extractedIndexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "lobby_session_id", Value: 1},
+				{Key: "frame_index", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "match_type", Value: 1},
+				{Key: "game_status", Value: 1},
+				{Key: "timestamp", Value: 1},
+			},
+		},
+	}
+
+	_, err = collection.Indexes().CreateMany(ctx, extractedIndexes)
+	if err != nil {
+		return fmt.Errorf("failed to create frame field indexes: %w", err)
+	}
+
+	// Reject duplicate frames from retries and re-sent files. Frames stored
+	// before frame_key existed are left out of the index.
+	frameKeyIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "lobby_session_id", Value: 1},
+			{Key: "node_id", Value: 1},
+			{Key: "frame_key", Value: 1},
+		},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"frame_key": bson.M{"$exists": true}}),
+	}
+
+	_, err = collection.Indexes().CreateOne(ctx, frameKeyIndex)
+	if err != nil {
+		return fmt.Errorf("failed to create frame_key index: %w", err)
+	}
+
+	if err := s.createEventIndexes(ctx); err != nil {
+		return err
+	}
+
 	s.logger.Debug("Created database indexes")
 	return nil
 }
 
+// createEventIndexes creates the indexes for the typed events collection
+func (s *Service) createEventIndexes(ctx context.Context) error {
+	collection := s.mongoClient.Database(s.config.DatabaseName).Collection(store.EventsCollectionName)
+
+	indexes := []mongo.IndexModel{
+		// Created by migration 4 on existing databases, and here on new
+		// ones, which are recorded as migrated without running migrations
+		eventKeyIndex(),
+		{
+			Keys: bson.D{
+				{Key: "lobby_session_id", Value: 1},
+				{Key: "type", Value: 1},
+				{Key: "timestamp", Value: 1},
+			},
+		},
+		{
+			// Pages of a session's events of any type
+			Keys: bson.D{
+				{Key: "lobby_session_id", Value: 1},
+				{Key: "timestamp", Value: 1},
+				{Key: "event_index", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "type", Value: 1},
+				{Key: "timestamp", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "player_ids", Value: 1}},
+		},
+	}
+
+	if _, err := collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		return fmt.Errorf("failed to create %s indexes: %w", store.EventsCollectionName, err)
+	}
+	return nil
+}
+
 // Start starts the service
 func (s *Service) Start(ctx context.Context) error {
 	if s.server == nil {
 		return fmt.Errorf("service not initialized, call Initialize() first")
 	}
 
+	if s.storage != nil {
+		s.storage.Start(ctx)
+	}
+
+	if s.retention != nil && s.retention.policy.Enabled() {
+		s.retention.Start(ctx)
+	}
+
+	if s.metrics != nil {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", s.metrics.Handler())
+		s.metricsServer = &http.Server{Addr: s.config.MetricsAddr, Handler: mux}
+		go func() {
+			if err := s.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.logger.Error("Metrics server failed", "error", err)
+			}
+		}()
+		s.logger.Info("Serving metrics", "address", s.config.MetricsAddr)
+	}
+
+	if s.grpcServer != nil {
+		go func() {
+			if err := s.grpcServer.Serve(s.config.GRPCAddress); err != nil {
+				s.logger.Error("gRPC server failed", "error", err)
+			}
+		}()
+	}
+
 	s.logger.Info("Starting session events service", "address", s.config.ServerAddress)
 	return s.server.StartWithContext(ctx, s.config.ServerAddress)
 }
@@ -287,6 +622,41 @@ func (s *Service) Stop(ctx context.Context) error {
 		}
 	}
 
+	if s.streamHub != nil {
+		if err := s.streamHub.CloseFrameBus(); err != nil {
+			s.logger.Error("Failed to close stream bus", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	if s.metricsServer != nil {
+		if err := s.metricsServer.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if s.grpcServer != nil {
+		s.grpcServer.Stop(ctx)
+	}
+
+	// Stop expiring sessions before the capture store is closed
+	if s.retention != nil {
+		s.retention.Stop()
+	}
+
+	// Close capture files
+	if s.storage != nil {
+		s.storage.Stop()
+	}
+
+	// Close the repository
+	if s.repository != nil {
+		if err := s.repository.Close(ctx); err != nil {
+			s.logger.Error("Failed to close repository", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
 	// Disconnect MongoDB
 	if s.mongoClient != nil {
 		if err := s.mongoClient.Disconnect(ctx); err != nil {
@@ -313,7 +683,17 @@ func (s *Service) GetServer() *Server {
 	return s.server
 }
 
-// GetMongoClient returns the MongoDB client instance
+// GetRepository returns the session repository
+func (s *Service) GetRepository() store.Repository {
+	return s.repository
+}
+
+// GetStreamHub returns the live stream hub
+func (s *Service) GetStreamHub() *StreamHub {
+	return s.streamHub
+}
+
+// GetMongoClient returns the MongoDB client instance (nil with embedded storage)
 func (s *Service) GetMongoClient() *mongo.Client {
 	return s.mongoClient
 }
@@ -11,13 +11,15 @@ import (
 	"sync"
 	"time"
 
-	"github.com/echotools/nevr-capture/v3/pkg/codecs"
+	"github.com/echotools/nevr-agent/v4/internal/nevrcap"
 	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // StorageManager handles nevrcap file storage with retention and size limits
 type StorageManager struct {
 	dir           string
+	archiveDir    string // Archives of expired sessions, exempt from cleanup
 	retention     time.Duration
 	maxSize       int64
 	logger        Logger
@@ -31,7 +33,7 @@ type StorageManager struct {
 type matchWriter struct {
 	matchID   string
 	filePath  string
-	writer    *codecs.NevrCap
+	writer    *nevrcap.Writer
 	mu        sync.Mutex
 	createdAt time.Time
 	lastWrite time.Time
@@ -46,6 +48,7 @@ func NewStorageManager(dir string, retention time.Duration, maxSize int64, logge
 
 	sm := &StorageManager{
 		dir:           dir,
+		archiveDir:    filepath.Join(dir, defaultArchiveDir),
 		retention:     retention,
 		maxSize:       maxSize,
 		logger:        logger,
@@ -56,6 +59,19 @@ func NewStorageManager(dir string, retention time.Duration, maxSize int64, logge
 	return sm, nil
 }
 
+// defaultArchiveDir is the directory for archives within the capture
+// directory, unless SetArchiveDir chooses another
+const defaultArchiveDir = "archive"
+
+// SetArchiveDir sets the directory archives of expired sessions are written
+// to. Archives are the only copy of their sessions, so they are never removed
+// by the retention and size limits of capture files.
+func (sm *StorageManager) SetArchiveDir(dir string) {
+	if dir != "" {
+		sm.archiveDir = filepath.Clean(dir)
+	}
+}
+
 // Start begins the cleanup routine
 func (sm *StorageManager) Start(ctx context.Context) {
 	sm.cleanupTicker = time.NewTicker(5 * time.Minute)
@@ -105,10 +121,10 @@ func (sm *StorageManager) WriteFrame(matchID string, frame *telemetry.LobbySessi
 		filename := fmt.Sprintf("%s_%s.nevrcap", time.Now().Format("2006-01-02_15-04-05"), matchID)
 		filePath := filepath.Join(sm.dir, filename)
 
-		writer, err := codecs.NewNevrCapWriter(filePath)
+		writer, err := newMatchCapture(filePath, matchID)
 		if err != nil {
 			sm.mu.Unlock()
-			return fmt.Errorf("failed to create nevrcap writer: %w", err)
+			return err
 		}
 
 		w = &matchWriter{
@@ -139,6 +155,28 @@ func (sm *StorageManager) WriteFrame(matchID string, frame *telemetry.LobbySessi
 	return nil
 }
 
+// newMatchCapture creates a nevrcap file for a match and writes its header.
+// The file is written in blocks, with a seek index written beside it on close.
+func newMatchCapture(filePath, matchID string) (*nevrcap.Writer, error) {
+	writer, err := nevrcap.Create(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create nevrcap writer: %w", err)
+	}
+
+	header := &telemetry.TelemetryHeader{
+		CaptureId: matchID,
+		CreatedAt: timestamppb.Now(),
+		Metadata: map[string]string{
+			"format": "nevrcap",
+		},
+	}
+	if err := writer.WriteHeader(header); err != nil {
+		writer.Close()
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	return writer, nil
+}
+
 // CloseMatch closes the writer for a specific match
 func (sm *StorageManager) CloseMatch(matchID string) error {
 	sm.mu.Lock()
@@ -153,6 +191,80 @@ func (sm *StorageManager) CloseMatch(matchID string) error {
 	return w.Close()
 }
 
+// ArchiveMatch writes a complete capture file for a match to the archive
+// directory from the frames returned by next, which returns a nil frame once
+// all frames are read. Once the archive is written, commit is called: if it
+// succeeds, earlier capture files and archives of the match are replaced by
+// the archive, otherwise the archive is removed and they are kept.
+func (sm *StorageManager) ArchiveMatch(matchID string, next func() (*telemetry.LobbySessionStateFrame, error), commit func() error) (string, error) {
+	if !sm.IsMatchComplete(matchID) {
+		return "", fmt.Errorf("match %s is still in progress", matchID)
+	}
+
+	previous, err := sm.matchFiles(matchID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(sm.archiveDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create archive directory: %w", err)
+	}
+	filePath := filepath.Join(sm.archiveDir, fmt.Sprintf("%s_%s.nevrcap", time.Now().Format("2006-01-02_15-04-05"), matchID))
+
+	// Write under a temporary name so cleanup and downloads never see a partial archive
+	tmpPath := filePath + ".partial"
+	writer, err := newMatchCapture(tmpPath, matchID)
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		frame, err := next()
+		if err == nil && frame == nil {
+			break
+		}
+		if err == nil {
+			err = writer.WriteFrame(frame)
+		}
+		if err != nil {
+			writer.Close()
+			removeCapture(tmpPath)
+			return "", fmt.Errorf("failed to write archive: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		removeCapture(tmpPath)
+		return "", fmt.Errorf("failed to close archive: %w", err)
+	}
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		removeCapture(tmpPath)
+		return "", fmt.Errorf("failed to finalize archive: %w", err)
+	}
+	if err := os.Rename(nevrcap.IndexPath(tmpPath), nevrcap.IndexPath(filePath)); err != nil {
+		sm.logger.Warn("failed to move archive index", "path", filePath, "error", err)
+	}
+
+	if err := commit(); err != nil {
+		if rmErr := removeCapture(filePath); rmErr != nil {
+			sm.logger.Warn("failed to remove discarded archive", "path", filePath, "error", rmErr)
+		}
+		return "", err
+	}
+
+	for _, path := range previous {
+		if path == filePath {
+			continue
+		}
+		if err := removeCapture(path); err != nil {
+			sm.logger.Warn("failed to remove superseded capture file", "path", path, "error", err)
+		}
+	}
+
+	sm.logger.Info("archived match", "match_id", matchID, "path", filePath)
+	return filePath, nil
+}
+
 // GetMatchFile returns the file path for a completed match
 func (sm *StorageManager) GetMatchFile(matchID string) (string, error) {
 	// First check active writers
@@ -164,10 +276,9 @@ func (sm *StorageManager) GetMatchFile(matchID string) (string, error) {
 	sm.mu.RUnlock()
 
 	// Search for existing file
-	pattern := filepath.Join(sm.dir, fmt.Sprintf("*_%s.nevrcap", matchID))
-	matches, err := filepath.Glob(pattern)
+	matches, err := sm.matchFiles(matchID)
 	if err != nil {
-		return "", fmt.Errorf("failed to search for match file: %w", err)
+		return "", err
 	}
 
 	if len(matches) == 0 {
@@ -177,6 +288,70 @@ func (sm *StorageManager) GetMatchFile(matchID string) (string, error) {
 	return matches[0], nil
 }
 
+// matchFiles returns the capture files of a match, then its archives
+func (sm *StorageManager) matchFiles(matchID string) ([]string, error) {
+	var files []string
+	for _, dir := range []string{sm.dir, sm.archiveDir} {
+		matches, err := filepath.Glob(filepath.Join(dir, fmt.Sprintf("*_%s.nevrcap", matchID)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to search for match file: %w", err)
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+// GetCaptureFile returns the capture file of a match, including one that is
+// still being written. The frames last written to an active file may not be
+// readable yet, since they are compressed in blocks.
+func (sm *StorageManager) GetCaptureFile(matchID string) (path string, inProgress bool, err error) {
+	sm.mu.RLock()
+	w, exists := sm.activeWriters[matchID]
+	sm.mu.RUnlock()
+	if exists {
+		return w.filePath, true, nil
+	}
+
+	path, err = sm.GetMatchFile(matchID)
+	return path, false, err
+}
+
+// CaptureIndex returns the capture file of a match with its seek index. The
+// index of an active file covers the blocks written so far. A nil index is
+// returned for files written without one, or changed since they were indexed.
+func (sm *StorageManager) CaptureIndex(matchID string) (string, *nevrcap.Index, error) {
+	sm.mu.RLock()
+	w, exists := sm.activeWriters[matchID]
+	sm.mu.RUnlock()
+	if exists {
+		return w.filePath, w.writer.Index(), nil
+	}
+
+	path, err := sm.GetMatchFile(matchID)
+	if err != nil {
+		return "", nil, err
+	}
+	index, err := nevrcap.LoadIndex(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			sm.logger.Debug("ignoring capture index", "path", path, "error", err)
+		}
+		return path, nil, nil
+	}
+	return path, index, nil
+}
+
+// removeCapture removes a capture file, its seek index and a lock file left
+// by a writer that did not exit cleanly
+func removeCapture(path string) error {
+	for _, sidecar := range []string{nevrcap.IndexPath(path), nevrcap.LockPath(path)} {
+		if err := os.Remove(sidecar); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Remove(path)
+}
+
 // IsMatchComplete checks if a match capture is complete (not actively being written)
 func (sm *StorageManager) IsMatchComplete(matchID string) bool {
 	sm.mu.RLock()
@@ -212,7 +387,7 @@ func (sm *StorageManager) cleanup() {
 	// Delete files older than retention period
 	for _, f := range files {
 		if now.Sub(f.modTime) > sm.retention {
-			if err := os.Remove(f.path); err != nil {
+			if err := removeCapture(f.path); err != nil {
 				sm.logger.Error("failed to delete old file", "path", f.path, "error", err)
 			} else {
 				sm.logger.Info("deleted old capture file", "path", f.path, "age", now.Sub(f.modTime))
@@ -256,7 +431,7 @@ func (sm *StorageManager) cleanup() {
 				continue
 			}
 
-			if err := os.Remove(f.path); err != nil {
+			if err := removeCapture(f.path); err != nil {
 				sm.logger.Error("failed to delete file for size limit", "path", f.path, "error", err)
 			} else {
 				sm.logger.Info("deleted capture file for size limit", "path", f.path, "size", f.size)
@@ -286,6 +461,10 @@ func (sm *StorageManager) getFiles() ([]fileInfo, error) {
 		}
 
 		if d.IsDir() {
+			if path == sm.archiveDir {
+				// Archives are kept regardless of capture limits
+				return filepath.SkipDir
+			}
 			return nil
 		}
 
@@ -0,0 +1,619 @@
+package store
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// embeddedFileExt is the extension of the per-session frame files
+const embeddedFileExt = ".frames"
+
+// maxCachedSessions bounds the session indexes kept in memory. Beyond it, the
+// least recently used index that is not in use is dropped, to be loaded again
+// from its file when the session is next accessed.
+const maxCachedSessions = 1024
+
+// EmbeddedRepository is a Repository that keeps each session in an append-only
+// file of BSON frame documents, for deployments without MongoDB.
+//
+// A session's file is indexed in memory the first time the session is
+// accessed; only the document metadata is read, frames are decoded on demand.
+// Up to maxCachedSessions indexes are kept.
+type EmbeddedRepository struct {
+	dir      string
+	mu       sync.Mutex
+	sessions map[string]*embeddedSession
+}
+
+// embeddedSession is the in-memory index of one session file
+type embeddedSession struct {
+	// Guarded by the repository's mu: the calls using the index, which
+	// keep it cached, and when it was last used
+	refs     int
+	lastUsed time.Time
+
+	mu      sync.RWMutex
+	path    string
+	size    int64
+	records []embeddedRecord    // Ordered by timestamp
+	keys    map[string]struct{} // Node and frame key of every record
+}
+
+// embeddedRecord locates a frame document within a session file
+type embeddedRecord struct {
+	offset     int64
+	length     int32
+	timestamp  time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+	eventTypes []string
+	frameKey   string
+
+	nodeID       string
+	matchType    string
+	privateMatch bool
+}
+
+// NewEmbeddedRepository creates a repository storing session files in dir
+func NewEmbeddedRepository(dir string) (*EmbeddedRepository, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+	return &EmbeddedRepository{
+		dir:      dir,
+		sessions: make(map[string]*embeddedSession),
+	}, nil
+}
+
+// session returns the index for a session, loading it from disk if needed.
+// The index stays cached until it is passed to release.
+func (r *EmbeddedRepository) session(lobbySessionID string) (*embeddedSession, error) {
+	if !ValidSessionID(lobbySessionID) {
+		return nil, ErrInvalidSessionID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.sessions[lobbySessionID]
+	if !ok {
+		s = &embeddedSession{path: filepath.Join(r.dir, lobbySessionID+embeddedFileExt)}
+		if err := s.load(); err != nil {
+			return nil, err
+		}
+		r.evict()
+		r.sessions[lobbySessionID] = s
+	}
+	s.refs++
+	s.lastUsed = time.Now()
+	return s, nil
+}
+
+// release ends a use of an index returned by session
+func (r *EmbeddedRepository) release(s *embeddedSession) {
+	r.mu.Lock()
+	s.refs--
+	r.mu.Unlock()
+}
+
+// evict drops least recently used indexes that are not in use until there is
+// room for one more. Indexes in use are never dropped, so that each session
+// file is only ever written through one index. The caller must hold r.mu.
+func (r *EmbeddedRepository) evict() {
+	for len(r.sessions) >= maxCachedSessions {
+		var oldest string
+		for id, s := range r.sessions {
+			if s.refs == 0 && (oldest == "" || s.lastUsed.Before(r.sessions[oldest].lastUsed)) {
+				oldest = id
+			}
+		}
+		if oldest == "" {
+			return
+		}
+		delete(r.sessions, oldest)
+	}
+}
+
+// StoreFrame implements Repository
+func (r *EmbeddedRepository) StoreFrame(ctx context.Context, doc *SessionFrameDocument) error {
+	s, err := r.session(doc.LobbySessionID)
+	if err != nil {
+		return err
+	}
+	defer r.release(s)
+
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode session frame: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.keys[recordKey(doc.NodeID, doc.FrameKey)]; ok && doc.FrameKey != "" {
+		return ErrDuplicateFrame
+	}
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		// Drop a partly written document, which would corrupt the file
+		if truncErr := f.Truncate(s.size); truncErr != nil {
+			return fmt.Errorf("failed to write session frame: %w (truncating: %v)", err, truncErr)
+		}
+		return fmt.Errorf("failed to write session frame: %w", err)
+	}
+
+	s.insert(indexRecord(bson.Raw(data), s.size))
+	s.size += int64(len(data))
+	return nil
+}
+
+// Frames implements Repository
+func (r *EmbeddedRepository) Frames(ctx context.Context, lobbySessionID string) ([]*SessionFrameDocument, error) {
+	frames, _, err := r.FramesPage(ctx, lobbySessionID, "", 0, 0)
+	return frames, err
+}
+
+// FramesPage implements Repository. A limit of zero returns all frames.
+func (r *EmbeddedRepository) FramesPage(ctx context.Context, lobbySessionID, eventType string, limit, offset int64) ([]*SessionFrameDocument, int64, error) {
+	s, err := r.session(lobbySessionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer r.release(s)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []embeddedRecord
+	for _, rec := range s.records {
+		if eventType == "" || contains(rec.eventTypes, eventType) {
+			matched = append(matched, rec)
+		}
+	}
+	total := int64(len(matched))
+
+	if offset >= total {
+		return []*SessionFrameDocument{}, total, nil
+	}
+	matched = matched[offset:]
+	if limit > 0 && int64(len(matched)) > limit {
+		matched = matched[:limit]
+	}
+
+	frames, err := s.read(ctx, matched)
+	if err != nil {
+		return nil, 0, err
+	}
+	return frames, total, nil
+}
+
+// EventFrames implements Repository
+func (r *EmbeddedRepository) EventFrames(ctx context.Context, lobbySessionID string, eventTypes []string) ([]*SessionFrameDocument, error) {
+	s, err := r.session(lobbySessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer r.release(s)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []embeddedRecord
+	for _, rec := range s.records {
+		if len(rec.eventTypes) == 0 {
+			continue
+		}
+		if len(eventTypes) == 0 || slices.ContainsFunc(eventTypes, func(t string) bool { return contains(rec.eventTypes, t) }) {
+			matched = append(matched, rec)
+		}
+	}
+	return s.read(ctx, matched)
+}
+
+// EventsPage implements Repository. A limit of zero returns all events. Only
+// the frames carrying the page are decoded, found by the event types indexed
+// with each record.
+func (r *EmbeddedRepository) EventsPage(ctx context.Context, lobbySessionID string, eventTypes []string, limit, offset int64) ([]*FrameEvent, error) {
+	indexed, err := FrameEventTypes(eventTypes)
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.session(lobbySessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer r.release(s)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var (
+		matched []embeddedRecord
+		skip    = offset // Wanted events of the matched records before the page
+		count   int64    // Wanted events of the matched records
+	)
+	for _, rec := range s.records {
+		if limit > 0 && count-skip >= limit {
+			break
+		}
+		var n int64
+		for _, t := range rec.eventTypes {
+			if len(indexed) == 0 || contains(indexed, t) {
+				n++
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		if len(matched) == 0 && skip >= n {
+			skip -= n
+			continue
+		}
+		matched = append(matched, rec)
+		count += n
+	}
+
+	frames, err := s.read(ctx, matched)
+	if err != nil {
+		return nil, err
+	}
+
+	page := []*FrameEvent{}
+	for _, frame := range frames {
+		for _, evt := range EventsFromDocument(frame) {
+			if len(eventTypes) > 0 && !contains(eventTypes, evt.Type) {
+				continue
+			}
+			if skip > 0 {
+				skip--
+				continue
+			}
+			if limit > 0 && int64(len(page)) >= limit {
+				return page, nil
+			}
+			page = append(page, &FrameEvent{Event: evt, Frame: frame})
+		}
+	}
+	return page, nil
+}
+
+// LatestFrame implements Repository
+func (r *EmbeddedRepository) LatestFrame(ctx context.Context, lobbySessionID string) (*SessionFrameDocument, error) {
+	s, err := r.session(lobbySessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer r.release(s)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.records) == 0 {
+		return nil, nil
+	}
+	frames, err := s.read(ctx, s.records[len(s.records)-1:])
+	if err != nil {
+		return nil, err
+	}
+	return frames[0], nil
+}
+
+// read decodes the frame documents of the given records. The caller must hold s.mu.
+func (s *embeddedSession) read(ctx context.Context, records []embeddedRecord) ([]*SessionFrameDocument, error) {
+	frames := make([]*SessionFrameDocument, 0, len(records))
+	if len(records) == 0 {
+		return frames, nil
+	}
+
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer f.Close()
+
+	for _, rec := range records {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data := make([]byte, rec.length)
+		if _, err := f.ReadAt(data, rec.offset); err != nil {
+			return nil, fmt.Errorf("failed to read session frame: %w", err)
+		}
+
+		doc := &SessionFrameDocument{}
+		if err := bson.Unmarshal(data, doc); err != nil {
+			return nil, fmt.Errorf("failed to decode session frame: %w", err)
+		}
+		frames = append(frames, doc)
+	}
+	return frames, nil
+}
+
+// Session implements Repository
+func (r *EmbeddedRepository) Session(ctx context.Context, lobbySessionID string) (*SessionSummary, error) {
+	s, err := r.session(lobbySessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer r.release(s)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.records) == 0 {
+		return nil, nil
+	}
+
+	first, last := s.records[0], s.records[len(s.records)-1]
+	return &SessionSummary{
+		LobbySessionID: lobbySessionID,
+		FrameCount:     int64(len(s.records)),
+		FirstTimestamp: first.timestamp,
+		LastTimestamp:  last.timestamp,
+		CreatedAt:      first.createdAt,
+		UpdatedAt:      last.updatedAt,
+		NodeID:         last.nodeID,
+		MatchType:      last.matchType,
+		PrivateMatch:   last.privateMatch,
+	}, nil
+}
+
+// Sessions implements Repository. Every session file is indexed on the first call.
+func (r *EmbeddedRepository) Sessions(ctx context.Context, updatedBefore time.Time) ([]*SessionSummary, error) {
+	summaries, err := r.summaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*SessionSummary
+	for _, summary := range summaries {
+		if summary.UpdatedAt.Before(updatedBefore) {
+			sessions = append(sessions, summary)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].UpdatedAt.Before(sessions[j].UpdatedAt)
+	})
+	return sessions, nil
+}
+
+// RecentSessions implements Repository
+func (r *EmbeddedRepository) RecentSessions(ctx context.Context, limit int64) ([]*SessionSummary, error) {
+	sessions, err := r.summaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
+	})
+	if limit > 0 && int64(len(sessions)) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions, nil
+}
+
+// summaries returns the summaries of all sessions in the data directory
+func (r *EmbeddedRepository) summaries(ctx context.Context) ([]*SessionSummary, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list data directory: %w", err)
+	}
+
+	var sessions []*SessionSummary
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != embeddedFileExt {
+			continue
+		}
+
+		summary, err := r.Session(ctx, strings.TrimSuffix(name, embeddedFileExt))
+		if errors.Is(err, ErrInvalidSessionID) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if summary != nil {
+			sessions = append(sessions, summary)
+		}
+	}
+	return sessions, nil
+}
+
+// DeleteSession implements Repository
+func (r *EmbeddedRepository) DeleteSession(ctx context.Context, lobbySessionID string) (int64, error) {
+	s, err := r.session(lobbySessionID)
+	if err != nil {
+		return 0, err
+	}
+	defer r.release(s)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("failed to delete session file: %w", err)
+	}
+
+	deleted := int64(len(s.records))
+	s.records = nil
+	s.keys = nil
+	s.size = 0
+	delete(r.sessions, lobbySessionID)
+	return deleted, nil
+}
+
+// Ping implements Repository
+func (r *EmbeddedRepository) Ping(ctx context.Context) error {
+	if _, err := os.Stat(r.dir); err != nil {
+		return fmt.Errorf("data directory unavailable: %w", err)
+	}
+	return nil
+}
+
+// Close implements Repository
+func (r *EmbeddedRepository) Close(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions = make(map[string]*embeddedSession)
+	return nil
+}
+
+// load indexes the session file. A truncated trailing document, left by a
+// crash during a write, is cut off so that later appends stay readable.
+func (s *embeddedSession) load() error {
+	f, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("failed to stat session file: %w", err)
+	}
+
+	reader := bufio.NewReader(f)
+	var offset int64
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(reader, header[:]); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return s.truncate(offset)
+		}
+
+		// A length past the end of the file is from a document that was
+		// not written whole, or is garbage; it is not allocated
+		length := int32(binary.LittleEndian.Uint32(header[:]))
+		if length < 5 || int64(length) > info.Size()-offset {
+			return s.truncate(offset)
+		}
+
+		data := make([]byte, length)
+		copy(data, header[:])
+		if _, err := io.ReadFull(reader, data[4:]); err != nil {
+			return s.truncate(offset)
+		}
+
+		s.insert(indexRecord(bson.Raw(data), offset))
+		offset += int64(length)
+	}
+
+	s.size = offset
+	return nil
+}
+
+// truncate cuts the session file at offset
+func (s *embeddedSession) truncate(offset int64) error {
+	if err := os.Truncate(s.path, offset); err != nil {
+		return fmt.Errorf("failed to truncate damaged session file: %w", err)
+	}
+	s.size = offset
+	return nil
+}
+
+// insert adds a record, keeping the records ordered by timestamp
+func (s *embeddedSession) insert(rec embeddedRecord) {
+	if rec.frameKey != "" {
+		if s.keys == nil {
+			s.keys = make(map[string]struct{})
+		}
+		s.keys[recordKey(rec.nodeID, rec.frameKey)] = struct{}{}
+	}
+
+	i := sort.Search(len(s.records), func(i int) bool {
+		return s.records[i].timestamp.After(rec.timestamp)
+	})
+	if i == len(s.records) {
+		s.records = append(s.records, rec)
+		return
+	}
+	s.records = append(s.records, embeddedRecord{})
+	copy(s.records[i+1:], s.records[i:])
+	s.records[i] = rec
+}
+
+// recordKey is the deduplication key of a record within its session
+func recordKey(nodeID, frameKey string) string {
+	return nodeID + "\x00" + frameKey
+}
+
+// indexRecord reads the metadata of a stored document without decoding its frame
+func indexRecord(doc bson.Raw, offset int64) embeddedRecord {
+	rec := embeddedRecord{
+		offset: offset,
+		length: int32(len(doc)),
+	}
+	if t, ok := doc.Lookup("timestamp").TimeOK(); ok {
+		rec.timestamp = t
+	}
+	if t, ok := doc.Lookup("created_at").TimeOK(); ok {
+		rec.createdAt = t
+	}
+	if t, ok := doc.Lookup("updated_at").TimeOK(); ok {
+		rec.updatedAt = t
+	}
+	if nodeID, ok := doc.Lookup("node_id").StringValueOK(); ok {
+		rec.nodeID = nodeID
+	}
+	if frameKey, ok := doc.Lookup("frame_key").StringValueOK(); ok {
+		rec.frameKey = frameKey
+	}
+	if matchType, ok := doc.Lookup("match_type").StringValueOK(); ok {
+		rec.matchType = matchType
+	}
+	if private, ok := doc.Lookup("private_match").BooleanOK(); ok {
+		rec.privateMatch = private
+	}
+	if arr, ok := doc.Lookup("event_types").ArrayOK(); ok {
+		values, _ := arr.Values()
+		for _, value := range values {
+			if eventType, ok := value.StringValueOK(); ok {
+				rec.eventTypes = append(rec.eventTypes, eventType)
+			}
+		}
+	}
+	return rec
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,232 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEmbeddedRepository(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sessionID := "0f4a4a2c-5d2b-4f0e-9c41-2a6f0d3f9a11"
+
+	repo, err := NewEmbeddedRepository(dir)
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+
+	start := time.Now().UTC().Truncate(time.Millisecond)
+	var stored []*telemetry.LobbySessionStateFrame
+	for i := 0; i < 5; i++ {
+		frame := stunFrame()
+		frame.FrameIndex = uint32(i)
+		frame.Timestamp = timestamppb.New(start.Add(time.Duration(i) * time.Second))
+		if i%2 == 1 {
+			frame.Events = nil
+		}
+
+		doc, err := NewSessionFrameDocument(sessionID, "node1", "", frame)
+		if err != nil {
+			t.Fatalf("NewSessionFrameDocument() error = %v", err)
+		}
+		if err := repo.StoreFrame(ctx, doc); err != nil {
+			t.Fatalf("StoreFrame() error = %v", err)
+		}
+		stored = append(stored, frame)
+	}
+
+	// Reopen to make sure the index is rebuilt from disk
+	repo, err = NewEmbeddedRepository(dir)
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+
+	frames, err := repo.Frames(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("Frames() error = %v", err)
+	}
+	if len(frames) != len(stored) {
+		t.Fatalf("Frames() returned %d frames, want %d", len(frames), len(stored))
+	}
+	for i, doc := range frames {
+		if !proto.Equal(doc.Frame, stored[i]) {
+			t.Errorf("frame %d does not match stored frame", i)
+		}
+	}
+
+	page, total, err := repo.FramesPage(ctx, sessionID, "*telemetry.LobbySessionEvent_PlayerStun", 2, 1)
+	if err != nil {
+		t.Fatalf("FramesPage() error = %v", err)
+	}
+	if total != 3 || len(page) != 2 || page[0].FrameIndex != 2 {
+		t.Errorf("FramesPage() = %d frames (total %d), want 2 frames starting at index 2 (total 3)", len(page), total)
+	}
+
+	events, err := repo.EventsPage(ctx, sessionID, []string{"player_stun"}, 1, 1)
+	if err != nil {
+		t.Fatalf("EventsPage() error = %v", err)
+	}
+	if len(events) != 1 || events[0].Event.FrameIndex != 2 || events[0].Frame == nil || events[0].Frame.FrameIndex != 2 {
+		t.Errorf("EventsPage() = %+v, want the event of frame 2 with its frame", events)
+	}
+	if events, err := repo.EventsPage(ctx, sessionID, []string{"goal_scored"}, 10, 0); err != nil || len(events) != 0 {
+		t.Errorf("EventsPage() for an absent type = %d events, %v, want none", len(events), err)
+	}
+
+	latest, err := repo.LatestFrame(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("LatestFrame() error = %v", err)
+	}
+	if latest == nil || latest.FrameIndex != 4 {
+		t.Errorf("LatestFrame() = %+v, want frame 4", latest)
+	}
+
+	summary, err := repo.Session(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("Session() error = %v", err)
+	}
+	if summary == nil || summary.FrameCount != 5 || !summary.FirstTimestamp.Equal(start) {
+		t.Errorf("Session() = %+v, want 5 frames starting at %v", summary, start)
+	}
+
+	if summary, err := repo.Session(ctx, "unknown-session"); err != nil || summary != nil {
+		t.Errorf("Session() for unknown session = %v, %v, want nil, nil", summary, err)
+	}
+
+	other, err := NewSessionFrameDocument("1b2c3d4e-0000-4000-8000-000000000000", "node1", "", stunFrame())
+	if err != nil {
+		t.Fatalf("NewSessionFrameDocument() error = %v", err)
+	}
+	other.UpdatedAt = other.UpdatedAt.Add(time.Second)
+	if err := repo.StoreFrame(ctx, other); err != nil {
+		t.Fatalf("StoreFrame() error = %v", err)
+	}
+	recent, err := repo.RecentSessions(ctx, 1)
+	if err != nil {
+		t.Fatalf("RecentSessions() error = %v", err)
+	}
+	if len(recent) != 1 || recent[0].LobbySessionID != other.LobbySessionID {
+		t.Errorf("RecentSessions() = %+v, want only %s", recent, other.LobbySessionID)
+	}
+
+	if _, err := repo.Frames(ctx, "../escape"); err != ErrInvalidSessionID {
+		t.Errorf("Frames() with invalid session ID error = %v, want %v", err, ErrInvalidSessionID)
+	}
+}
+
+func TestEmbeddedRepository_RestartedFrameIndexes(t *testing.T) {
+	ctx := context.Background()
+	sessionID := "0f4a4a2c-5d2b-4f0e-9c41-2a6f0d3f9a11"
+	repo, err := NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+
+	start := time.Now().UTC().Truncate(time.Millisecond)
+	store := func(index uint32, at time.Duration) error {
+		frame := stunFrame()
+		frame.FrameIndex = index
+		frame.Timestamp = timestamppb.New(start.Add(at))
+		doc, err := NewSessionFrameDocument(sessionID, "node1", "", frame)
+		if err != nil {
+			t.Fatalf("NewSessionFrameDocument() error = %v", err)
+		}
+		return repo.StoreFrame(ctx, doc)
+	}
+
+	// The agent restarts after frame 3 and numbers its frames from 1 again
+	for i, index := range []uint32{1, 2, 3, 1, 2, 3} {
+		if err := store(index, time.Duration(i)*time.Second); err != nil {
+			t.Fatalf("StoreFrame() of frame %d after %ds error = %v", index, i, err)
+		}
+	}
+	if err := store(2, 4*time.Second); err != ErrDuplicateFrame {
+		t.Errorf("StoreFrame() of a resent frame error = %v, want %v", err, ErrDuplicateFrame)
+	}
+
+	frames, err := repo.Frames(ctx, sessionID)
+	if err != nil || len(frames) != 6 {
+		t.Errorf("Frames() = %d frames, %v, want 6", len(frames), err)
+	}
+}
+
+func TestEmbeddedRepository_DamagedLength(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	sessionID := "0f4a4a2c-5d2b-4f0e-9c41-2a6f0d3f9a11"
+	repo, err := NewEmbeddedRepository(dir)
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	doc, err := NewSessionFrameDocument(sessionID, "node1", "", stunFrame())
+	if err != nil {
+		t.Fatalf("NewSessionFrameDocument() error = %v", err)
+	}
+	if err := repo.StoreFrame(ctx, doc); err != nil {
+		t.Fatalf("StoreFrame() error = %v", err)
+	}
+
+	// A length prefix claiming a gigabyte must not be allocated
+	path := filepath.Join(dir, sessionID+embeddedFileExt)
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 0, 0x40, 1, 2, 3})
+	f.Close()
+
+	repo, err = NewEmbeddedRepository(dir)
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	if frames, err := repo.Frames(ctx, sessionID); err != nil || len(frames) != 1 {
+		t.Fatalf("Frames() = %d frames, %v, want 1", len(frames), err)
+	}
+	if after, err := os.Stat(path); err != nil || after.Size() != info.Size() {
+		t.Errorf("file size after loading = %d, want %d", after.Size(), info.Size())
+	}
+}
+
+func TestEmbeddedRepository_SessionCache(t *testing.T) {
+	repo, err := NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+
+	// The least recently used index is in use, so the next one is dropped
+	now := time.Now()
+	for i := 0; i < maxCachedSessions; i++ {
+		repo.sessions[fmt.Sprintf("session-%d", i)] = &embeddedSession{lastUsed: now.Add(time.Duration(i) * time.Second)}
+	}
+	repo.sessions["session-0"].refs = 1
+
+	s, err := repo.session("new-session")
+	if err != nil {
+		t.Fatalf("session() error = %v", err)
+	}
+	repo.release(s)
+
+	if len(repo.sessions) != maxCachedSessions {
+		t.Errorf("%d cached sessions, want %d", len(repo.sessions), maxCachedSessions)
+	}
+	for _, id := range []string{"session-0", "new-session"} {
+		if _, ok := repo.sessions[id]; !ok {
+			t.Errorf("%s was dropped from the cache", id)
+		}
+	}
+	if _, ok := repo.sessions["session-1"]; ok {
+		t.Error("session-1 was not dropped from the cache")
+	}
+}
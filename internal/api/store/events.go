@@ -0,0 +1,380 @@
+// Package store holds the MongoDB document types and helpers shared by the
+// REST API and the GraphQL resolvers.
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// DatabaseName is the database holding all session data
+	DatabaseName = "nakama"
+
+	// FramesCollectionName is the collection holding raw session frames
+	FramesCollectionName = "session_events"
+
+	// EventsCollectionName is the collection holding one document per detected event
+	EventsCollectionName = "lobby_events"
+)
+
+// Team identifiers used in event documents
+const (
+	TeamBlue      = "blue"
+	TeamOrange    = "orange"
+	TeamSpectator = "spectator"
+)
+
+// EventDocument represents a single LobbySessionEvent stored in MongoDB
+type EventDocument struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	LobbySessionID string             `bson:"lobby_session_id" json:"lobby_session_id"`
+	NodeID         string             `bson:"node_id,omitempty" json:"node_id,omitempty"`     // Node that sent the frame
+	FrameKey       string             `bson:"frame_key,omitempty" json:"frame_key,omitempty"` // FrameKey of the frame, see SessionFrameDocument
+	FrameIndex     uint32             `bson:"frame_index" json:"frame_index"`
+	EventIndex     int                `bson:"event_index" json:"event_index"` // Position of the event within its frame
+	Timestamp      time.Time          `bson:"timestamp" json:"timestamp"`
+	Type           string             `bson:"type" json:"type"` // Oneof field name, e.g. "goal_scored"
+	PlayerSlots    []int32            `bson:"player_slots,omitempty" json:"player_slots,omitempty"`
+	PlayerIDs      []string           `bson:"player_ids,omitempty" json:"player_ids,omitempty"` // Account numbers resolved from the frame
+	TeamIDs        []string           `bson:"team_ids,omitempty" json:"team_ids,omitempty"`
+	Fields         map[string]any     `bson:"fields,omitempty" json:"fields,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at" json:"created_at"`
+}
+
+var eventFieldMarshaler = protojson.MarshalOptions{
+	UseProtoNames:  true,
+	UseEnumNumbers: false,
+}
+
+// EventType returns the oneof field name of the event payload (e.g. "player_stun"),
+// or an empty string if the event has no payload.
+func EventType(evt *telemetry.LobbySessionEvent) string {
+	if evt == nil || evt.Event == nil {
+		return ""
+	}
+	m := evt.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("event"))
+	if fd == nil {
+		return ""
+	}
+	return string(fd.Name())
+}
+
+// ErrUnknownEventType is returned for event type names that are not a
+// LobbySessionEvent variant
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// frameEventTypes maps event type names to the names indexed in the
+// event_types field of frame documents
+var frameEventTypes = func() map[string]string {
+	fields := (&telemetry.LobbySessionEvent{}).ProtoReflect().Descriptor().Oneofs().ByName("event").Fields()
+	names := make(map[string]string, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		m := (&telemetry.LobbySessionEvent{}).ProtoReflect()
+		m.Set(fd, m.NewField(fd))
+		names[string(fd.Name())] = fmt.Sprintf("%T", m.Interface().(*telemetry.LobbySessionEvent).Event)
+	}
+	return names
+}()
+
+// FrameEventTypes converts event type names (e.g. "goal_scored") to the names
+// indexed in frame documents, for use with Repository.EventFrames
+func FrameEventTypes(eventTypes []string) ([]string, error) {
+	indexed := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		name, ok := frameEventTypes[t]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownEventType, t)
+		}
+		indexed = append(indexed, name)
+	}
+	return indexed, nil
+}
+
+// EventsFromFrame builds one EventDocument per event carried by the frame.
+// Player and team involvement is resolved against the frame's session state.
+func EventsFromFrame(lobbySessionID string, frame *telemetry.LobbySessionStateFrame) []*EventDocument {
+	if frame == nil || len(frame.GetEvents()) == 0 {
+		return nil
+	}
+
+	var timestamp time.Time
+	if frame.GetTimestamp() != nil {
+		timestamp = frame.GetTimestamp().AsTime()
+	}
+
+	roster := newRoster(frame.GetSession())
+	now := time.Now().UTC()
+
+	docs := make([]*EventDocument, 0, len(frame.GetEvents()))
+	for i, evt := range frame.GetEvents() {
+		eventType := EventType(evt)
+		if eventType == "" {
+			continue
+		}
+
+		doc := &EventDocument{
+			LobbySessionID: lobbySessionID,
+			FrameIndex:     frame.GetFrameIndex(),
+			EventIndex:     i,
+			Timestamp:      timestamp,
+			Type:           eventType,
+			CreatedAt:      now,
+		}
+
+		payload := eventPayload(evt)
+		if payload != nil {
+			if data, err := eventFieldMarshaler.Marshal(payload.Interface()); err == nil {
+				var fields map[string]any
+				if err := json.Unmarshal(data, &fields); err == nil && len(fields) > 0 {
+					doc.Fields = fields
+				}
+			}
+		}
+
+		collectInvolvement(doc, evt, roster)
+		docs = append(docs, doc)
+	}
+
+	return docs
+}
+
+// EventsFromDocument builds the event documents of a stored frame, keyed
+// like the frame by session, node and frame key.
+func EventsFromDocument(frame *SessionFrameDocument) []*EventDocument {
+	docs := EventsFromFrame(frame.LobbySessionID, frame.Frame)
+	for _, doc := range docs {
+		doc.NodeID = frame.NodeID
+		doc.FrameKey = frame.FrameKey
+	}
+	return docs
+}
+
+// InsertEvents stores the given event documents in the events collection.
+// Events that are already stored are skipped.
+func InsertEvents(ctx context.Context, db *mongo.Database, docs []*EventDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	models := make([]any, 0, len(docs))
+	for _, doc := range docs {
+		if doc.ID.IsZero() {
+			doc.ID = primitive.NewObjectID()
+		}
+		models = append(models, doc)
+	}
+
+	opts := options.InsertMany().SetOrdered(false)
+	if _, err := db.Collection(EventsCollectionName).InsertMany(ctx, models, opts); err != nil && !onlyDuplicateKeys(err) {
+		return fmt.Errorf("failed to insert session events: %w", err)
+	}
+	return nil
+}
+
+// onlyDuplicateKeys reports whether every write of a bulk insert that
+// failed was rejected by a unique index
+func onlyDuplicateKeys(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil || len(bulkErr.WriteErrors) == 0 {
+		return false
+	}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr.WriteError) {
+			return false
+		}
+	}
+	return true
+}
+
+// UpsertEvents stores the given event documents, skipping events that were
+// already stored for the same session, node, frame and position. Events of
+// frames stored before frame_key existed are matched by frame index.
+func UpsertEvents(ctx context.Context, db *mongo.Database, docs []*EventDocument) (int64, error) {
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(docs))
+	for _, doc := range docs {
+		filter := bson.M{
+			"lobby_session_id": doc.LobbySessionID,
+			"frame_index":      doc.FrameIndex,
+			"event_index":      doc.EventIndex,
+		}
+		if doc.FrameKey != "" {
+			filter = bson.M{
+				"lobby_session_id": doc.LobbySessionID,
+				"node_id":          doc.NodeID,
+				"frame_key":        doc.FrameKey,
+				"event_index":      doc.EventIndex,
+			}
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(filter).
+			SetUpdate(bson.M{"$setOnInsert": doc}).
+			SetUpsert(true))
+	}
+
+	result, err := db.Collection(EventsCollectionName).BulkWrite(ctx, models)
+	if err != nil {
+		return 0, fmt.Errorf("failed to upsert session events: %w", err)
+	}
+	return result.UpsertedCount, nil
+}
+
+// eventPayload returns the message set in the event's oneof
+func eventPayload(evt *telemetry.LobbySessionEvent) protoreflect.Message {
+	m := evt.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("event"))
+	if fd == nil || fd.Message() == nil {
+		return nil
+	}
+	return m.Get(fd).Message()
+}
+
+// collectInvolvement fills the player and team fields of an event document
+func collectInvolvement(doc *EventDocument, evt *telemetry.LobbySessionEvent, r roster) {
+	addSlot := func(slot int32) {
+		if slot < 0 {
+			return
+		}
+		for _, s := range doc.PlayerSlots {
+			if s == slot {
+				return
+			}
+		}
+		doc.PlayerSlots = append(doc.PlayerSlots, slot)
+		if member, team := r.bySlot(slot); member != nil {
+			if member.GetAccountNumber() != 0 {
+				doc.PlayerIDs = append(doc.PlayerIDs, strconv.FormatUint(member.GetAccountNumber(), 10))
+			}
+			addTeam(doc, team)
+		}
+	}
+
+	switch e := evt.Event.(type) {
+	case *telemetry.LobbySessionEvent_RoundEnded:
+		addTeam(doc, TeamFromRole(e.RoundEnded.GetWinningTeam()))
+	case *telemetry.LobbySessionEvent_MatchEnded:
+		addTeam(doc, TeamFromRole(e.MatchEnded.GetWinningTeam()))
+	case *telemetry.LobbySessionEvent_PlayerJoined:
+		addSlot(e.PlayerJoined.GetPlayer().GetSlotNumber())
+		addTeam(doc, TeamFromRole(e.PlayerJoined.GetRole()))
+	case *telemetry.LobbySessionEvent_PlayerSwitchedTeam:
+		addSlot(e.PlayerSwitchedTeam.GetPlayerSlot())
+		addTeam(doc, TeamFromRole(e.PlayerSwitchedTeam.GetPrevRole()))
+		addTeam(doc, TeamFromRole(e.PlayerSwitchedTeam.GetNewRole()))
+	case *telemetry.LobbySessionEvent_DiscPossessionChanged:
+		addSlot(e.DiscPossessionChanged.GetPlayerSlot())
+		addSlot(e.DiscPossessionChanged.GetPreviousPlayerSlot())
+	case *telemetry.LobbySessionEvent_GoalScored:
+		score := e.GoalScored.GetScoreDetails()
+		if slot, ok := r.slotByName(score.GetPersonScored()); ok {
+			addSlot(slot)
+		}
+		if slot, ok := r.slotByName(score.GetAssistScored()); ok {
+			addSlot(slot)
+		}
+		addTeam(doc, strings.ToLower(score.GetTeam()))
+	default:
+		// Most player events carry a single player_slot field
+		if payload := eventPayload(evt); payload != nil {
+			if fd := payload.Descriptor().Fields().ByName("player_slot"); fd != nil {
+				addSlot(int32(payload.Get(fd).Int()))
+			}
+		}
+	}
+}
+
+func addTeam(doc *EventDocument, team string) {
+	if team == "" {
+		return
+	}
+	for _, t := range doc.TeamIDs {
+		if t == team {
+			return
+		}
+	}
+	doc.TeamIDs = append(doc.TeamIDs, team)
+}
+
+// TeamFromRole converts a telemetry role to a team identifier
+func TeamFromRole(role telemetry.Role) string {
+	switch role {
+	case telemetry.Role_ROLE_BLUE_TEAM:
+		return TeamBlue
+	case telemetry.Role_ROLE_ORANGE_TEAM:
+		return TeamOrange
+	case telemetry.Role_ROLE_SPECTATOR:
+		return TeamSpectator
+	default:
+		return ""
+	}
+}
+
+// TeamFromIndex converts the index of a team in SessionResponse.Teams to a team identifier
+func TeamFromIndex(i int) string {
+	switch i {
+	case 0:
+		return TeamBlue
+	case 1:
+		return TeamOrange
+	case 2:
+		return TeamSpectator
+	default:
+		return ""
+	}
+}
+
+// roster indexes the players of a session by slot
+type roster struct {
+	members map[int32]*apigame.TeamMember
+	teams   map[int32]string
+}
+
+func newRoster(session *apigame.SessionResponse) roster {
+	r := roster{
+		members: make(map[int32]*apigame.TeamMember),
+		teams:   make(map[int32]string),
+	}
+	for i, team := range session.GetTeams() {
+		for _, member := range team.GetPlayers() {
+			r.members[member.GetSlotNumber()] = member
+			r.teams[member.GetSlotNumber()] = TeamFromIndex(i)
+		}
+	}
+	return r
+}
+
+func (r roster) bySlot(slot int32) (*apigame.TeamMember, string) {
+	return r.members[slot], r.teams[slot]
+}
+
+func (r roster) slotByName(name string) (int32, bool) {
+	if name == "" {
+		return 0, false
+	}
+	for slot, member := range r.members {
+		if member.GetDisplayName() == name {
+			return slot, true
+		}
+	}
+	return 0, false
+}
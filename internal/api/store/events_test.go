@@ -0,0 +1,152 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testFrame(events ...*telemetry.LobbySessionEvent) *telemetry.LobbySessionStateFrame {
+	return &telemetry.LobbySessionStateFrame{
+		FrameIndex: 42,
+		Timestamp:  timestamppb.Now(),
+		Events:     events,
+		Session: &apigame.SessionResponse{
+			Teams: []*apigame.Team{
+				{Players: []*apigame.TeamMember{{SlotNumber: 0, AccountNumber: 1001, DisplayName: "blue0"}}},
+				{Players: []*apigame.TeamMember{{SlotNumber: 5, AccountNumber: 2005, DisplayName: "orange5"}}},
+			},
+		},
+	}
+}
+
+func TestEventsFromFrame(t *testing.T) {
+	tests := []struct {
+		name      string
+		event     *telemetry.LobbySessionEvent
+		wantType  string
+		wantSlots []int32
+		wantIDs   []string
+		wantTeams []string
+	}{
+		{
+			name: "player stun",
+			event: &telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_PlayerStun{
+				PlayerStun: &telemetry.PlayerStun{PlayerSlot: 5, TotalStuns: 3},
+			}},
+			wantType:  "player_stun",
+			wantSlots: []int32{5},
+			wantIDs:   []string{"2005"},
+			wantTeams: []string{TeamOrange},
+		},
+		{
+			name: "possession change from free disc",
+			event: &telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_DiscPossessionChanged{
+				DiscPossessionChanged: &telemetry.DiscPossessionChanged{PlayerSlot: 0, PreviousPlayerSlot: -1},
+			}},
+			wantType:  "disc_possession_changed",
+			wantSlots: []int32{0},
+			wantIDs:   []string{"1001"},
+			wantTeams: []string{TeamBlue},
+		},
+		{
+			name: "goal scored",
+			event: &telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_GoalScored{
+				GoalScored: &telemetry.GoalScored{ScoreDetails: &apigame.LastScore{
+					Team:         "blue",
+					PersonScored: "blue0",
+				}},
+			}},
+			wantType:  "goal_scored",
+			wantSlots: []int32{0},
+			wantIDs:   []string{"1001"},
+			wantTeams: []string{TeamBlue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docs := EventsFromFrame("session-1", testFrame(tt.event))
+			if len(docs) != 1 {
+				t.Fatalf("EventsFromFrame() returned %d documents, want 1", len(docs))
+			}
+
+			doc := docs[0]
+			if doc.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", doc.Type, tt.wantType)
+			}
+			if doc.LobbySessionID != "session-1" || doc.FrameIndex != 42 {
+				t.Errorf("unexpected document position: %s/%d", doc.LobbySessionID, doc.FrameIndex)
+			}
+			if !equalSlices(doc.PlayerSlots, tt.wantSlots) {
+				t.Errorf("PlayerSlots = %v, want %v", doc.PlayerSlots, tt.wantSlots)
+			}
+			if !equalSlices(doc.PlayerIDs, tt.wantIDs) {
+				t.Errorf("PlayerIDs = %v, want %v", doc.PlayerIDs, tt.wantIDs)
+			}
+			if !equalSlices(doc.TeamIDs, tt.wantTeams) {
+				t.Errorf("TeamIDs = %v, want %v", doc.TeamIDs, tt.wantTeams)
+			}
+		})
+	}
+}
+
+func TestEventsFromFrame_NoEvents(t *testing.T) {
+	if docs := EventsFromFrame("session-1", testFrame()); docs != nil {
+		t.Errorf("EventsFromFrame() = %v, want nil", docs)
+	}
+}
+
+func TestEventsFromDocument(t *testing.T) {
+	stun := &telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_PlayerStun{PlayerStun: &telemetry.PlayerStun{}}}
+	frame, err := NewSessionFrameDocument("session-1", "node-1", "", testFrame(stun))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs := EventsFromDocument(frame)
+	if len(docs) != 1 {
+		t.Fatalf("EventsFromDocument() returned %d documents, want 1", len(docs))
+	}
+	if docs[0].NodeID != "node-1" || docs[0].FrameKey != frame.FrameKey || frame.FrameKey == "" {
+		t.Errorf("event key = %q/%q, want node-1/%q", docs[0].NodeID, docs[0].FrameKey, frame.FrameKey)
+	}
+}
+
+func TestOnlyDuplicateKeys(t *testing.T) {
+	writeErr := func(code int) mongo.BulkWriteError {
+		return mongo.BulkWriteError{WriteError: mongo.WriteError{Code: code}}
+	}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"duplicates", mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{writeErr(11000), writeErr(11000)}}, true},
+		{"other write error", mongo.BulkWriteException{WriteErrors: []mongo.BulkWriteError{writeErr(11000), writeErr(121)}}, false},
+		{"not a write error", errors.New("connection reset"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := onlyDuplicateKeys(tt.err); got != tt.want {
+				t.Errorf("onlyDuplicateKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/klauspost/compress/zstd"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FrameSchemaVersion is the schema version written by SessionFrameDocument.
+// Version 4 documents store the frame as zstd-compressed protobuf bytes in
+// frame_data; older documents store it as protojson or as an embedded document
+// under frame.
+const FrameSchemaVersion = 4
+
+var (
+	frameEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	frameDecoder, _ = zstd.NewReader(nil)
+)
+
+// SessionFrameDocument represents a LobbySessionStateFrame stored in MongoDB.
+//
+// The frame itself is not mapped to a BSON field: MarshalBSON encodes it into
+// frame_data and fills the extracted index fields, and UnmarshalBSON decodes it
+// from any of the stored schema versions.
+type SessionFrameDocument struct {
+	ID             primitive.ObjectID                `bson:"_id,omitempty"`
+	SchemaVersion  int                               `bson:"schema_version,omitempty"`
+	LobbySessionID string                            `bson:"lobby_session_id"`
+	NodeID         string                            `bson:"node_id,omitempty"`
+	UserID         string                            `bson:"user_id,omitempty"`
+	Frame          *telemetry.LobbySessionStateFrame `bson:"-"`
+	FrameKey       string                            `bson:"frame_key,omitempty"`   // Unique per session and node, see FrameKey
+	EventTypes     []string                          `bson:"event_types,omitempty"` // For indexing/querying
+
+	// Fields extracted from the frame for indexing
+	FrameIndex   uint32 `bson:"frame_index"`
+	GameStatus   string `bson:"game_status,omitempty"`
+	MatchType    string `bson:"match_type,omitempty"`
+	MapName      string `bson:"map_name,omitempty"`
+	PrivateMatch bool   `bson:"private_match"`
+
+	Timestamp time.Time `bson:"timestamp"`
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// frameDocumentFields has the same layout as SessionFrameDocument without its
+// BSON methods, so it can be (un)marshalled by the default struct codec.
+type frameDocumentFields SessionFrameDocument
+
+// MarshalBSON implements bson.Marshaler
+func (d *SessionFrameDocument) MarshalBSON() ([]byte, error) {
+	out := struct {
+		Fields    frameDocumentFields `bson:",inline"`
+		FrameData []byte              `bson:"frame_data,omitempty"`
+	}{
+		Fields: frameDocumentFields(*d),
+	}
+
+	if d.Frame != nil {
+		data, err := EncodeFrame(d.Frame)
+		if err != nil {
+			return nil, err
+		}
+		out.FrameData = data
+		out.Fields.SchemaVersion = FrameSchemaVersion
+
+		session := d.Frame.GetSession()
+		out.Fields.FrameIndex = d.Frame.GetFrameIndex()
+		out.Fields.GameStatus = session.GetGameStatus()
+		out.Fields.MatchType = session.GetMatchType()
+		out.Fields.MapName = session.GetMapName()
+		out.Fields.PrivateMatch = session.GetPrivateMatch()
+	}
+
+	return bson.Marshal(out)
+}
+
+// UnmarshalBSON implements bson.Unmarshaler
+func (d *SessionFrameDocument) UnmarshalBSON(data []byte) error {
+	var in struct {
+		Fields      frameDocumentFields `bson:",inline"`
+		FrameData   []byte              `bson:"frame_data"`
+		LegacyFrame bson.RawValue       `bson:"frame"`
+	}
+	if err := bson.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	*d = SessionFrameDocument(in.Fields)
+
+	switch {
+	case len(in.FrameData) > 0:
+		frame, err := DecodeFrame(in.FrameData)
+		if err != nil {
+			return err
+		}
+		d.Frame = frame
+	case in.LegacyFrame.Type == bsontype.String:
+		frame := &telemetry.LobbySessionStateFrame{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(in.LegacyFrame.StringValue()), frame); err != nil {
+			return fmt.Errorf("failed to decode legacy frame: %w", err)
+		}
+		d.Frame = frame
+	case in.LegacyFrame.Type == bsontype.EmbeddedDocument:
+		frame, err := decodeEmbeddedFrame(in.LegacyFrame.Document())
+		if err != nil {
+			return err
+		}
+		d.Frame = frame
+	}
+
+	return nil
+}
+
+// EncodeFrame serializes a frame to zstd-compressed protobuf bytes
+func EncodeFrame(frame *telemetry.LobbySessionStateFrame) ([]byte, error) {
+	data, err := proto.Marshal(frame)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal frame: %w", err)
+	}
+	return frameEncoder.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+}
+
+// DecodeFrame deserializes a frame produced by EncodeFrame
+func DecodeFrame(data []byte) (*telemetry.LobbySessionStateFrame, error) {
+	raw, err := frameDecoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress frame: %w", err)
+	}
+	frame := &telemetry.LobbySessionStateFrame{}
+	if err := proto.Unmarshal(raw, frame); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal frame: %w", err)
+	}
+	return frame, nil
+}
+
+// decodeEmbeddedFrame decodes a frame that was written with the default BSON
+// struct codec, which stores fields under their lowercased Go names and
+// oneofs as a document keyed by the wrapper field.
+func decodeEmbeddedFrame(doc bson.Raw) (*telemetry.LobbySessionStateFrame, error) {
+	var m bson.M
+	if err := bson.Unmarshal(doc, &m); err != nil {
+		return nil, fmt.Errorf("failed to decode legacy frame: %w", err)
+	}
+
+	frame := &telemetry.LobbySessionStateFrame{}
+	data, err := json.Marshal(legacyMessageToJSON(frame.ProtoReflect().Descriptor(), m))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert legacy frame: %w", err)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, frame); err != nil {
+		return nil, fmt.Errorf("failed to decode legacy frame: %w", err)
+	}
+	return frame, nil
+}
+
+// legacyMessageToJSON rewrites a legacy embedded document into the protojson
+// shape of the given message.
+func legacyMessageToJSON(md protoreflect.MessageDescriptor, doc bson.M) bson.M {
+	out := bson.M{}
+	for key, value := range doc {
+		if oneof := md.Oneofs().ByName(protoreflect.Name(key)); oneof != nil {
+			// The oneof holds a single wrapper field named after the member
+			for member, inner := range toM(value) {
+				if fd := legacyField(oneof.Fields(), member); fd != nil {
+					out[fd.JSONName()] = legacyValueToJSON(fd, inner)
+				}
+			}
+			continue
+		}
+		if fd := legacyField(md.Fields(), key); fd != nil {
+			out[fd.JSONName()] = legacyValueToJSON(fd, value)
+		}
+	}
+	return out
+}
+
+func legacyValueToJSON(fd protoreflect.FieldDescriptor, value any) any {
+	if value == nil {
+		return nil
+	}
+	if fd.IsList() {
+		items, ok := value.(primitive.A)
+		if !ok {
+			return nil
+		}
+		out := make(primitive.A, 0, len(items))
+		for _, item := range items {
+			out = append(out, legacyScalarToJSON(fd, item))
+		}
+		return out
+	}
+	return legacyScalarToJSON(fd, value)
+}
+
+func legacyScalarToJSON(fd protoreflect.FieldDescriptor, value any) any {
+	if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+		return value
+	}
+	doc := toM(value)
+	if fd.Message().FullName() == "google.protobuf.Timestamp" {
+		// Well-known types use their own JSON representation
+		seconds, _ := toInt64(doc["seconds"])
+		nanos, _ := toInt64(doc["nanos"])
+		return time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano)
+	}
+	return legacyMessageToJSON(fd.Message(), doc)
+}
+
+// legacyField finds the field whose name matches a lowercased Go field name
+func legacyField(fields protoreflect.FieldDescriptors, key string) protoreflect.FieldDescriptor {
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if strings.ReplaceAll(string(fd.Name()), "_", "") == key {
+			return fd
+		}
+	}
+	return nil
+}
+
+func toM(value any) bson.M {
+	switch v := value.(type) {
+	case bson.M:
+		return v
+	case primitive.D:
+		return v.Map()
+	default:
+		return nil
+	}
+}
+
+func toInt64(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float64:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,95 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func stunFrame() *telemetry.LobbySessionStateFrame {
+	frame := testFrame(&telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_PlayerStun{
+		PlayerStun: &telemetry.PlayerStun{PlayerSlot: 5, TotalStuns: 3},
+	}})
+	frame.Session.MatchType = "Echo_Arena"
+	frame.Session.MapName = "mpl_arena_a"
+	frame.Session.PrivateMatch = true
+	return frame
+}
+
+func TestSessionFrameDocument_RoundTrip(t *testing.T) {
+	frame := stunFrame()
+	doc := &SessionFrameDocument{
+		ID:             primitive.NewObjectID(),
+		LobbySessionID: "session-1",
+		Frame:          frame,
+		Timestamp:      frame.GetTimestamp().AsTime(),
+	}
+
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatalf("bson.Marshal() error = %v", err)
+	}
+
+	raw := bson.Raw(data)
+	if _, err := raw.LookupErr("frame"); err == nil {
+		t.Errorf("v4 document should not contain a frame field")
+	}
+	if v := raw.Lookup("schema_version").Int32(); v != FrameSchemaVersion {
+		t.Errorf("schema_version = %d, want %d", v, FrameSchemaVersion)
+	}
+
+	var got SessionFrameDocument
+	if err := bson.Unmarshal(data, &got); err != nil {
+		t.Fatalf("bson.Unmarshal() error = %v", err)
+	}
+
+	if !proto.Equal(got.Frame, frame) {
+		t.Errorf("decoded frame does not match original")
+	}
+	if got.FrameIndex != 42 || got.MatchType != "Echo_Arena" || got.MapName != "mpl_arena_a" || !got.PrivateMatch {
+		t.Errorf("extracted fields not populated: %+v", got)
+	}
+}
+
+func TestSessionFrameDocument_LegacyFrames(t *testing.T) {
+	frame := stunFrame()
+	frameJSON, err := protojson.Marshal(frame)
+	if err != nil {
+		t.Fatalf("protojson.Marshal() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		frame any
+	}{
+		{name: "protojson string", frame: string(frameJSON)},
+		{name: "embedded document", frame: frame},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(bson.M{
+				"_id":              primitive.NewObjectID(),
+				"lobby_session_id": "session-1",
+				"frame":            tt.frame,
+				"timestamp":        time.Now().UTC(),
+			})
+			if err != nil {
+				t.Fatalf("bson.Marshal() error = %v", err)
+			}
+
+			var got SessionFrameDocument
+			if err := bson.Unmarshal(data, &got); err != nil {
+				t.Fatalf("bson.Unmarshal() error = %v", err)
+			}
+			if !proto.Equal(got.Frame, frame) {
+				t.Errorf("decoded frame = %v, want %v", got.Frame, frame)
+			}
+		})
+	}
+}
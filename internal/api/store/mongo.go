@@ -0,0 +1,403 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// queryTimeout bounds each repository call against MongoDB
+const queryTimeout = 10 * time.Second
+
+// scanTimeout bounds calls that aggregate over the whole frames collection
+const scanTimeout = 5 * time.Minute
+
+// MongoRepository is a Repository backed by MongoDB
+type MongoRepository struct {
+	client *mongo.Client
+	db     *mongo.Database
+}
+
+// NewMongoRepository creates a repository using the given database.
+// The client is owned by the caller and is not disconnected by Close.
+func NewMongoRepository(client *mongo.Client, databaseName string) *MongoRepository {
+	if databaseName == "" {
+		databaseName = DatabaseName
+	}
+	return &MongoRepository{
+		client: client,
+		db:     client.Database(databaseName),
+	}
+}
+
+// Database returns the underlying MongoDB database
+func (r *MongoRepository) Database() *mongo.Database {
+	return r.db
+}
+
+func (r *MongoRepository) frames() *mongo.Collection {
+	return r.db.Collection(FramesCollectionName)
+}
+
+// StoreFrame implements Repository
+func (r *MongoRepository) StoreFrame(ctx context.Context, doc *SessionFrameDocument) error {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	if _, err := r.frames().InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ErrDuplicateFrame
+		}
+		return fmt.Errorf("failed to insert session frame: %w", err)
+	}
+
+	// Store each detected event as its own document for event-level queries
+	if err := InsertEvents(ctx, r.db, EventsFromDocument(doc)); err != nil {
+		return fmt.Errorf("%w: %w", ErrEventsNotStored, err)
+	}
+	return nil
+}
+
+// Frames implements Repository
+func (r *MongoRepository) Frames(ctx context.Context, lobbySessionID string) ([]*SessionFrameDocument, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	filter := bson.M{"lobby_session_id": lobbySessionID}
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	cursor, err := r.frames().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session frames: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var frames []*SessionFrameDocument
+	if err := cursor.All(ctx, &frames); err != nil {
+		return nil, fmt.Errorf("failed to decode session frames: %w", err)
+	}
+	return frames, nil
+}
+
+// FramesPage implements Repository
+func (r *MongoRepository) FramesPage(ctx context.Context, lobbySessionID, eventType string, limit, offset int64) ([]*SessionFrameDocument, int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	filter := bson.M{"lobby_session_id": lobbySessionID}
+	if eventType != "" {
+		filter["event_types"] = eventType
+	}
+
+	totalCount, err := r.frames().CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count session frames: %w", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: 1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+
+	cursor, err := r.frames().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query session frames: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var frames []*SessionFrameDocument
+	if err := cursor.All(ctx, &frames); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode session frames: %w", err)
+	}
+	return frames, totalCount, nil
+}
+
+// EventFrames implements Repository
+func (r *MongoRepository) EventFrames(ctx context.Context, lobbySessionID string, eventTypes []string) ([]*SessionFrameDocument, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	filter := bson.M{"lobby_session_id": lobbySessionID}
+	if len(eventTypes) > 0 {
+		filter["event_types"] = bson.M{"$in": eventTypes}
+	} else {
+		filter["event_types.0"] = bson.M{"$exists": true}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}})
+
+	cursor, err := r.frames().Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session frames: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var frames []*SessionFrameDocument
+	if err := cursor.All(ctx, &frames); err != nil {
+		return nil, fmt.Errorf("failed to decode session frames: %w", err)
+	}
+	return frames, nil
+}
+
+// EventsPage implements Repository
+func (r *MongoRepository) EventsPage(ctx context.Context, lobbySessionID string, eventTypes []string, limit, offset int64) ([]*FrameEvent, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	filter := bson.M{"lobby_session_id": lobbySessionID}
+	if len(eventTypes) > 0 {
+		filter["type"] = bson.M{"$in": eventTypes}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "event_index", Value: 1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+
+	cursor, err := r.db.Collection(EventsCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session events: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []*EventDocument
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("failed to decode session events: %w", err)
+	}
+	if len(events) == 0 {
+		return []*FrameEvent{}, nil
+	}
+
+	// Load only the frames carrying the page: by frame key, or by timestamp
+	// and index for events stored before frame keys existed
+	carriers := make([]bson.M, 0, len(events))
+	seen := make(map[string]bool, len(events))
+	for _, evt := range events {
+		key := eventFrameKey(evt.NodeID, evt.FrameKey, evt.FrameIndex, evt.Timestamp)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if evt.FrameKey == "" {
+			carriers = append(carriers, bson.M{"timestamp": evt.Timestamp, "frame_index": evt.FrameIndex})
+			continue
+		}
+		var nodeID any = evt.NodeID
+		if evt.NodeID == "" {
+			nodeID = nil // Not stored for frames without a node
+		}
+		carriers = append(carriers, bson.M{"node_id": nodeID, "frame_key": evt.FrameKey})
+	}
+
+	cursor, err = r.frames().Find(ctx, bson.M{"lobby_session_id": lobbySessionID, "$or": carriers})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session frames: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var frames []*SessionFrameDocument
+	if err := cursor.All(ctx, &frames); err != nil {
+		return nil, fmt.Errorf("failed to decode session frames: %w", err)
+	}
+
+	byKey := make(map[string]*SessionFrameDocument, 2*len(frames))
+	for _, frame := range frames {
+		if frame.FrameKey != "" {
+			byKey[eventFrameKey(frame.NodeID, frame.FrameKey, 0, time.Time{})] = frame
+		}
+		byKey[eventFrameKey("", "", frame.FrameIndex, frame.Timestamp)] = frame
+	}
+
+	page := make([]*FrameEvent, 0, len(events))
+	for _, evt := range events {
+		page = append(page, &FrameEvent{
+			Event: evt,
+			Frame: byKey[eventFrameKey(evt.NodeID, evt.FrameKey, evt.FrameIndex, evt.Timestamp)],
+		})
+	}
+	return page, nil
+}
+
+// eventFrameKey identifies the frame carrying an event: by node and frame key
+// if it has one, else by frame index and timestamp
+func eventFrameKey(nodeID, frameKey string, frameIndex uint32, timestamp time.Time) string {
+	if frameKey != "" {
+		return recordKey(nodeID, frameKey)
+	}
+	return fmt.Sprintf("%d/%d", frameIndex, timestamp.UnixMilli())
+}
+
+// LatestFrame implements Repository
+func (r *MongoRepository) LatestFrame(ctx context.Context, lobbySessionID string) (*SessionFrameDocument, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: -1}})
+
+	var frame SessionFrameDocument
+	if err := r.frames().FindOne(ctx, bson.M{"lobby_session_id": lobbySessionID}, opts).Decode(&frame); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query latest session frame: %w", err)
+	}
+	return &frame, nil
+}
+
+// Session implements Repository
+func (r *MongoRepository) Session(ctx context.Context, lobbySessionID string) (*SessionSummary, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	filter := bson.M{"lobby_session_id": lobbySessionID}
+	count, err := r.frames().CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query lobby session: %w", err)
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	// Only the metadata is needed, not the frames themselves
+	projection := bson.M{
+		"timestamp":     1,
+		"created_at":    1,
+		"updated_at":    1,
+		"node_id":       1,
+		"match_type":    1,
+		"private_match": 1,
+	}
+
+	var first, last SessionFrameDocument
+	opts := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: 1}}).SetProjection(projection)
+	if err := r.frames().FindOne(ctx, filter, opts).Decode(&first); err != nil {
+		return nil, fmt.Errorf("failed to get first frame: %w", err)
+	}
+
+	opts = options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: -1}}).SetProjection(projection)
+	if err := r.frames().FindOne(ctx, filter, opts).Decode(&last); err != nil {
+		return nil, fmt.Errorf("failed to get last frame: %w", err)
+	}
+
+	return &SessionSummary{
+		LobbySessionID: lobbySessionID,
+		FrameCount:     count,
+		FirstTimestamp: first.Timestamp,
+		LastTimestamp:  last.Timestamp,
+		CreatedAt:      first.CreatedAt,
+		UpdatedAt:      last.UpdatedAt,
+		NodeID:         last.NodeID,
+		MatchType:      last.MatchType,
+		PrivateMatch:   last.PrivateMatch,
+	}, nil
+}
+
+// Sessions implements Repository
+func (r *MongoRepository) Sessions(ctx context.Context, updatedBefore time.Time) ([]*SessionSummary, error) {
+	return r.sessions(ctx, bson.M{"updated_at": bson.M{"$lt": updatedBefore}}, 1, 0)
+}
+
+// RecentSessions implements Repository
+func (r *MongoRepository) RecentSessions(ctx context.Context, limit int64) ([]*SessionSummary, error) {
+	return r.sessions(ctx, nil, -1, limit)
+}
+
+// sessions summarizes the sessions matching filter, which applies to the
+// summary fields, ordered by update time in the given direction and limited
+// to limit sessions if it is positive
+func (r *MongoRepository) sessions(ctx context.Context, filter bson.M, order int, limit int64) ([]*SessionSummary, error) {
+	ctx, cancel := context.WithTimeout(ctx, scanTimeout)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "lobby_session_id", Value: 1}, {Key: "timestamp", Value: 1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$lobby_session_id"},
+			{Key: "frame_count", Value: bson.M{"$sum": 1}},
+			{Key: "first_timestamp", Value: bson.M{"$first": "$timestamp"}},
+			{Key: "last_timestamp", Value: bson.M{"$last": "$timestamp"}},
+			{Key: "created_at", Value: bson.M{"$first": "$created_at"}},
+			{Key: "updated_at", Value: bson.M{"$last": "$updated_at"}},
+			{Key: "node_id", Value: bson.M{"$last": "$node_id"}},
+			{Key: "match_type", Value: bson.M{"$last": "$match_type"}},
+			{Key: "private_match", Value: bson.M{"$last": "$private_match"}},
+		}}},
+	}
+	if filter != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: filter}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: bson.D{{Key: "updated_at", Value: order}}}})
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: limit}})
+	}
+
+	cursor, err := r.frames().Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []struct {
+		LobbySessionID string    `bson:"_id"`
+		FrameCount     int64     `bson:"frame_count"`
+		FirstTimestamp time.Time `bson:"first_timestamp"`
+		LastTimestamp  time.Time `bson:"last_timestamp"`
+		CreatedAt      time.Time `bson:"created_at"`
+		UpdatedAt      time.Time `bson:"updated_at"`
+		NodeID         string    `bson:"node_id"`
+		MatchType      string    `bson:"match_type"`
+		PrivateMatch   bool      `bson:"private_match"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("failed to decode sessions: %w", err)
+	}
+
+	sessions := make([]*SessionSummary, 0, len(results))
+	for _, res := range results {
+		sessions = append(sessions, &SessionSummary{
+			LobbySessionID: res.LobbySessionID,
+			FrameCount:     res.FrameCount,
+			FirstTimestamp: res.FirstTimestamp,
+			LastTimestamp:  res.LastTimestamp,
+			CreatedAt:      res.CreatedAt,
+			UpdatedAt:      res.UpdatedAt,
+			NodeID:         res.NodeID,
+			MatchType:      res.MatchType,
+			PrivateMatch:   res.PrivateMatch,
+		})
+	}
+	return sessions, nil
+}
+
+// DeleteSession implements Repository
+func (r *MongoRepository) DeleteSession(ctx context.Context, lobbySessionID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	filter := bson.M{"lobby_session_id": lobbySessionID}
+
+	// Events go first so that a failure never leaves events without their frames
+	if _, err := r.db.Collection(EventsCollectionName).DeleteMany(ctx, filter); err != nil {
+		return 0, fmt.Errorf("failed to delete session events: %w", err)
+	}
+
+	result, err := r.frames().DeleteMany(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete session frames: %w", err)
+	}
+	return result.DeletedCount, nil
+}
+
+// Ping implements Repository
+func (r *MongoRepository) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx, nil)
+}
+
+// Close implements Repository
+func (r *MongoRepository) Close(ctx context.Context) error {
+	return nil
+}
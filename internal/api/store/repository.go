@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Storage backends selectable in the server configuration
+const (
+	BackendMongo    = "mongo"
+	BackendEmbedded = "embedded"
+)
+
+// ErrInvalidSessionID is returned for lobby session IDs that cannot be stored or queried
+var ErrInvalidSessionID = errors.New("invalid lobby session id")
+
+// ErrDuplicateFrame is returned by StoreFrame for a frame that is already
+// stored, identified by its session, node and FrameKey
+var ErrDuplicateFrame = errors.New("frame already stored")
+
+// ErrEventsNotStored is returned by StoreFrame when the frame was stored but
+// the events derived from it were not. The events backfill can rebuild them.
+var ErrEventsNotStored = errors.New("frame stored without its events")
+
+// Repository stores session frames and the events detected in them.
+// The REST API, the GraphQL resolvers and the WebSocket ingest all go
+// through a Repository, so the storage backend can be chosen by configuration.
+type Repository interface {
+	// StoreFrame stores a frame document and the typed events it carries. It
+	// returns ErrDuplicateFrame, storing nothing, if the session already has
+	// a frame from the same node with the same FrameKey, and
+	// ErrEventsNotStored if only the events failed.
+	//
+	// Only the MongoDB backend stores the typed events as documents of their
+	// own. The embedded backend stores the frame alone and derives its events
+	// from it when they are read, with EventsPage.
+	StoreFrame(ctx context.Context, doc *SessionFrameDocument) error
+
+	// Frames returns all frames of a session, ordered by timestamp
+	Frames(ctx context.Context, lobbySessionID string) ([]*SessionFrameDocument, error)
+
+	// FramesPage returns a page of a session's frames ordered by timestamp, and the
+	// total number of matching frames. If eventType is not empty, only frames
+	// carrying that event type are returned.
+	FramesPage(ctx context.Context, lobbySessionID, eventType string, limit, offset int64) ([]*SessionFrameDocument, int64, error)
+
+	// EventFrames returns the frames of a session carrying any of the given
+	// event types (indexed type names, see FrameEventTypes), or any event at
+	// all if none are given, ordered by timestamp
+	EventFrames(ctx context.Context, lobbySessionID string, eventTypes []string) ([]*SessionFrameDocument, error)
+
+	// EventsPage returns a page of a session's typed events ordered by
+	// timestamp and position within their frame, only those of the given
+	// event types (e.g. "goal_scored") if any are given, each with the frame
+	// carrying it
+	EventsPage(ctx context.Context, lobbySessionID string, eventTypes []string, limit, offset int64) ([]*FrameEvent, error)
+
+	// LatestFrame returns the last frame of a session by timestamp, or nil if
+	// no frames are stored for it
+	LatestFrame(ctx context.Context, lobbySessionID string) (*SessionFrameDocument, error)
+
+	// Session returns a summary of a session, or nil if no frames are stored for it
+	Session(ctx context.Context, lobbySessionID string) (*SessionSummary, error)
+
+	// Sessions returns summaries of the sessions whose last frame was stored
+	// before the given time, oldest first
+	Sessions(ctx context.Context, updatedBefore time.Time) ([]*SessionSummary, error)
+
+	// RecentSessions returns summaries of the limit sessions whose last frame
+	// was stored most recently, newest first
+	RecentSessions(ctx context.Context, limit int64) ([]*SessionSummary, error)
+
+	// DeleteSession removes all frames and events of a session and returns the
+	// number of frames deleted
+	DeleteSession(ctx context.Context, lobbySessionID string) (int64, error)
+
+	// Ping checks that the storage backend is reachable
+	Ping(ctx context.Context) error
+
+	// Close releases resources held by the repository
+	Close(ctx context.Context) error
+}
+
+// FrameEvent is a typed event with the frame that carries it
+type FrameEvent struct {
+	Event *EventDocument
+	Frame *SessionFrameDocument // Nil if the frame is no longer stored
+}
+
+// SessionSummary describes the frames stored for a session
+type SessionSummary struct {
+	LobbySessionID string
+	FrameCount     int64
+	FirstTimestamp time.Time
+	LastTimestamp  time.Time
+	CreatedAt      time.Time // Creation time of the first frame
+	UpdatedAt      time.Time // Update time of the last frame
+
+	// Taken from the last frame
+	NodeID       string
+	MatchType    string
+	PrivateMatch bool
+}
+
+// NewSessionFrameDocument builds the document stored for an ingested frame.
+// The frame timestamp is set to the current time if it is missing.
+func NewSessionFrameDocument(lobbySessionID, nodeID, userID string, frame *telemetry.LobbySessionStateFrame) (*SessionFrameDocument, error) {
+	if frame == nil {
+		return nil, fmt.Errorf("frame is nil")
+	}
+
+	// Extract event types for indexing
+	eventTypes := make([]string, 0, len(frame.GetEvents()))
+	for _, evt := range frame.GetEvents() {
+		if evt != nil && evt.Event != nil {
+			// Get the event type name from the oneof
+			eventTypes = append(eventTypes, fmt.Sprintf("%T", evt.Event))
+		}
+	}
+
+	// The key is taken before the timestamp is defaulted, so that a frame
+	// resent without one is still recognized by its index
+	frameKey := FrameKey(frame)
+
+	// Set timestamps
+	now := time.Now().UTC()
+	if frame.Timestamp == nil {
+		frame.Timestamp = timestamppb.New(now)
+	}
+
+	return &SessionFrameDocument{
+		ID:             primitive.NewObjectID(),
+		LobbySessionID: lobbySessionID,
+		NodeID:         nodeID,
+		UserID:         userID,
+		Frame:          frame,
+		FrameKey:       frameKey,
+		EventTypes:     eventTypes,
+		Timestamp:      frame.Timestamp.AsTime(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// FrameKey identifies a frame within a session and node for deduplication:
+// its frame index and timestamp. Agents number frames from 0 again when
+// they restart, so an index alone is only a key for frames without a
+// timestamp. Frames with neither have no key and are never deduplicated.
+func FrameKey(frame *telemetry.LobbySessionStateFrame) string {
+	index, ts := frame.GetFrameIndex(), frame.GetTimestamp()
+	switch {
+	case ts == nil && index == 0:
+		return ""
+	case ts == nil:
+		return fmt.Sprintf("i:%d", index)
+	case index == 0:
+		return fmt.Sprintf("t:%d", ts.AsTime().UnixNano())
+	}
+	return fmt.Sprintf("i:%d:t:%d", index, ts.AsTime().UnixNano())
+}
+
+// ValidSessionID reports whether id is safe to use as a lobby session ID in
+// queries and file names
+func ValidSessionID(id string) bool {
+	if len(id) == 0 || len(id) > 128 {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if !((c >= 'a' && c <= 'z') ||
+			(c >= 'A' && c <= 'Z') ||
+			(c >= '0' && c <= '9') ||
+			c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
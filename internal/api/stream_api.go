@@ -3,18 +3,17 @@ package api
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/echotools/nevr-capture/v3/pkg/codecs"
+	"github.com/echotools/nevr-agent/v4/internal/api/graph"
 	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
-	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // StreamHub manages subscriptions to match streams
@@ -27,6 +26,53 @@ type StreamHub struct {
 	maxFrameRate int
 	upgrader     websocket.Upgrader
 	playerLookup *PlayerLookupService
+
+	// keyframeInterval is the default number of frames between keyframes
+	// for subscribers on the delta protocol
+	keyframeInterval int
+
+	// slowPolicy decides what happens to live frames for subscribers whose
+	// send buffer is full; see SlowConsumerDrop
+	slowPolicy  string
+	slowTimeout time.Duration
+
+	// bus passes frames to and from the other instances, if set; messages
+	// of this instance are queued on busOut until busDone is closed
+	bus        FrameBus
+	instanceID string
+	busOut     chan []byte
+	busDone    chan struct{}
+
+	observersMu sync.RWMutex
+	observers   map[*frameObserver]struct{}
+
+	// sseViewers counts the event streams of each match, guarded by mu
+	sseViewers map[string]int
+
+	// lastEviction is when streams were last evicted, in Unix nanoseconds
+	lastEviction atomic.Int64
+}
+
+const (
+	// observerBuffer is the number of frames buffered for each observer
+	observerBuffer = 64
+
+	// liveMatchTimeout is how long a match stays live without receiving frames
+	liveMatchTimeout = 2 * time.Minute
+
+	// Streams without subscribers are evicted, releasing their frame
+	// history, once their match ended endedStreamGrace ago or received no
+	// frames for idleStreamTimeout. Streams are checked at most once every
+	// streamEvictionInterval.
+	endedStreamGrace       = 5 * time.Minute
+	idleStreamTimeout      = 15 * time.Minute
+	streamEvictionInterval = time.Minute
+)
+
+// frameObserver receives the frames broadcast for a match, or for all matches
+type frameObserver struct {
+	matchID string // Empty for all matches
+	ch      chan graph.LiveFrame
 }
 
 // matchStream represents a stream for a single match
@@ -34,22 +80,68 @@ type matchStream struct {
 	matchID     string
 	subscribers map[*streamSubscriber]struct{}
 	frames      []*telemetry.LobbySessionStateFrame // Ring buffer for seeking
-	frameIndex  map[uint32]int                      // Map frame index to buffer position
+	frameIndex  map[uint32]int                      // Map frame index to its sequence number in the match
+	dropped     int                                 // Frames removed from the front of the ring buffer
 	mu          sync.RWMutex
 	maxFrames   int
 	startTime   time.Time
+
+	// Timestamp of the first frame of the match, for seeking by offset
+	firstFrameAt time.Time
+
+	// Live state, updated by BroadcastFrame and CloseMatch
+	lastFrameAt time.Time
+	ended       bool
+	endedAt     time.Time
+	node        string // Node the frames are ingested from
+	mapName     string
+	matchType   string
+	gameStatus  string
 }
 
-// streamSubscriber represents a WebSocket subscriber
+// streamSubscriber is the subscription of a stream connection to one match
 type streamSubscriber struct {
-	conn      *websocket.Conn
+	conn      *streamConn
 	matchID   string
 	frameRate int
-	send      chan []byte
+	send      chan []byte // The send channel of conn
 	done      chan struct{}
 	paused    bool
 	seekFrame uint32
 	mu        sync.Mutex
+
+	// Frames are only sent if they pass filter, and no more often than
+	// minInterval when it is set. They are reduced to projection, or sent
+	// whole if it is nil.
+	filter      StreamFilter
+	minInterval time.Duration
+	lastSent    time.Time
+	projection  *streamProjection
+
+	// While replaying after a seek, live frames are held back until the
+	// playback catches up; closing playbackStop ends the playback
+	replaying    bool
+	playbackStop chan struct{}
+
+	// Playback is paced by the frames' timestamps at speed (1 if unset). In
+	// lossless mode it waits for the subscriber instead of dropping frames.
+	// Steps made while paused are queued on steps; wake is signalled when
+	// the other settings change.
+	speed    float64
+	lossless bool
+	steps    chan int
+	wake     chan struct{}
+
+	// delta is the state of the subscription on connections that use
+	// DeltaSubprotocol, nil on JSON connections
+	delta *deltaState
+
+	// Backpressure: behindSince is when the send buffer was first found
+	// full since the subscriber last kept up. dropped counts the frames it
+	// did not receive, and unreported those it has not been told about.
+	behindSince time.Time
+	dropped     int64
+	unreported  int
 }
 
 // StreamMessage represents a message sent to/from the stream
@@ -58,30 +150,42 @@ type StreamMessage struct {
 	Payload json.RawMessage `json:"payload,omitempty"`
 }
 
-// SeekRequest represents a seek request
+// SeekRequest represents a seek request. Time may be combined with Round;
+// otherwise the first field set is used.
 type SeekRequest struct {
-	Frame uint32 `json:"frame,omitempty"`
-	Time  string `json:"time,omitempty"` // Format: "MM:SS" or "HH:MM:SS"
+	Frame  uint32 `json:"frame,omitempty"`
+	Time   string `json:"time,omitempty"`   // Game clock, which counts down; format: "MM:SS" or "HH:MM:SS"
+	Round  int    `json:"round,omitempty"`  // Round number, from 1
+	Offset string `json:"offset,omitempty"` // Wall-clock offset from the first frame, e.g. "90s" or "5m30s"
 }
 
 // StreamControl represents stream control commands
 type StreamControl struct {
-	Command   string `json:"command"` // play, pause, seek
-	FrameRate int    `json:"framerate,omitempty"`
+	Command   string  `json:"command"` // play, pause, framerate, speed, mode, step
+	FrameRate int     `json:"framerate,omitempty"`
+	Speed     float64 `json:"speed,omitempty"` // Playback speed, 0.25 to 8
+	Mode      string  `json:"mode,omitempty"`  // Playback mode: realtime or lossless
+	Step      int     `json:"step,omitempty"`  // Frames to step while paused, negative to step back
 }
 
 // NewStreamHub creates a new stream hub
 func NewStreamHub(storage *StorageManager, logger Logger, metrics *Metrics, maxFrameRate int, playerLookup *PlayerLookupService) *StreamHub {
 	return &StreamHub{
-		matches:      make(map[string]*matchStream),
-		storage:      storage,
-		logger:       logger,
-		metrics:      metrics,
-		maxFrameRate: maxFrameRate,
-		playerLookup: playerLookup,
+		matches:          make(map[string]*matchStream),
+		storage:          storage,
+		logger:           logger,
+		metrics:          metrics,
+		maxFrameRate:     maxFrameRate,
+		playerLookup:     playerLookup,
+		observers:        make(map[*frameObserver]struct{}),
+		sseViewers:       make(map[string]int),
+		keyframeInterval: defaultKeyframeInterval,
+		slowPolicy:       SlowConsumerDrop,
+		slowTimeout:      defaultSlowConsumerTimeout,
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024 * 64, // 64KB for frame data
+			Subprotocols:    []string{DeltaSubprotocol},
 			CheckOrigin: func(r *http.Request) bool {
 				return true // Allow all origins for now
 			},
@@ -89,10 +193,20 @@ func NewStreamHub(storage *StorageManager, logger Logger, metrics *Metrics, maxF
 	}
 }
 
+// SetKeyframeInterval sets the default number of frames between keyframes
+// on the delta protocol. Subscribers may ask for a different interval.
+func (h *StreamHub) SetKeyframeInterval(frames int) {
+	if frames > 0 {
+		h.keyframeInterval = frames
+	}
+}
+
 // RegisterRoutes registers the stream API routes
 func (h *StreamHub) RegisterRoutes(r *mux.Router) {
+	r.HandleFunc("/ws/stream", h.handleMuxConnection).Methods("GET")
 	r.HandleFunc("/api/v3/stream/{matchId}", h.handleStreamConnection).Methods("GET")
 	r.HandleFunc("/api/v3/stream/{matchId}/info", h.handleStreamInfo).Methods("GET")
+	h.registerSSERoutes(r)
 }
 
 // handleStreamConnection handles WebSocket connections for streaming
@@ -110,21 +224,25 @@ func (h *StreamHub) handleStreamConnection(w http.ResponseWriter, r *http.Reques
 			}
 		}
 	}
+	projection, err := parseStreamProjection(r.URL.Query().Get("view"), r.URL.Query().Get("players"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	keyframeInterval, _ := strconv.Atoi(r.URL.Query().Get("keyframe_interval"))
 
 	// Upgrade to WebSocket
-	conn, err := h.upgrader.Upgrade(w, r, nil)
+	ws, err := h.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		h.logger.Error("failed to upgrade websocket", "error", err)
 		return
 	}
 
-	subscriber := &streamSubscriber{
-		conn:      conn,
-		matchID:   matchID,
-		frameRate: frameRate,
-		send:      make(chan []byte, 256),
-		done:      make(chan struct{}),
-	}
+	conn := newStreamConn(ws, false)
+	defer conn.close()
+	subscriber := conn.newSubscriber(matchID, frameRate)
+	subscriber.projection = projection
+	subscriber.setKeyframeInterval(keyframeInterval, h.keyframeInterval)
 
 	// Subscribe to the match
 	h.subscribe(matchID, subscriber)
@@ -136,8 +254,23 @@ func (h *StreamHub) handleStreamConnection(w http.ResponseWriter, r *http.Reques
 	}
 
 	// Start send and receive goroutines
-	go subscriber.writePump(h.logger)
-	subscriber.readPump(h)
+	go conn.writePump(h.logger)
+	conn.readPump(h, func(message []byte) {
+		var msg StreamMessage
+		if err := json.Unmarshal(message, &msg); err != nil {
+			h.logger.Debug("failed to parse message", "error", err)
+			return
+		}
+
+		switch msg.Type {
+		case "control":
+			subscriber.handleControl(h, msg.Payload)
+		case "seek":
+			subscriber.handleSeek(h, msg.Payload)
+		case "resync":
+			subscriber.requestResync(resyncRequest)
+		}
+	})
 }
 
 // handleStreamInfo returns information about an available stream
@@ -168,6 +301,10 @@ func (h *StreamHub) handleStreamInfo(w http.ResponseWriter, r *http.Request) {
 	}
 
 	stream.mu.RLock()
+	subs := make([]*streamSubscriber, 0, len(stream.subscribers))
+	for sub := range stream.subscribers {
+		subs = append(subs, sub)
+	}
 	info := map[string]interface{}{
 		"match_id":    matchID,
 		"status":      "live",
@@ -177,12 +314,38 @@ func (h *StreamHub) handleStreamInfo(w http.ResponseWriter, r *http.Request) {
 	}
 	stream.mu.RUnlock()
 
+	// Backpressure of each subscriber, the slowest first
+	now := time.Now()
+	stats := make([]subscriberStats, 0, len(subs))
+	var dropped int64
+	lagging := 0
+	for _, sub := range subs {
+		s := sub.stats(now)
+		stats = append(stats, s)
+		dropped += s.Dropped
+		if s.BehindMs > 0 {
+			lagging++
+		}
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].BehindMs != stats[j].BehindMs {
+			return stats[i].BehindMs > stats[j].BehindMs
+		}
+		return stats[i].Queued > stats[j].Queued
+	})
+	info["slow_consumer_policy"] = h.slowPolicy
+	info["lagging_subscribers"] = lagging
+	info["dropped_frames"] = dropped
+	info["subscriber_stats"] = stats
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(info)
 }
 
 // subscribe adds a subscriber to a match stream
 func (h *StreamHub) subscribe(matchID string, sub *streamSubscriber) {
+	h.evictStreams(time.Now())
+
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -226,12 +389,30 @@ func (h *StreamHub) unsubscribe(matchID string, sub *streamSubscriber) {
 		h.logger.Info("stream has no subscribers", "match_id", matchID)
 	}
 
+	sub.mu.Lock()
+	if !sub.behindSince.IsZero() && h.metrics != nil {
+		h.metrics.StreamSubscribersLagging.Dec()
+	}
+	sub.behindSince = time.Time{}
+	sub.mu.Unlock()
+
 	close(sub.done)
 	h.logger.Info("subscriber left stream", "match_id", matchID)
 }
 
-// BroadcastFrame broadcasts a frame to all subscribers of a match
-func (h *StreamHub) BroadcastFrame(matchID string, frame *telemetry.LobbySessionStateFrame) {
+// BroadcastFrame broadcasts a frame ingested from node to all subscribers of
+// a match, on this instance and, through the frame bus, on the others
+func (h *StreamHub) BroadcastFrame(matchID, node string, frame *telemetry.LobbySessionStateFrame) {
+	h.broadcast(matchID, node, frame, false)
+	h.publish(busFrame, matchID, node, frame)
+}
+
+// broadcast stores a frame in the history of a match and sends it to the
+// match's subscribers on this instance. Remote frames come from other
+// instances through the frame bus.
+func (h *StreamHub) broadcast(matchID, node string, frame *telemetry.LobbySessionStateFrame, remote bool) {
+	h.evictStreams(time.Now())
+
 	h.mu.RLock()
 	stream, exists := h.matches[matchID]
 	h.mu.RUnlock()
@@ -239,30 +420,43 @@ func (h *StreamHub) BroadcastFrame(matchID string, frame *telemetry.LobbySession
 	if !exists {
 		// Create new stream for this match
 		h.mu.Lock()
-		stream = &matchStream{
-			matchID:     matchID,
-			subscribers: make(map[*streamSubscriber]struct{}),
-			frames:      make([]*telemetry.LobbySessionStateFrame, 0, 1000),
-			frameIndex:  make(map[uint32]int),
-			maxFrames:   10000,
-			startTime:   time.Now(),
+		if stream, exists = h.matches[matchID]; !exists {
+			stream = &matchStream{
+				matchID:     matchID,
+				subscribers: make(map[*streamSubscriber]struct{}),
+				frames:      make([]*telemetry.LobbySessionStateFrame, 0, 1000),
+				frameIndex:  make(map[uint32]int),
+				maxFrames:   10000,
+				startTime:   time.Now(),
+			}
+			h.matches[matchID] = stream
 		}
-		h.matches[matchID] = stream
 		h.mu.Unlock()
 	}
 
 	// Store frame for seeking
 	stream.mu.Lock()
-	bufferPos := len(stream.frames)
-	if bufferPos >= stream.maxFrames {
-		// Ring buffer: remove oldest frame
-		oldFrame := stream.frames[0]
-		delete(stream.frameIndex, oldFrame.GetFrameIndex())
-		stream.frames = stream.frames[1:]
-		bufferPos = len(stream.frames)
+	latest, ok := stream.insert(frame, remote)
+	if !ok || !latest {
+		// A duplicate, or a late frame from another instance that is only
+		// kept for seeking
+		stream.mu.Unlock()
+		return
+	}
+	if stream.firstFrameAt.IsZero() && frame.GetTimestamp() != nil {
+		stream.firstFrameAt = frame.GetTimestamp().AsTime()
+	}
+
+	stream.lastFrameAt = time.Now()
+	stream.ended = false
+	if node != "" {
+		stream.node = node
+	}
+	if session := frame.GetSession(); session != nil {
+		stream.mapName = session.GetMapName()
+		stream.matchType = session.GetMatchType()
+		stream.gameStatus = session.GetGameStatus()
 	}
-	stream.frames = append(stream.frames, frame)
-	stream.frameIndex[frame.GetFrameIndex()] = bufferPos
 
 	// Get subscribers
 	subs := make([]*streamSubscriber, 0, len(stream.subscribers))
@@ -271,43 +465,46 @@ func (h *StreamHub) BroadcastFrame(matchID string, frame *telemetry.LobbySession
 	}
 	stream.mu.Unlock()
 
-	// Serialize frame once
-	marshaler := protojson.MarshalOptions{
-		EmitUnpopulated: false,
-	}
-	frameBytes, err := marshaler.Marshal(frame)
-	if err != nil {
-		h.logger.Error("failed to marshal frame", "error", err)
-		return
-	}
+	h.notifyObservers(matchID, frame)
 
-	// Wrap in message
-	msg := StreamMessage{
-		Type:    "frame",
-		Payload: frameBytes,
-	}
-	msgBytes, err := json.Marshal(msg)
-	if err != nil {
-		h.logger.Error("failed to marshal message", "error", err)
-		return
-	}
+	// Serialize the frame once per projection and protocol: untagged and
+	// tagged by match ID, or binary
+	encoder := newFrameEncoder(matchID, frame)
+	now := time.Now()
 
 	// Send to all subscribers
 	for _, sub := range subs {
-		sub.mu.Lock()
-		if !sub.paused {
-			select {
-			case sub.send <- msgBytes:
-			default:
-				// Channel full, skip this frame for this subscriber
-			}
+		projection, ok := sub.accept(frame, now)
+		if !ok {
+			continue
 		}
-		sub.mu.Unlock()
+		if h.slowPolicy == SlowConsumerCoalesce && sub.conn.holds(sub) {
+			// This frame replaces one that was never sent
+			sub.markGap()
+		}
+
+		msg, err := encoder.encode(sub, projection)
+		if err != nil {
+			// Other projections and protocols may still encode
+			h.logger.Error("failed to marshal frame", "error", err, "match_id", matchID)
+			continue
+		}
+		if msg == nil {
+			continue
+		}
+		h.deliver(sub, msg, now)
 	}
 }
 
-// CloseMatch marks a match as complete
+// CloseMatch marks a match as complete, on this instance and the others
 func (h *StreamHub) CloseMatch(matchID string) {
+	h.closeMatch(matchID)
+	h.publish(busMatchEnded, matchID, "", nil)
+}
+
+// closeMatch marks a match as complete and tells its subscribers on this
+// instance
+func (h *StreamHub) closeMatch(matchID string) {
 	h.mu.Lock()
 	stream, exists := h.matches[matchID]
 	h.mu.Unlock()
@@ -316,16 +513,18 @@ func (h *StreamHub) CloseMatch(matchID string) {
 		return
 	}
 
-	// Notify subscribers
-	msg := StreamMessage{
-		Type: "match_ended",
-	}
-	msgBytes, _ := json.Marshal(msg)
+	stream.mu.Lock()
+	stream.ended = true
+	stream.endedAt = time.Now()
+	stream.mu.Unlock()
+
+	h.notifyObservers(matchID, nil)
 
+	// Notify subscribers
 	stream.mu.RLock()
 	for sub := range stream.subscribers {
 		select {
-		case sub.send <- msgBytes:
+		case sub.send <- sub.conn.statusMessage("match_ended", matchID):
 		default:
 		}
 	}
@@ -334,75 +533,133 @@ func (h *StreamHub) CloseMatch(matchID string) {
 	h.logger.Info("match stream closed", "match_id", matchID)
 }
 
-// writePump sends messages to the WebSocket
-func (s *streamSubscriber) writePump(logger Logger) {
-	ticker := time.NewTicker(time.Second / time.Duration(s.frameRate))
-	defer func() {
-		ticker.Stop()
-		s.conn.Close()
-	}()
+// evictStreams removes the streams without subscribers or event streams whose
+// match ended more than endedStreamGrace ago, or that received no frames for
+// idleStreamTimeout. Every instance keeps a stream for each match relayed by
+// the frame bus, so without eviction their histories would never be released.
+func (h *StreamHub) evictStreams(now time.Time) {
+	last := h.lastEviction.Load()
+	if now.UnixNano()-last < int64(streamEvictionInterval) || !h.lastEviction.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
 
-	for {
-		select {
-		case <-s.done:
-			return
-		case message, ok := <-s.send:
-			if !ok {
-				s.conn.WriteMessage(websocket.CloseMessage, []byte{})
-				return
-			}
+	h.mu.Lock()
+	defer h.mu.Unlock()
 
-			s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if err := s.conn.WriteMessage(websocket.TextMessage, message); err != nil {
-				logger.Debug("failed to write message", "error", err)
-				return
-			}
-		case <-ticker.C:
-			// Ping to keep connection alive
-			s.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-			if err := s.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
-				return
-			}
+	for matchID, stream := range h.matches {
+		stream.mu.RLock()
+		lastFrameAt := stream.lastFrameAt
+		if lastFrameAt.IsZero() {
+			lastFrameAt = stream.startTime
+		}
+		expired := stream.ended && now.Sub(stream.endedAt) > endedStreamGrace ||
+			now.Sub(lastFrameAt) > idleStreamTimeout
+		watched := len(stream.subscribers) > 0 || h.sseViewers[matchID] > 0
+		stream.mu.RUnlock()
+
+		if expired && !watched {
+			delete(h.matches, matchID)
+			h.logger.Debug("evicted match stream", "match_id", matchID)
 		}
 	}
 }
 
-// readPump reads messages from the WebSocket
-func (s *streamSubscriber) readPump(hub *StreamHub) {
-	defer s.conn.Close()
+// Observe implements graph.LiveSource
+func (h *StreamHub) Observe(ctx context.Context, matchID string) <-chan graph.LiveFrame {
+	obs := &frameObserver{
+		matchID: matchID,
+		ch:      make(chan graph.LiveFrame, observerBuffer),
+	}
 
-	s.conn.SetReadLimit(64 * 1024) // 64KB
-	s.conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-	s.conn.SetPongHandler(func(string) error {
-		s.conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-		return nil
-	})
+	h.observersMu.Lock()
+	h.observers[obs] = struct{}{}
+	h.observersMu.Unlock()
 
-	for {
-		_, message, err := s.conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				hub.logger.Debug("websocket read error", "error", err)
-			}
-			return
+	go func() {
+		<-ctx.Done()
+
+		h.observersMu.Lock()
+		delete(h.observers, obs)
+		close(obs.ch)
+		h.observersMu.Unlock()
+	}()
+
+	return obs.ch
+}
+
+// notifyObservers passes a frame, or the end of a match if frame is nil, to
+// the observers of the match. Frames are dropped for observers that are
+// behind, but the end of a match always gets through.
+func (h *StreamHub) notifyObservers(matchID string, frame *telemetry.LobbySessionStateFrame) {
+	live := graph.LiveFrame{MatchID: matchID, Frame: frame}
+
+	h.observersMu.RLock()
+	defer h.observersMu.RUnlock()
+
+	for obs := range h.observers {
+		if obs.matchID != "" && obs.matchID != matchID {
+			continue
 		}
 
-		// Parse message
-		var msg StreamMessage
-		if err := json.Unmarshal(message, &msg); err != nil {
-			hub.logger.Debug("failed to parse message", "error", err)
+		select {
+		case obs.ch <- live:
 			continue
+		default:
 		}
 
-		switch msg.Type {
-		case "control":
-			s.handleControl(hub, msg.Payload)
-		case "seek":
-			s.handleSeek(hub, msg.Payload)
+		if frame == nil {
+			// Make room by dropping the oldest pending frame
+			select {
+			case <-obs.ch:
+			default:
+			}
+			select {
+			case obs.ch <- live:
+			default:
+			}
 		}
 	}
 }
 
+// LiveMatches implements graph.LiveSource
+func (h *StreamHub) LiveMatches() []*graph.LiveMatch {
+	h.mu.RLock()
+	streams := make([]*matchStream, 0, len(h.matches))
+	for _, stream := range h.matches {
+		streams = append(streams, stream)
+	}
+	h.mu.RUnlock()
+
+	now := time.Now()
+	matches := make([]*graph.LiveMatch, 0, len(streams))
+	for _, stream := range streams {
+		stream.mu.RLock()
+		if !stream.ended && !stream.lastFrameAt.IsZero() && now.Sub(stream.lastFrameAt) <= liveMatchTimeout {
+			matches = append(matches, &graph.LiveMatch{
+				MatchID:     stream.matchID,
+				MapName:     optionalString(stream.mapName),
+				MatchType:   optionalString(stream.matchType),
+				GameStatus:  optionalString(stream.gameStatus),
+				StartedAt:   stream.startTime,
+				LastFrameAt: stream.lastFrameAt,
+			})
+		}
+		stream.mu.RUnlock()
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].StartedAt.Before(matches[j].StartedAt)
+	})
+	return matches
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 // handleControl handles stream control commands
 func (s *streamSubscriber) handleControl(hub *StreamHub, payload json.RawMessage) {
 	var ctrl StreamControl
@@ -410,17 +667,22 @@ func (s *streamSubscriber) handleControl(hub *StreamHub, payload json.RawMessage
 		return
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
 	switch ctrl.Command {
-	case "pause":
-		s.paused = true
-	case "play":
-		s.paused = false
+	case "pause", "play":
+		s.setPaused(ctrl.Command == "pause")
 	case "framerate":
 		if ctrl.FrameRate > 0 && ctrl.FrameRate <= hub.maxFrameRate {
+			s.mu.Lock()
 			s.frameRate = ctrl.FrameRate
+			s.mu.Unlock()
+		}
+	case "speed", "mode":
+		if err := s.setPlayback(ctrl.Speed, ctrl.Mode); err != nil {
+			hub.logger.Debug("invalid playback settings", "match_id", s.matchID, "error", err)
+		}
+	case "step":
+		if err := s.step(ctrl.Step); err != nil {
+			hub.logger.Debug("step failed", "match_id", s.matchID, "error", err)
 		}
 	}
 }
@@ -431,121 +693,5 @@ func (s *streamSubscriber) handleSeek(hub *StreamHub, payload json.RawMessage) {
 	if err := json.Unmarshal(payload, &seek); err != nil {
 		return
 	}
-
-	hub.mu.RLock()
-	stream, exists := hub.matches[s.matchID]
-	hub.mu.RUnlock()
-
-	if !exists {
-		return
-	}
-
-	stream.mu.RLock()
-	defer stream.mu.RUnlock()
-
-	var targetFrame *telemetry.LobbySessionStateFrame
-
-	if seek.Frame > 0 {
-		// Seek by frame index
-		if pos, ok := stream.frameIndex[seek.Frame]; ok {
-			targetFrame = stream.frames[pos]
-		}
-	} else if seek.Time != "" {
-		// Seek by time (TODO: implement time-based seeking)
-		// For now, just use frame-based seeking
-	}
-
-	if targetFrame != nil {
-		// Send the target frame
-		marshaler := protojson.MarshalOptions{EmitUnpopulated: false}
-		frameBytes, err := marshaler.Marshal(targetFrame)
-		if err == nil {
-			msg := StreamMessage{
-				Type:    "frame",
-				Payload: frameBytes,
-			}
-			msgBytes, _ := json.Marshal(msg)
-			select {
-			case s.send <- msgBytes:
-			default:
-			}
-		}
-	}
-}
-
-// ReplayMatch replays a stored match to a subscriber
-func (h *StreamHub) ReplayMatch(ctx context.Context, matchID string, sub *streamSubscriber) error {
-	if h.storage == nil {
-		return fmt.Errorf("storage not available")
-	}
-
-	filePath, err := h.storage.GetMatchFile(matchID)
-	if err != nil {
-		return err
-	}
-
-	reader, err := codecs.NewNevrCapReader(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to open file: %w", err)
-	}
-	defer reader.Close()
-
-	// Skip header
-	if _, err := reader.ReadHeader(); err != nil {
-		return fmt.Errorf("failed to read header: %w", err)
-	}
-
-	interval := time.Second / time.Duration(sub.frameRate)
-	ticker := time.NewTicker(interval)
-	defer ticker.Stop()
-
-	marshaler := protojson.MarshalOptions{EmitUnpopulated: false}
-
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-sub.done:
-			return nil
-		case <-ticker.C:
-			sub.mu.Lock()
-			paused := sub.paused
-			sub.mu.Unlock()
-
-			if paused {
-				continue
-			}
-
-			frame, err := reader.ReadFrame()
-			if err != nil {
-				if err == io.EOF {
-					// Send end of stream message
-					msg := StreamMessage{Type: "stream_ended"}
-					msgBytes, _ := json.Marshal(msg)
-					select {
-					case sub.send <- msgBytes:
-					default:
-					}
-					return nil
-				}
-				return fmt.Errorf("failed to read frame: %w", err)
-			}
-
-			frameBytes, err := marshaler.Marshal(frame)
-			if err != nil {
-				continue
-			}
-
-			msg := StreamMessage{
-				Type:    "frame",
-				Payload: frameBytes,
-			}
-			msgBytes, _ := json.Marshal(msg)
-			select {
-			case sub.send <- msgBytes:
-			default:
-				// Buffer full, skip frame
-			}
-		}
-	}
+	s.seek(hub, seek)
 }
@@ -0,0 +1,226 @@
+package api
+
+import (
+	"fmt"
+	"time"
+)
+
+// Slow-consumer policies, which decide what happens to live frames for a
+// subscriber whose send buffer is full
+const (
+	// SlowConsumerDrop drops frames, and tells the subscriber how many it
+	// missed once there is room again
+	SlowConsumerDrop = "drop"
+
+	// SlowConsumerCoalesce holds back only the latest frame, replacing it
+	// with each newer one until the send buffer drains
+	SlowConsumerCoalesce = "coalesce"
+
+	// SlowConsumerDisconnect drops frames like SlowConsumerDrop, and closes
+	// the connection of a subscriber that stays behind for the timeout
+	SlowConsumerDisconnect = "disconnect"
+)
+
+const (
+	// defaultSlowConsumerTimeout is how long a subscriber may stay behind
+	// under SlowConsumerDisconnect
+	defaultSlowConsumerTimeout = 10 * time.Second
+
+	// dropReasonPlayback counts frames dropped during playback, which
+	// reports them itself
+	dropReasonPlayback = "playback"
+)
+
+// ParseSlowConsumerPolicy validates a slow-consumer policy and its timeout,
+// a duration string. Empty values select the defaults.
+func ParseSlowConsumerPolicy(policy, timeout string) (string, time.Duration, error) {
+	switch policy {
+	case "":
+		policy = SlowConsumerDrop
+	case SlowConsumerDrop, SlowConsumerCoalesce, SlowConsumerDisconnect:
+	default:
+		return "", 0, fmt.Errorf("unknown stream_slow_consumer_policy %q", policy)
+	}
+
+	d := defaultSlowConsumerTimeout
+	if timeout != "" {
+		var err error
+		if d, err = time.ParseDuration(timeout); err != nil || d <= 0 {
+			return "", 0, fmt.Errorf("invalid stream_slow_consumer_timeout %q", timeout)
+		}
+	}
+	return policy, d, nil
+}
+
+// SetSlowConsumerPolicy sets what happens to live frames for subscribers
+// that fall behind; timeout only applies to SlowConsumerDisconnect
+func (h *StreamHub) SetSlowConsumerPolicy(policy string, timeout time.Duration) {
+	h.slowPolicy = policy
+	h.slowTimeout = timeout
+}
+
+// deliver queues a live frame for the subscriber, following the hub's
+// slow-consumer policy if its send buffer is full
+func (h *StreamHub) deliver(sub *streamSubscriber, msg []byte, now time.Time) {
+	if h.slowPolicy == SlowConsumerCoalesce && sub.conn.coalesce(sub, msg) {
+		// Replaced an older frame that was never sent
+		h.recordDrops(sub, SlowConsumerCoalesce, 1)
+		return
+	}
+
+	sub.reportDrops()
+	select {
+	case sub.send <- msg:
+		h.caughtUp(sub, now)
+		return
+	default:
+	}
+
+	behind := h.fellBehind(sub, now)
+	if h.slowPolicy == SlowConsumerCoalesce {
+		sub.conn.hold(sub, msg)
+		return
+	}
+
+	sub.markGap()
+	h.recordDrops(sub, h.slowPolicy, 1)
+	if h.slowPolicy == SlowConsumerDisconnect && behind > h.slowTimeout {
+		h.logger.Warn("disconnecting slow stream subscriber", "match_id", sub.matchID, "behind", behind)
+		if h.metrics != nil {
+			h.metrics.RecordSlowConsumerDisconnect()
+		}
+		sub.conn.close()
+	}
+}
+
+// recordDrops counts frames the subscriber did not receive
+func (h *StreamHub) recordDrops(sub *streamSubscriber, reason string, n int) {
+	sub.mu.Lock()
+	sub.dropped += int64(n)
+	if reason != dropReasonPlayback {
+		sub.unreported += n
+	}
+	sub.mu.Unlock()
+
+	if h.metrics != nil {
+		h.metrics.RecordStreamDrops(reason, n)
+	}
+}
+
+// reportDrops tells the subscriber how many live frames it missed, if there
+// is room for the notice
+func (s *streamSubscriber) reportDrops() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.unreported == 0 {
+		return
+	}
+	select {
+	case s.send <- s.conn.droppedMessage(s.matchID, s.unreported):
+		s.unreported = 0
+	default:
+	}
+}
+
+// fellBehind records that the subscriber's send buffer is full, and returns
+// how long it has been behind
+func (h *StreamHub) fellBehind(sub *streamSubscriber, now time.Time) time.Duration {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.behindSince.IsZero() {
+		sub.behindSince = now
+		if h.metrics != nil {
+			h.metrics.StreamSubscribersLagging.Inc()
+		}
+	}
+	return now.Sub(sub.behindSince)
+}
+
+// caughtUp records that the subscriber had room for a frame again
+func (h *StreamHub) caughtUp(sub *streamSubscriber, now time.Time) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	if sub.behindSince.IsZero() {
+		return
+	}
+	if h.metrics != nil {
+		h.metrics.StreamSubscribersLagging.Dec()
+		h.metrics.StreamSubscriberLag.Observe(now.Sub(sub.behindSince).Seconds())
+	}
+	sub.behindSince = time.Time{}
+}
+
+// subscriberStats is the backpressure of a subscriber, for stream info
+type subscriberStats struct {
+	Queued    int     `json:"queued"`              // Messages waiting in the connection's send buffer
+	BehindMs  float64 `json:"behind_ms"`           // How long the send buffer has been full, 0 if it is not
+	Dropped   int64   `json:"dropped"`             // Frames the subscriber did not receive
+	Replaying bool    `json:"replaying,omitempty"` // Playing back after a seek
+}
+
+func (s *streamSubscriber) stats(now time.Time) subscriberStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := subscriberStats{
+		Queued:    len(s.send),
+		Dropped:   s.dropped,
+		Replaying: s.replaying,
+	}
+	if !s.behindSince.IsZero() {
+		stats.BehindMs = float64(now.Sub(s.behindSince)) / float64(time.Millisecond)
+	}
+	return stats
+}
+
+// coalesce replaces the frame held back for sub, if there is one, and
+// reports whether there was
+func (c *streamConn) coalesce(sub *streamSubscriber, msg []byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.held[sub]; !ok {
+		return false
+	}
+	c.held[sub] = msg
+	return true
+}
+
+// holds reports whether a frame is held back for sub
+func (c *streamConn) holds(sub *streamSubscriber) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.held[sub]
+	return ok
+}
+
+// hold holds back a frame for sub until the send buffer drains
+func (c *streamConn) hold(sub *streamSubscriber, msg []byte) {
+	c.mu.Lock()
+	if c.held == nil {
+		c.held = make(map[*streamSubscriber][]byte)
+	}
+	c.held[sub] = msg
+	c.mu.Unlock()
+
+	select {
+	case c.flush <- struct{}{}:
+	default:
+	}
+}
+
+// takeHeld returns and forgets the frames held back
+func (c *streamConn) takeHeld() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	msgs := make([][]byte, 0, len(c.held))
+	for sub, msg := range c.held {
+		msgs = append(msgs, msg)
+		delete(c.held, sub)
+	}
+	return msgs
+}
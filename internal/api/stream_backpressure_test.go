@@ -0,0 +1,110 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+)
+
+func TestStreamHub_SlowConsumerPolicies(t *testing.T) {
+	newHub := func(policy string, timeout time.Duration) (*StreamHub, *streamSubscriber) {
+		hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+		hub.SetSlowConsumerPolicy(policy, timeout)
+		sub := newStreamConn(nil, true).newSubscriber("match-a", 0)
+		hub.subscribe("match-a", sub)
+		return hub, sub
+	}
+	broadcast := func(hub *StreamHub, from, to uint32) {
+		for i := from; i <= to; i++ {
+			hub.BroadcastFrame("match-a", "", &telemetry.LobbySessionStateFrame{FrameIndex: i})
+		}
+	}
+	drain := func(sub *streamSubscriber) []MuxMessage {
+		var msgs []MuxMessage
+		for len(sub.send) > 0 {
+			var msg MuxMessage
+			json.Unmarshal(<-sub.send, &msg)
+			msgs = append(msgs, msg)
+		}
+		return msgs
+	}
+
+	t.Run("drop", func(t *testing.T) {
+		hub, sub := newHub(SlowConsumerDrop, 0)
+		broadcast(hub, 1, streamSendBuffer+10)
+		if stats := sub.stats(time.Now()); stats.Dropped != 10 || stats.Queued != streamSendBuffer {
+			t.Errorf("stats = %+v, want 10 dropped and a full buffer", stats)
+		}
+		drain(sub)
+
+		// The next frame is preceded by the gap notice
+		broadcast(hub, streamSendBuffer+11, streamSendBuffer+11)
+		msgs := drain(sub)
+		if len(msgs) != 2 || msgs[0].Type != "frames_dropped" || *msgs[0].Dropped != 10 || msgs[1].Type != "frame" {
+			t.Errorf("messages after draining = %+v, want frames_dropped 10 then a frame", msgs)
+		}
+		if stats := sub.stats(time.Now()); stats.BehindMs != 0 {
+			t.Errorf("stats after catching up = %+v, want not behind", stats)
+		}
+	})
+
+	t.Run("coalesce", func(t *testing.T) {
+		hub, sub := newHub(SlowConsumerCoalesce, 0)
+		broadcast(hub, 1, streamSendBuffer+10)
+		held := sub.conn.takeHeld()
+		if len(held) != 1 {
+			t.Fatalf("held %d frames, want the latest one", len(held))
+		}
+		var msg MuxMessage
+		json.Unmarshal(held[0], &msg)
+		if *msg.FrameIndex != streamSendBuffer+10 {
+			t.Errorf("held frame %d, want %d", *msg.FrameIndex, streamSendBuffer+10)
+		}
+		if stats := sub.stats(time.Now()); stats.Dropped != 9 {
+			t.Errorf("stats = %+v, want the 9 replaced frames dropped", stats)
+		}
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		hub, sub := newHub(SlowConsumerDisconnect, 20*time.Millisecond)
+		broadcast(hub, 1, streamSendBuffer+1)
+		select {
+		case <-sub.conn.closed:
+			t.Fatal("disconnected before the timeout")
+		default:
+		}
+		time.Sleep(30 * time.Millisecond)
+		broadcast(hub, streamSendBuffer+2, streamSendBuffer+2)
+		select {
+		case <-sub.conn.closed:
+		default:
+			t.Fatal("still connected after the timeout")
+		}
+	})
+
+	t.Run("info", func(t *testing.T) {
+		hub, _ := newHub(SlowConsumerDrop, 0)
+		broadcast(hub, 1, streamSendBuffer+5)
+		router := mux.NewRouter()
+		hub.RegisterRoutes(router)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest("GET", "/api/v3/stream/match-a/info", nil))
+
+		var info struct {
+			Policy  string            `json:"slow_consumer_policy"`
+			Lagging int               `json:"lagging_subscribers"`
+			Dropped int64             `json:"dropped_frames"`
+			Stats   []subscriberStats `json:"subscriber_stats"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &info); err != nil {
+			t.Fatalf("info = %s, %v", rec.Body, err)
+		}
+		if info.Policy != SlowConsumerDrop || info.Lagging != 1 || info.Dropped != 5 || len(info.Stats) != 1 {
+			t.Errorf("info = %+v, want one lagging subscriber with 5 dropped frames", info)
+		}
+	})
+}
@@ -0,0 +1,299 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// FrameBus passes live frames between API server instances, so that every
+// instance can serve any live match. Messages published by any instance,
+// this one included, are passed to the handler of every instance, in the
+// order they were published. amqp.FrameBus implements it across processes.
+type FrameBus interface {
+	Publish(ctx context.Context, body []byte) error
+	Subscribe(handle func(body []byte)) error
+	Connected() bool // Whether messages can currently be passed
+	Close() error
+}
+
+// StreamBusAMQP selects the AMQP frame bus in Config.StreamBus
+const StreamBusAMQP = "amqp"
+
+// Frame bus message kinds
+const (
+	busFrame      byte = 1
+	busMatchEnded byte = 2
+)
+
+const (
+	// busOutBuffer is the number of messages queued for publishing; frames
+	// are dropped from the bus, not from local subscribers, beyond it
+	busOutBuffer = 1024
+
+	// maxFrameReorder is how far back in a match's history a late frame
+	// from another instance is placed by its timestamp
+	maxFrameReorder = 64
+)
+
+var errBusClosed = errors.New("frame bus is closed")
+
+// MemoryFrameBus is a FrameBus within one process, which passes messages to
+// its handlers as they are published. It connects the hubs of tests, and of
+// instances sharing a process.
+type MemoryFrameBus struct {
+	mu       sync.RWMutex
+	handlers []func(body []byte)
+	closed   bool
+}
+
+// NewMemoryFrameBus creates an in-memory frame bus
+func NewMemoryFrameBus() *MemoryFrameBus {
+	return &MemoryFrameBus{}
+}
+
+// Publish implements FrameBus
+func (b *MemoryFrameBus) Publish(ctx context.Context, body []byte) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return errBusClosed
+	}
+	for _, handle := range b.handlers {
+		handle(append([]byte(nil), body...))
+	}
+	return nil
+}
+
+// Subscribe implements FrameBus
+func (b *MemoryFrameBus) Subscribe(handle func(body []byte)) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return errBusClosed
+	}
+	b.handlers = append(b.handlers, handle)
+	return nil
+}
+
+// Connected implements FrameBus
+func (b *MemoryFrameBus) Connected() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return !b.closed
+}
+
+// Close implements FrameBus
+func (b *MemoryFrameBus) Close() error {
+	b.mu.Lock()
+	b.closed = true
+	b.mu.Unlock()
+	return nil
+}
+
+// SetFrameBus connects the hub to the other instances on bus. Frames and
+// match ends broadcast on this hub are published on the bus, and those of
+// other instances are passed to this hub's subscribers and kept in its
+// history for seeking. instanceID must be unique to this instance.
+func (h *StreamHub) SetFrameBus(bus FrameBus, instanceID string) error {
+	h.bus = bus
+	h.instanceID = instanceID
+	h.busOut = make(chan []byte, busOutBuffer)
+	h.busDone = make(chan struct{})
+	go h.publishLoop()
+
+	if err := bus.Subscribe(h.receive); err != nil {
+		close(h.busDone)
+		h.bus = nil
+		return err
+	}
+	h.logger.Info("stream hub joined frame bus", "instance_id", instanceID)
+	return nil
+}
+
+// CloseFrameBus stops publishing and closes the frame bus
+func (h *StreamHub) CloseFrameBus() error {
+	if h.bus == nil {
+		return nil
+	}
+	close(h.busDone)
+	return h.bus.Close()
+}
+
+// publish queues a message for the other instances. Frames are dropped if
+// the bus is backed up; the end of a match waits for room.
+func (h *StreamHub) publish(kind byte, matchID, node string, frame *telemetry.LobbySessionStateFrame) {
+	if h.bus == nil {
+		return
+	}
+
+	body, err := encodeBusMessage(h.instanceID, matchID, node, kind, frame)
+	if err != nil {
+		h.logger.Error("failed to encode frame bus message", "match_id", matchID, "error", err)
+		return
+	}
+
+	if kind == busMatchEnded {
+		select {
+		case h.busOut <- body:
+		case <-h.busDone:
+		}
+		return
+	}
+	select {
+	case h.busOut <- body:
+	case <-h.busDone:
+	default:
+		h.logger.Debug("frame bus backed up, frame not published", "match_id", matchID)
+	}
+}
+
+// publishLoop publishes queued messages in order until the bus is closed.
+// Every failure is counted; the first of a run is logged, and so is the
+// recovery.
+func (h *StreamHub) publishLoop() {
+	failing := false
+	for {
+		select {
+		case <-h.busDone:
+			return
+		case body := <-h.busOut:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			err := h.bus.Publish(ctx, body)
+			cancel()
+
+			switch {
+			case err != nil:
+				if h.metrics != nil {
+					h.metrics.RecordFrameBusPublishError()
+				}
+				if !failing {
+					h.logger.Error("failed to publish on frame bus", "error", err)
+					failing = true
+				}
+			case failing:
+				h.logger.Info("frame bus publishing resumed")
+				failing = false
+			}
+		}
+	}
+}
+
+// FrameBusConnected reports whether the hub has a frame bus, and if so
+// whether it is connected
+func (h *StreamHub) FrameBusConnected() (connected, ok bool) {
+	if h.bus == nil {
+		return false, false
+	}
+	return h.bus.Connected(), true
+}
+
+// receive handles a message of the frame bus
+func (h *StreamHub) receive(body []byte) {
+	instanceID, matchID, node, kind, frame, err := decodeBusMessage(body)
+	if err != nil {
+		h.logger.Warn("invalid frame bus message", "error", err)
+		return
+	}
+	if instanceID == h.instanceID {
+		// Already broadcast here
+		return
+	}
+
+	switch kind {
+	case busFrame:
+		h.broadcast(matchID, node, frame, true)
+	case busMatchEnded:
+		h.closeMatch(matchID)
+	}
+}
+
+// encodeBusMessage encodes a frame bus message: the instance and match IDs,
+// the node that ingested the frame, the kind, and the frame as protobuf
+func encodeBusMessage(instanceID, matchID, node string, kind byte, frame *telemetry.LobbySessionStateFrame) ([]byte, error) {
+	b := protowire.AppendString(nil, instanceID)
+	b = protowire.AppendString(b, matchID)
+	b = protowire.AppendString(b, node)
+	b = append(b, kind)
+	if frame == nil {
+		return b, nil
+	}
+	return proto.MarshalOptions{}.MarshalAppend(b, frame)
+}
+
+func decodeBusMessage(b []byte) (instanceID, matchID, node string, kind byte, frame *telemetry.LobbySessionStateFrame, err error) {
+	errMalformed := errors.New("malformed frame bus message")
+
+	var fields [3]string
+	for i := range fields {
+		s, n := protowire.ConsumeString(b)
+		if n < 0 {
+			return "", "", "", 0, nil, errMalformed
+		}
+		fields[i], b = s, b[n:]
+	}
+	if len(b) == 0 {
+		return "", "", "", 0, nil, errMalformed
+	}
+	kind, b = b[0], b[1:]
+
+	if kind == busFrame {
+		frame = &telemetry.LobbySessionStateFrame{}
+		if err := proto.Unmarshal(b, frame); err != nil {
+			return "", "", "", 0, nil, err
+		}
+	}
+	return fields[0], fields[1], fields[2], kind, frame, nil
+}
+
+// insert adds a frame to the history of the match and reports whether it is
+// the latest. Frames may reach an instance out of order, or more than once,
+// when a match is ingested by several instances. Late frames are placed by
+// their timestamps so that seeking plays the history in order, and remote
+// frames already in the history are ignored (ok is false). Local frames
+// were deduplicated by storage. The caller holds s.mu.
+func (s *matchStream) insert(frame *telemetry.LobbySessionStateFrame, remote bool) (latest, ok bool) {
+	if seq, exists := s.frameIndex[frame.GetFrameIndex()]; exists && remote && frame.GetTimestamp() != nil {
+		if old := s.frames[seq-s.dropped]; proto.Equal(old.GetTimestamp(), frame.GetTimestamp()) {
+			return false, false
+		}
+	}
+
+	if len(s.frames) >= s.maxFrames {
+		// Ring buffer: remove oldest frame
+		oldFrame := s.frames[0]
+		if s.frameIndex[oldFrame.GetFrameIndex()] == s.dropped {
+			delete(s.frameIndex, oldFrame.GetFrameIndex())
+		}
+		s.frames = s.frames[1:]
+		s.dropped++
+	}
+
+	pos := len(s.frames)
+	if ts := frame.GetTimestamp(); ts != nil {
+		t := ts.AsTime()
+		for pos > 0 && len(s.frames)-pos < maxFrameReorder {
+			prev := s.frames[pos-1].GetTimestamp()
+			if prev == nil || !prev.AsTime().After(t) {
+				break
+			}
+			pos--
+		}
+	}
+
+	s.frames = append(s.frames, nil)
+	copy(s.frames[pos+1:], s.frames[pos:])
+	s.frames[pos] = frame
+	for i := pos; i < len(s.frames); i++ {
+		s.frameIndex[s.frames[i].GetFrameIndex()] = s.dropped + i
+	}
+	return pos == len(s.frames)-1, true
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStreamHub_FrameBus(t *testing.T) {
+	bus := NewMemoryFrameBus()
+	newHub := func(instanceID string) *StreamHub {
+		hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+		if err := hub.SetFrameBus(bus, instanceID); err != nil {
+			t.Fatalf("SetFrameBus() error = %v", err)
+		}
+		return hub
+	}
+	a, b := newHub("a"), newHub("b")
+	defer a.CloseFrameBus()
+
+	// A viewer on b sees the frames ingested by a
+	sub := newStreamConn(nil, true).newSubscriber("match-a", 0)
+	b.subscribe("match-a", sub)
+	read := func(t *testing.T) MuxMessage {
+		t.Helper()
+		select {
+		case data := <-sub.send:
+			var msg MuxMessage
+			json.Unmarshal(data, &msg)
+			return msg
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for a message")
+			return MuxMessage{}
+		}
+	}
+
+	start := time.Now()
+	frame := func(index uint32) *telemetry.LobbySessionStateFrame {
+		return &telemetry.LobbySessionStateFrame{
+			FrameIndex: index,
+			Timestamp:  timestamppb.New(start.Add(time.Duration(index) * time.Second)),
+		}
+	}
+	a.BroadcastFrame("match-a", "node-1", frame(1))
+	a.BroadcastFrame("match-a", "", frame(3))
+	for _, want := range []uint32{1, 3} {
+		if msg := read(t); msg.Type != "frame" || *msg.FrameIndex != want {
+			t.Fatalf("message = %+v, want frame %d", msg, want)
+		}
+	}
+
+	// A late frame ingested by b is kept in order for seeking but not sent
+	// live, and is not echoed back to b
+	b.BroadcastFrame("match-a", "", frame(2))
+	a.BroadcastFrame("match-a", "", frame(4))
+	if msg := read(t); *msg.FrameIndex != 4 {
+		t.Fatalf("message = %+v, want frame 4", msg)
+	}
+	time.Sleep(20 * time.Millisecond)
+	for name, hub := range map[string]*StreamHub{"a": a, "b": b} {
+		stream := hub.matches["match-a"]
+		stream.mu.RLock()
+		var got []uint32
+		for _, f := range stream.frames {
+			got = append(got, f.GetFrameIndex())
+		}
+		seq := stream.frameIndex[3]
+		stream.mu.RUnlock()
+		if len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 3 || got[3] != 4 || seq != 2 {
+			t.Errorf("history of %s = %v (frame 3 at %d), want frames 1 to 4 in order", name, got, seq)
+		}
+	}
+
+	if node := b.matches["match-a"].node; node != "node-1" {
+		t.Errorf("node on b = %q, want the ingesting node", node)
+	}
+
+	// Frames delivered twice are ignored
+	b.receive(mustEncodeBusMessage(t, "a", "match-a", busFrame, frame(4)))
+	if n := len(b.matches["match-a"].frames); n != 4 {
+		t.Errorf("history after a duplicate has %d frames, want 4", n)
+	}
+
+	a.CloseMatch("match-a")
+	if msg := read(t); msg.Type != "match_ended" {
+		t.Errorf("message = %+v, want match_ended", msg)
+	}
+
+	// A lost bus is reported, e.g. by /health
+	if connected, ok := b.FrameBusConnected(); !ok || !connected {
+		t.Errorf("FrameBusConnected() = %v, %v, want connected", connected, ok)
+	}
+	bus.Close()
+	if connected, _ := b.FrameBusConnected(); connected {
+		t.Error("FrameBusConnected() after the bus closed = true")
+	}
+}
+
+func mustEncodeBusMessage(t *testing.T, instanceID, matchID string, kind byte, frame *telemetry.LobbySessionStateFrame) []byte {
+	t.Helper()
+	body, err := encodeBusMessage(instanceID, matchID, "", kind, frame)
+	if err != nil {
+		t.Fatalf("encodeBusMessage() error = %v", err)
+	}
+	return body
+}
+
+func TestStreamHub_EvictStreams(t *testing.T) {
+	hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+	for _, matchID := range []string{"ended", "idle", "live", "watched"} {
+		hub.broadcast(matchID, "", &telemetry.LobbySessionStateFrame{FrameIndex: 1}, true)
+	}
+	hub.closeMatch("ended")
+	hub.closeMatch("watched")
+	sub := newStreamConn(nil, true).newSubscriber("watched", 0)
+	hub.subscribe("watched", sub)
+
+	now := time.Now()
+	for matchID, stream := range hub.matches {
+		switch matchID {
+		case "ended", "watched":
+			stream.endedAt = now.Add(-endedStreamGrace - time.Second)
+		case "idle":
+			stream.lastFrameAt = now.Add(-idleStreamTimeout - time.Second)
+		}
+	}
+
+	// Streams are not checked again within the eviction interval
+	hub.evictStreams(now)
+	if len(hub.matches) != 4 {
+		t.Fatalf("%d streams after a recent eviction, want 4", len(hub.matches))
+	}
+
+	hub.evictStreams(now.Add(streamEvictionInterval))
+	for _, matchID := range []string{"live", "watched"} {
+		if _, ok := hub.matches[matchID]; !ok {
+			t.Errorf("stream %s was evicted", matchID)
+		}
+	}
+	if len(hub.matches) != 2 {
+		t.Errorf("%d streams after eviction, want 2", len(hub.matches))
+	}
+}
@@ -0,0 +1,428 @@
+package api
+
+import (
+	"errors"
+	"math"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DeltaSubprotocol is the WebSocket subprotocol of the binary delta stream.
+// Clients that offer it in Sec-WebSocket-Protocol receive frames as protobuf
+// keyframes and compact deltas instead of JSON; see DeltaDecoder.
+const DeltaSubprotocol = "nevr.delta.v1"
+
+// Delta stream message kinds, the first byte of each binary message
+const (
+	deltaKindKeyframe byte = 1 // A whole frame
+	deltaKindDelta    byte = 2 // The changes from the previous frame
+	deltaKindResync   byte = 3 // A whole frame after a seek or gap
+	deltaKindSnapshot byte = 4 // A whole frame outside the stream, from get_frames
+	deltaKindJSON     byte = 5 // Any other server message, as JSON
+)
+
+// Resync reasons
+const (
+	resyncSeek    byte = 1
+	resyncGap     byte = 2
+	resyncRequest byte = 3
+)
+
+const (
+	// defaultKeyframeInterval is the number of frames from one keyframe to
+	// the next, about one to two seconds of a match
+	defaultKeyframeInterval = 60
+
+	// deltaQuantum is the precision of the repeated floating-point fields of
+	// frames on the delta stream: positions, orientations, velocities and
+	// bones. It is a power of two, so quantized values are exact.
+	deltaQuantum = 1.0 / 4096
+)
+
+// errDeltaShape is returned when a frame differs in structure from the one
+// before it, such as when a player joins, and is sent as a keyframe instead
+var errDeltaShape = errors.New("frame structure changed")
+
+// frameEventsField is encoded whole in every delta, since events differ
+// from frame to frame
+var frameEventsField = (&telemetry.LobbySessionStateFrame{}).ProtoReflect().Descriptor().Fields().ByName("events")
+
+// deltaState is the state of a subscription on the delta stream: the last
+// frame sent, which the client applies the next delta to
+type deltaState struct {
+	interval      int // Frames between keyframes
+	ref           *telemetry.LobbySessionStateFrame
+	sinceKeyframe int
+	resync        byte // Reason to resync with the next frame, or 0
+}
+
+// setKeyframeInterval sets the frames between keyframes for a subscription
+// on the delta stream, using the hub's interval if n is not positive
+func (s *streamSubscriber) setKeyframeInterval(n, hubInterval int) {
+	if n <= 0 {
+		n = hubInterval
+	}
+	s.mu.Lock()
+	if s.delta != nil {
+		s.delta.interval = n
+	}
+	s.mu.Unlock()
+}
+
+// requestResync makes the next frame sent on the delta stream a resync
+func (s *streamSubscriber) requestResync(reason byte) {
+	s.mu.Lock()
+	if s.delta != nil && s.delta.ref != nil {
+		s.delta.resync = reason
+	}
+	s.mu.Unlock()
+}
+
+// markGap records that a frame could not be sent. The client cannot apply
+// the next delta, so a resync follows.
+func (s *streamSubscriber) markGap() {
+	s.requestResync(resyncGap)
+}
+
+// encode returns the message carrying the encoder's frame to the subscriber,
+// or nil if its projection selects nothing of the frame. On the delta stream
+// the subscription moves on to the frame.
+func (e *frameEncoder) encode(sub *streamSubscriber, proj *streamProjection) ([]byte, error) {
+	sub.mu.Lock()
+	state := sub.delta
+	sub.mu.Unlock()
+	if state == nil {
+		return e.message(sub.conn, proj)
+	}
+
+	frame, err := e.quantized(proj)
+	if frame == nil || err != nil {
+		return nil, err
+	}
+
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+
+	var msg []byte
+	switch {
+	case state.resync != 0:
+		msg, err = e.keyframe(proj, deltaKindResync, state.resync)
+	case state.ref == nil || state.sinceKeyframe+1 >= state.interval:
+		msg, err = e.keyframe(proj, deltaKindKeyframe, 0)
+	default:
+		msg, err = e.delta(proj, state.ref)
+		if errors.Is(err, errDeltaShape) {
+			msg, err = e.keyframe(proj, deltaKindKeyframe, 0)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if msg[0] == deltaKindDelta {
+		state.sinceKeyframe++
+	} else {
+		state.sinceKeyframe = 0
+	}
+	state.ref = frame
+	state.resync = 0
+	return msg, nil
+}
+
+// snapshot returns the message carrying the encoder's frame to a subscriber
+// outside its stream, such as in answer to get_frames
+func (e *frameEncoder) snapshot(sub *streamSubscriber, proj *streamProjection) ([]byte, error) {
+	if !sub.conn.binary {
+		return e.message(sub.conn, proj)
+	}
+	if frame, err := e.quantized(proj); frame == nil || err != nil {
+		return nil, err
+	}
+	return e.keyframe(proj, deltaKindSnapshot, 0)
+}
+
+// quantized returns the projection of the frame with its repeated floating
+// point fields rounded to deltaQuantum, or nil if the projection selects
+// nothing of the frame
+func (e *frameEncoder) quantized(proj *streamProjection) (*telemetry.LobbySessionStateFrame, error) {
+	if proj == nil {
+		proj = fullProjection
+	}
+	if frame, ok := e.quantizedFrames[proj.key]; ok {
+		return frame, nil
+	}
+
+	var frame *telemetry.LobbySessionStateFrame
+	if projected := proj.project(e.frame); projected != nil {
+		frame = proto.Clone(projected).(*telemetry.LobbySessionStateFrame)
+		quantizeMessage(frame.ProtoReflect())
+	}
+	if e.quantizedFrames == nil {
+		e.quantizedFrames = make(map[string]*telemetry.LobbySessionStateFrame)
+	}
+	e.quantizedFrames[proj.key] = frame
+	return frame, nil
+}
+
+// keyframe returns a whole-frame message of the quantized frame
+func (e *frameEncoder) keyframe(proj *streamProjection, kind, reason byte) ([]byte, error) {
+	if proj == nil {
+		proj = fullProjection
+	}
+	key := deltaCacheKey{projection: proj.key, kind: kind, reason: reason}
+	if msg, ok := e.deltaMessages[key]; ok {
+		return msg, nil
+	}
+
+	frame, err := e.quantized(proj)
+	if err != nil {
+		return nil, err
+	}
+	msg := e.header(kind)
+	if kind == deltaKindResync {
+		msg = append(msg, reason)
+	}
+	msg, err = proto.MarshalOptions{}.MarshalAppend(msg, frame)
+	if err != nil {
+		return nil, err
+	}
+	e.cacheDelta(key, msg)
+	return msg, nil
+}
+
+// delta returns a message of the changes from ref to the quantized frame.
+// Subscribers that were sent the same ref share it.
+func (e *frameEncoder) delta(proj *streamProjection, ref *telemetry.LobbySessionStateFrame) ([]byte, error) {
+	if proj == nil {
+		proj = fullProjection
+	}
+	key := deltaCacheKey{projection: proj.key, kind: deltaKindDelta, ref: ref}
+	if msg, ok := e.deltaMessages[key]; ok {
+		return msg, nil
+	}
+
+	frame, err := e.quantized(proj)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := e.header(deltaKindDelta)
+	msg = protowire.AppendVarint(msg, uint64(ref.GetFrameIndex()))
+	msg = protowire.AppendVarint(msg, uint64(len(frame.GetEvents())))
+	for _, event := range frame.GetEvents() {
+		data, err := proto.Marshal(event)
+		if err != nil {
+			return nil, err
+		}
+		msg = protowire.AppendBytes(msg, data)
+	}
+
+	w := &deltaWriter{last: -1}
+	if err := w.diff(frame.ProtoReflect(), ref.ProtoReflect()); err != nil {
+		return nil, err
+	}
+	msg = protowire.AppendVarint(msg, uint64(w.changed))
+	msg = append(msg, w.buf...)
+
+	e.cacheDelta(key, msg)
+	return msg, nil
+}
+
+// header starts a message of the given kind for the encoder's match
+func (e *frameEncoder) header(kind byte) []byte {
+	msg := []byte{kind}
+	return protowire.AppendString(msg, e.matchID)
+}
+
+func (e *frameEncoder) cacheDelta(key deltaCacheKey, msg []byte) {
+	if e.deltaMessages == nil {
+		e.deltaMessages = make(map[deltaCacheKey][]byte)
+	}
+	e.deltaMessages[key] = msg
+}
+
+// deltaCacheKey identifies a delta stream message of a frame
+type deltaCacheKey struct {
+	projection string
+	kind       byte
+	reason     byte
+	ref        *telemetry.LobbySessionStateFrame // Delta base
+}
+
+// quantizeMessage rounds the repeated floating-point fields of m, and of the
+// messages within it, to deltaQuantum
+func quantizeMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.IsList() && isMessageKind(fd):
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				quantizeMessage(list.Get(i).Message())
+			}
+		case fd.IsList() && isFloatKind(fd):
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				list.Set(i, floatValue(fd, dequantize(quantize(list.Get(i).Float()))))
+			}
+		case isMessageKind(fd):
+			quantizeMessage(v.Message())
+		}
+		return true
+	})
+}
+
+func quantize(f float64) int64 {
+	return int64(math.Round(f / deltaQuantum))
+}
+
+func dequantize(q int64) float64 {
+	return float64(q) * deltaQuantum
+}
+
+func isMessageKind(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+func isFloatKind(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.FloatKind || fd.Kind() == protoreflect.DoubleKind
+}
+
+func floatValue(fd protoreflect.FieldDescriptor, f float64) protoreflect.Value {
+	if fd.Kind() == protoreflect.FloatKind {
+		return protoreflect.ValueOfFloat32(float32(f))
+	}
+	return protoreflect.ValueOfFloat64(f)
+}
+
+// deltaWriter encodes the changes between two frames of the same structure.
+// The fields of a frame are visited in a fixed order, in which each scalar
+// field, and each repeated scalar field as a whole, is a leaf. A delta lists
+// the changed leaves by the distance from the previous changed leaf, each
+// followed by its new value. Events are left out, as they are sent whole.
+type deltaWriter struct {
+	buf     []byte
+	leaf    int // Index of the next leaf
+	last    int // Index of the last changed leaf
+	changed int
+}
+
+// diff writes the leaves of m that differ from ref
+func (w *deltaWriter) diff(m, ref protoreflect.Message) error {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd == frameEventsField {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+			if m.Get(fd).Map().Len() != 0 || ref.Get(fd).Map().Len() != 0 {
+				return errDeltaShape
+			}
+
+		case fd.IsList() && isMessageKind(fd):
+			list, refList := m.Get(fd).List(), ref.Get(fd).List()
+			if list.Len() != refList.Len() {
+				return errDeltaShape
+			}
+			for j := 0; j < list.Len(); j++ {
+				if err := w.diff(list.Get(j).Message(), refList.Get(j).Message()); err != nil {
+					return err
+				}
+			}
+
+		case fd.IsList() && isFloatKind(fd):
+			list, refList := m.Get(fd).List(), ref.Get(fd).List()
+			if list.Len() != refList.Len() {
+				return errDeltaShape
+			}
+			if !listsEqual(list, refList) {
+				w.mark()
+				for j := 0; j < list.Len(); j++ {
+					d := quantize(list.Get(j).Float()) - quantize(refList.Get(j).Float())
+					w.buf = protowire.AppendVarint(w.buf, protowire.EncodeZigZag(d))
+				}
+			}
+			w.leaf++
+
+		case fd.IsList():
+			list := m.Get(fd).List()
+			if !listsEqual(list, ref.Get(fd).List()) {
+				w.mark()
+				w.buf = protowire.AppendVarint(w.buf, uint64(list.Len()))
+				for j := 0; j < list.Len(); j++ {
+					w.buf = appendScalar(w.buf, fd, list.Get(j))
+				}
+			}
+			w.leaf++
+
+		case isMessageKind(fd):
+			if m.Has(fd) != ref.Has(fd) {
+				return errDeltaShape
+			}
+			if m.Has(fd) {
+				if err := w.diff(m.Get(fd).Message(), ref.Get(fd).Message()); err != nil {
+					return err
+				}
+			}
+
+		default:
+			if fd.ContainingOneof() != nil && m.Has(fd) != ref.Has(fd) {
+				return errDeltaShape
+			}
+			if v := m.Get(fd); !v.Equal(ref.Get(fd)) {
+				w.mark()
+				w.buf = appendScalar(w.buf, fd, v)
+			}
+			w.leaf++
+		}
+	}
+	return nil
+}
+
+// mark records the current leaf as changed
+func (w *deltaWriter) mark() {
+	w.buf = protowire.AppendVarint(w.buf, uint64(w.leaf-w.last-1))
+	w.last = w.leaf
+	w.changed++
+}
+
+func listsEqual(a, b protoreflect.List) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for i := 0; i < a.Len(); i++ {
+		if !a.Get(i).Equal(b.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// appendScalar appends a scalar value of field fd
+func appendScalar(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) []byte {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protowire.AppendVarint(b, protowire.EncodeBool(v.Bool()))
+	case protoreflect.EnumKind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(int64(v.Enum())))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protowire.AppendVarint(b, v.Uint())
+	case protoreflect.FloatKind:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(v.Float())))
+	case protoreflect.DoubleKind:
+		return protowire.AppendFixed64(b, math.Float64bits(v.Float()))
+	case protoreflect.StringKind:
+		return protowire.AppendString(b, v.String())
+	default: // BytesKind
+		return protowire.AppendBytes(b, v.Bytes())
+	}
+}
@@ -0,0 +1,300 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrDeltaGap is returned for a delta that does not follow the last frame
+// decoded for its match. The client should send a resync request.
+var ErrDeltaGap = errors.New("delta does not follow the last frame")
+
+var errMalformedDelta = errors.New("malformed delta stream message")
+
+// DeltaMessage is a decoded message of the delta stream
+type DeltaMessage struct {
+	Kind    string // keyframe, delta, resync, snapshot or json
+	MatchID string // Empty for json messages
+	Frame   *telemetry.LobbySessionStateFrame
+	Reason  string // resync: seek, gap or request
+	JSON    []byte // json: a MuxMessage, or a StreamMessage on per-match connections
+}
+
+var resyncReasons = map[byte]string{
+	resyncSeek:    "seek",
+	resyncGap:     "gap",
+	resyncRequest: "request",
+}
+
+// DeltaDecoder decodes the binary messages of DeltaSubprotocol, keeping the
+// last frame of each match to apply deltas to. It is the reference decoder
+// for clients of the protocol, and is not safe for concurrent use.
+type DeltaDecoder struct {
+	frames map[string]*telemetry.LobbySessionStateFrame
+}
+
+// NewDeltaDecoder creates a decoder for one stream connection
+func NewDeltaDecoder() *DeltaDecoder {
+	return &DeltaDecoder{frames: make(map[string]*telemetry.LobbySessionStateFrame)}
+}
+
+// Decode decodes a message. Frames are shared with the decoder and must not
+// be modified.
+func (d *DeltaDecoder) Decode(data []byte) (*DeltaMessage, error) {
+	if len(data) == 0 {
+		return nil, errMalformedDelta
+	}
+	kind, data := data[0], data[1:]
+	if kind == deltaKindJSON {
+		return &DeltaMessage{Kind: "json", JSON: data}, nil
+	}
+
+	matchID, n := protowire.ConsumeString(data)
+	if n < 0 {
+		return nil, errMalformedDelta
+	}
+	data = data[n:]
+	msg := &DeltaMessage{MatchID: matchID}
+
+	switch kind {
+	case deltaKindKeyframe, deltaKindResync, deltaKindSnapshot:
+		msg.Kind = map[byte]string{deltaKindKeyframe: "keyframe", deltaKindResync: "resync", deltaKindSnapshot: "snapshot"}[kind]
+		if kind == deltaKindResync {
+			if len(data) == 0 {
+				return nil, errMalformedDelta
+			}
+			msg.Reason = resyncReasons[data[0]]
+			data = data[1:]
+		}
+		frame := &telemetry.LobbySessionStateFrame{}
+		if err := proto.Unmarshal(data, frame); err != nil {
+			return nil, fmt.Errorf("failed to decode frame: %w", err)
+		}
+		msg.Frame = frame
+		if kind != deltaKindSnapshot {
+			d.frames[matchID] = frame
+		}
+
+	case deltaKindDelta:
+		msg.Kind = "delta"
+		frame, err := d.applyDelta(matchID, data)
+		if err != nil {
+			return nil, err
+		}
+		msg.Frame = frame
+		d.frames[matchID] = frame
+
+	default:
+		return nil, fmt.Errorf("unknown delta stream message kind %d", kind)
+	}
+	return msg, nil
+}
+
+// applyDelta returns the frame a delta makes of the last frame of a match
+func (d *DeltaDecoder) applyDelta(matchID string, data []byte) (*telemetry.LobbySessionStateFrame, error) {
+	base, n := protowire.ConsumeVarint(data)
+	if n < 0 {
+		return nil, errMalformedDelta
+	}
+	data = data[n:]
+	ref := d.frames[matchID]
+	if ref == nil || uint32(base) != ref.GetFrameIndex() {
+		return nil, ErrDeltaGap
+	}
+	frame := proto.Clone(ref).(*telemetry.LobbySessionStateFrame)
+
+	count, n := protowire.ConsumeVarint(data)
+	if n < 0 || count > uint64(len(data)) {
+		return nil, errMalformedDelta
+	}
+	data = data[n:]
+	frame.Events = make([]*telemetry.LobbySessionEvent, 0, count)
+	for i := uint64(0); i < count; i++ {
+		b, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return nil, errMalformedDelta
+		}
+		data = data[n:]
+		event := &telemetry.LobbySessionEvent{}
+		if err := proto.Unmarshal(b, event); err != nil {
+			return nil, fmt.Errorf("failed to decode event: %w", err)
+		}
+		frame.Events = append(frame.Events, event)
+	}
+	if len(frame.Events) == 0 {
+		frame.Events = nil
+	}
+
+	changed, n := protowire.ConsumeVarint(data)
+	if n < 0 {
+		return nil, errMalformedDelta
+	}
+	r := &deltaReader{buf: data[n:], remaining: changed, last: -1}
+	r.advance()
+	r.apply(frame.ProtoReflect())
+	if r.err == nil && (r.next >= 0 || len(r.buf) > 0) {
+		r.err = errMalformedDelta
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return frame, nil
+}
+
+// deltaReader applies the changed leaves of a delta, visiting the fields of
+// a frame in the order of deltaWriter
+type deltaReader struct {
+	buf       []byte
+	leaf      int    // Index of the next leaf
+	last      int    // Index of the last changed leaf
+	next      int    // Index of the next changed leaf, or -1 if there are no more
+	remaining uint64 // Changed leaves after next
+	err       error
+}
+
+// advance reads the index of the next changed leaf
+func (r *deltaReader) advance() {
+	if r.remaining == 0 {
+		r.next = -1
+		return
+	}
+	r.remaining--
+	gap := r.varint()
+	r.next = r.last + 1 + int(gap)
+}
+
+// changed reports whether the current leaf is changed, moving to the next
+// leaf in either case
+func (r *deltaReader) changed() bool {
+	leaf := r.leaf
+	r.leaf++
+	if r.err != nil || leaf != r.next {
+		return false
+	}
+	r.last = leaf
+	return true
+}
+
+func (r *deltaReader) apply(m protoreflect.Message) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len() && r.err == nil; i++ {
+		fd := fields.Get(i)
+		if fd == frameEventsField {
+			continue
+		}
+
+		switch {
+		case fd.IsMap():
+
+		case fd.IsList() && isMessageKind(fd):
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				r.apply(list.Get(j).Message())
+			}
+
+		case fd.IsList() && isFloatKind(fd):
+			if r.changed() {
+				list := m.Mutable(fd).List()
+				for j := 0; j < list.Len(); j++ {
+					d := protowire.DecodeZigZag(r.varint())
+					list.Set(j, floatValue(fd, dequantize(quantize(list.Get(j).Float())+d)))
+				}
+				r.advance()
+			}
+
+		case fd.IsList():
+			if r.changed() {
+				n := r.varint()
+				if n > uint64(len(r.buf)) {
+					r.err = errMalformedDelta
+					return
+				}
+				m.Clear(fd)
+				if n > 0 {
+					list := m.Mutable(fd).List()
+					for j := uint64(0); j < n; j++ {
+						list.Append(r.scalar(fd))
+					}
+				}
+				r.advance()
+			}
+
+		case isMessageKind(fd):
+			if m.Has(fd) {
+				r.apply(m.Mutable(fd).Message())
+			}
+
+		default:
+			if r.changed() {
+				m.Set(fd, r.scalar(fd))
+				r.advance()
+			}
+		}
+	}
+}
+
+func (r *deltaReader) varint() uint64 {
+	v, n := protowire.ConsumeVarint(r.buf)
+	if n < 0 {
+		r.err = errMalformedDelta
+		return 0
+	}
+	r.buf = r.buf[n:]
+	return v
+}
+
+// scalar reads a scalar value of field fd, as written by appendScalar
+func (r *deltaReader) scalar(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(protowire.DecodeBool(r.varint()))
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(protowire.DecodeZigZag(r.varint())))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(protowire.DecodeZigZag(r.varint())))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(protowire.DecodeZigZag(r.varint()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(r.varint()))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(r.varint())
+	case protoreflect.FloatKind:
+		v, n := protowire.ConsumeFixed32(r.buf)
+		if n < 0 {
+			r.err = errMalformedDelta
+			return protoreflect.ValueOfFloat32(0)
+		}
+		r.buf = r.buf[n:]
+		return protoreflect.ValueOfFloat32(math.Float32frombits(v))
+	case protoreflect.DoubleKind:
+		v, n := protowire.ConsumeFixed64(r.buf)
+		if n < 0 {
+			r.err = errMalformedDelta
+			return protoreflect.ValueOfFloat64(0)
+		}
+		r.buf = r.buf[n:]
+		return protoreflect.ValueOfFloat64(math.Float64frombits(v))
+	case protoreflect.StringKind:
+		v, n := protowire.ConsumeString(r.buf)
+		if n < 0 {
+			r.err = errMalformedDelta
+			return protoreflect.ValueOfString("")
+		}
+		r.buf = r.buf[n:]
+		return protoreflect.ValueOfString(v)
+	default: // BytesKind
+		v, n := protowire.ConsumeBytes(r.buf)
+		if n < 0 {
+			r.err = errMalformedDelta
+			return protoreflect.ValueOfBytes(nil)
+		}
+		r.buf = r.buf[n:]
+		return protoreflect.ValueOfBytes(append([]byte(nil), v...))
+	}
+}
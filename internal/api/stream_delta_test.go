@@ -0,0 +1,137 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStreamHub_DeltaProtocol(t *testing.T) {
+	hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+	router := mux.NewRouter()
+	hub.RegisterRoutes(router)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	dialer := websocket.Dialer{Subprotocols: []string{DeltaSubprotocol}}
+	ws, _, err := dialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/stream", nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer ws.Close()
+	if ws.Subprotocol() != DeltaSubprotocol {
+		t.Fatalf("Subprotocol() = %q, want %q", ws.Subprotocol(), DeltaSubprotocol)
+	}
+
+	decoder := NewDeltaDecoder()
+	read := func(t *testing.T) (*DeltaMessage, int) {
+		t.Helper()
+		ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+		msgType, data, err := ws.ReadMessage()
+		if err != nil || msgType != websocket.BinaryMessage {
+			t.Fatalf("ReadMessage() = %d, %v, want a binary message", msgType, err)
+		}
+		msg, err := decoder.Decode(data)
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		return msg, len(data)
+	}
+	frame := func(index uint32, players int) *telemetry.LobbySessionStateFrame {
+		x := float64(index) * 0.01
+		session := &apigame.SessionResponse{
+			GameStatus: "playing",
+			GameClock:  300 - float64(index)/30,
+			Disc:       &apigame.Disc{Position: []float64{x, 1.23456789, -x}},
+			Teams:      []*apigame.Team{{TeamName: "blue"}},
+		}
+		bones := &apigame.PlayerBonesResponse{}
+		for i := 0; i < players; i++ {
+			session.Teams[0].Players = append(session.Teams[0].Players, &apigame.TeamMember{
+				AccountNumber: uint64(100 + i),
+				SlotNumber:    int32(i),
+				Head:          &apigame.BodyPart{Position: []float64{x, float64(i), 0.5}},
+			})
+			user := &apigame.UserBones{PlayerIndex: int32(i)}
+			for b := 0; b < 23; b++ {
+				user.BoneT = append(user.BoneT, float32(b)*0.1, float32(x), 0)
+				user.BoneO = append(user.BoneO, 0, 0, 0, 1)
+			}
+			bones.UserBones = append(bones.UserBones, user)
+		}
+		return &telemetry.LobbySessionStateFrame{FrameIndex: index, Session: session, PlayerBones: bones}
+	}
+	quantized := func(f *telemetry.LobbySessionStateFrame) *telemetry.LobbySessionStateFrame {
+		q := proto.Clone(f).(*telemetry.LobbySessionStateFrame)
+		quantizeMessage(q.ProtoReflect())
+		return q
+	}
+
+	// Status messages are JSON in a binary envelope
+	ws.WriteJSON(MuxRequest{Type: "subscribe", MatchID: "match-a", KeyframeInterval: 4})
+	msg, _ := read(t)
+	var reply MuxMessage
+	if msg.Kind != "json" || json.Unmarshal(msg.JSON, &reply) != nil || reply.Type != "subscribed" {
+		t.Fatalf("subscribe = %+v, want subscribed as json", msg)
+	}
+
+	// A keyframe starts the stream, followed by deltas until the interval
+	// is up or the players change
+	var (
+		index                   uint32
+		keyframeSize, deltaSize int
+	)
+	for _, tt := range []struct {
+		players int
+		kind    string
+	}{{2, "keyframe"}, {2, "delta"}, {2, "delta"}, {2, "delta"}, {2, "keyframe"}, {2, "delta"}, {3, "keyframe"}} {
+		index++
+		f := frame(index, tt.players)
+		hub.BroadcastFrame("match-a", "", f)
+		msg, size := read(t)
+		if msg.Kind != tt.kind || msg.MatchID != "match-a" {
+			t.Fatalf("frame %d = %s, want %s", index, msg.Kind, tt.kind)
+		}
+		if !proto.Equal(msg.Frame, quantized(f)) {
+			t.Fatalf("frame %d decoded as %v, want %v", index, msg.Frame, quantized(f))
+		}
+		switch msg.Kind {
+		case "keyframe":
+			keyframeSize = size
+		case "delta":
+			deltaSize = size
+		}
+	}
+	if deltaSize*4 > keyframeSize {
+		t.Errorf("delta of %d bytes, want well under the keyframe's %d", deltaSize, keyframeSize)
+	}
+
+	// Requested frames do not disturb the stream
+	ws.WriteJSON(MuxRequest{Type: "get_frames", MatchID: "match-a", Start: 1, End: 1})
+	if msg, _ := read(t); msg.Kind != "snapshot" || msg.Frame.GetFrameIndex() != 1 {
+		t.Fatalf("get_frames = %+v, want a snapshot of frame 1", msg)
+	}
+
+	ws.WriteJSON(MuxRequest{Type: "resync", MatchID: "match-a"})
+	time.Sleep(50 * time.Millisecond)
+	hub.BroadcastFrame("match-a", "", frame(100, 3))
+	if msg, _ := read(t); msg.Kind != "resync" || msg.Reason != "request" {
+		t.Fatalf("frame after resync = %+v, want a resync", msg)
+	}
+
+	// A decoder that missed the frame before a delta reports a gap
+	hub.BroadcastFrame("match-a", "", frame(101, 3))
+	_, data, _ := ws.ReadMessage()
+	if _, err := NewDeltaDecoder().Decode(data); !errors.Is(err, ErrDeltaGap) {
+		t.Errorf("Decode() of a delta without its base error = %v, want ErrDeltaGap", err)
+	}
+}
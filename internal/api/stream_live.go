@@ -0,0 +1,317 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/graph"
+	"github.com/gorilla/websocket"
+)
+
+// liveDirectoryInterval is how often directory clients are sent the clocks
+// and subscriber counts of live matches, and told of matches that stopped
+// sending frames. Score, status and roster changes are sent as they happen.
+const liveDirectoryInterval = time.Second
+
+// Live directory message types
+const (
+	liveMatchesMessage      = "live_matches"       // Every live match, on connecting
+	liveMatchMessage        = "live_match"         // A match that started or changed
+	liveMatchRemovedMessage = "live_match_removed" // A match that ended or stopped sending frames
+)
+
+// LiveMatchSummary is a live match in the directory of /api/v3/live
+type LiveMatchSummary struct {
+	MatchID          string       `json:"match_id"`
+	Node             string       `json:"node,omitempty"` // Node the frames are ingested from
+	MapName          string       `json:"map_name,omitempty"`
+	MatchType        string       `json:"match_type,omitempty"`
+	GameStatus       string       `json:"game_status,omitempty"`
+	GameClock        float64      `json:"game_clock"`
+	GameClockDisplay string       `json:"game_clock_display,omitempty"`
+	BluePoints       int32        `json:"blue_points"`
+	OrangePoints     int32        `json:"orange_points"`
+	BlueRoundScore   int32        `json:"blue_round_score,omitempty"`
+	OrangeRoundScore int32        `json:"orange_round_score,omitempty"`
+	Players          []LivePlayer `json:"players"`
+	Subscribers      int          `json:"subscribers"` // WebSocket subscriptions and per-match event streams on this instance
+	StartedAt        time.Time    `json:"started_at"`
+	LastFrameAt      time.Time    `json:"last_frame_at"`
+
+	// scores is the scoreKey of the latest frame
+	scores string
+}
+
+// LivePlayer is a player on the roster of a live match
+type LivePlayer struct {
+	AccountNumber uint64 `json:"account_number"`
+	DisplayName   string `json:"display_name"`
+	Team          string `json:"team,omitempty"`
+	JerseyNumber  int32  `json:"jersey_number,omitempty"`
+}
+
+// liveDirectoryUpdate is a message of the live directory, sent over
+// WebSocket and as Server-Sent Events named by type
+type liveDirectoryUpdate struct {
+	Type    string              `json:"type"`
+	Matches []*LiveMatchSummary `json:"matches,omitempty"`
+	Match   *LiveMatchSummary   `json:"match,omitempty"`
+	MatchID string              `json:"match_id,omitempty"`
+}
+
+// liveSummary returns the summary of a match if it is live
+func (h *StreamHub) liveSummary(stream *matchStream, now time.Time) *LiveMatchSummary {
+	h.mu.RLock()
+	viewers := h.sseViewers[stream.matchID]
+	h.mu.RUnlock()
+
+	stream.mu.RLock()
+	defer stream.mu.RUnlock()
+
+	if stream.ended || stream.lastFrameAt.IsZero() || now.Sub(stream.lastFrameAt) > liveMatchTimeout {
+		return nil
+	}
+	summary := &LiveMatchSummary{
+		MatchID:     stream.matchID,
+		Node:        stream.node,
+		MapName:     stream.mapName,
+		MatchType:   stream.matchType,
+		GameStatus:  stream.gameStatus,
+		Players:     []LivePlayer{},
+		Subscribers: len(stream.subscribers) + viewers,
+		StartedAt:   stream.startTime,
+		LastFrameAt: stream.lastFrameAt,
+	}
+	if len(stream.frames) == 0 {
+		return summary
+	}
+
+	session := stream.frames[len(stream.frames)-1].GetSession()
+	if session == nil {
+		return summary
+	}
+	summary.GameClock = session.GetGameClock()
+	summary.GameClockDisplay = session.GetGameClockDisplay()
+	summary.BluePoints = session.GetBluePoints()
+	summary.OrangePoints = session.GetOrangePoints()
+	summary.BlueRoundScore = session.GetBlueRoundScore()
+	summary.OrangeRoundScore = session.GetOrangeRoundScore()
+	summary.scores = scoreKey(session)
+	for _, team := range session.GetTeams() {
+		for _, m := range team.GetPlayers() {
+			summary.Players = append(summary.Players, LivePlayer{
+				AccountNumber: m.GetAccountNumber(),
+				DisplayName:   m.GetDisplayName(),
+				Team:          team.GetTeamName(),
+				JerseyNumber:  m.GetJerseyNumber(),
+			})
+		}
+	}
+	return summary
+}
+
+// LiveSummaries returns the summaries of the live matches, oldest first
+func (h *StreamHub) LiveSummaries() []*LiveMatchSummary {
+	h.mu.RLock()
+	streams := make([]*matchStream, 0, len(h.matches))
+	for _, stream := range h.matches {
+		streams = append(streams, stream)
+	}
+	h.mu.RUnlock()
+
+	now := time.Now()
+	summaries := make([]*LiveMatchSummary, 0, len(streams))
+	for _, stream := range streams {
+		if summary := h.liveSummary(stream, now); summary != nil {
+			summaries = append(summaries, summary)
+		}
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].StartedAt.Before(summaries[j].StartedAt)
+	})
+	return summaries
+}
+
+// addViewer counts an event stream of a match toward its subscribers
+func (h *StreamHub) addViewer(matchID string, n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sseViewers[matchID] += n
+	if h.sseViewers[matchID] <= 0 {
+		delete(h.sseViewers, matchID)
+	}
+}
+
+// liveDirectory follows the live matches for one directory client, and
+// decides which summaries it is sent
+type liveDirectory struct {
+	hub  *StreamHub
+	sent map[string]*LiveMatchSummary // Last summary sent, by match
+}
+
+func newLiveDirectory(hub *StreamHub) *liveDirectory {
+	return &liveDirectory{hub: hub, sent: make(map[string]*LiveMatchSummary)}
+}
+
+// snapshot returns every live match
+func (d *liveDirectory) snapshot() liveDirectoryUpdate {
+	summaries := d.hub.LiveSummaries()
+	for _, summary := range summaries {
+		d.sent[summary.MatchID] = summary
+	}
+	return liveDirectoryUpdate{Type: liveMatchesMessage, Matches: summaries}
+}
+
+// observe returns the update for a frame of a match, if the match is new
+// or its score, status or roster changed, and whether there is one
+func (d *liveDirectory) observe(live graph.LiveFrame, now time.Time) (liveDirectoryUpdate, bool) {
+	if live.Frame == nil {
+		if _, ok := d.sent[live.MatchID]; !ok {
+			return liveDirectoryUpdate{}, false
+		}
+		delete(d.sent, live.MatchID)
+		return liveDirectoryUpdate{Type: liveMatchRemovedMessage, MatchID: live.MatchID}, true
+	}
+
+	d.hub.mu.RLock()
+	stream := d.hub.matches[live.MatchID]
+	d.hub.mu.RUnlock()
+	if stream == nil {
+		return liveDirectoryUpdate{}, false
+	}
+	summary := d.hub.liveSummary(stream, now)
+	if summary == nil {
+		return liveDirectoryUpdate{}, false
+	}
+	if prev, ok := d.sent[live.MatchID]; ok && !prev.changed(summary, false) {
+		return liveDirectoryUpdate{}, false
+	}
+	d.sent[live.MatchID] = summary
+	return liveDirectoryUpdate{Type: liveMatchMessage, Match: summary}, true
+}
+
+// refresh returns the updates of the clocks and subscriber counts since the
+// last ones sent, and the removal of matches that are no longer live
+func (d *liveDirectory) refresh() []liveDirectoryUpdate {
+	var updates []liveDirectoryUpdate
+	live := make(map[string]struct{}, len(d.sent))
+	for _, summary := range d.hub.LiveSummaries() {
+		live[summary.MatchID] = struct{}{}
+		if prev, ok := d.sent[summary.MatchID]; ok && !prev.changed(summary, true) {
+			continue
+		}
+		d.sent[summary.MatchID] = summary
+		updates = append(updates, liveDirectoryUpdate{Type: liveMatchMessage, Match: summary})
+	}
+	for matchID := range d.sent {
+		if _, ok := live[matchID]; !ok {
+			delete(d.sent, matchID)
+			updates = append(updates, liveDirectoryUpdate{Type: liveMatchRemovedMessage, MatchID: matchID})
+		}
+	}
+	return updates
+}
+
+// changed reports whether a newer summary of the match differs from s in
+// what clients are told of as it happens, or, with periodic, in its clock
+// and subscriber count as well
+func (s *LiveMatchSummary) changed(next *LiveMatchSummary, periodic bool) bool {
+	if s.scores != next.scores || s.Node != next.Node || s.MapName != next.MapName ||
+		s.MatchType != next.MatchType || s.GameStatus != next.GameStatus ||
+		!slices.Equal(s.Players, next.Players) {
+		return true
+	}
+	return periodic && (s.GameClockDisplay != next.GameClockDisplay || s.GameClock != next.GameClock ||
+		s.Subscribers != next.Subscribers)
+}
+
+// handleLive serves /api/v3/live: the directory of live matches as JSON, or
+// kept up to date over WebSocket, or Server-Sent Events of the directory
+// and of every match's events
+func (h *StreamHub) handleLive(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case websocket.IsWebSocketUpgrade(r):
+		h.handleLiveWebSocket(w, r)
+	case wantsEventStream(r):
+		events := r.URL.Query().Get("events") != "false"
+		h.serveSSE(w, r, "", events, events)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(liveDirectoryUpdate{Type: liveMatchesMessage, Matches: h.LiveSummaries()})
+	}
+}
+
+// handleLiveWebSocket sends the live directory over WebSocket: every live
+// match on connecting, then the matches that start, change or end
+func (h *StreamHub) handleLiveWebSocket(w http.ResponseWriter, r *http.Request) {
+	ws, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Error("failed to upgrade websocket", "error", err)
+		return
+	}
+
+	conn := newStreamConn(ws, false)
+	defer conn.close()
+
+	if h.metrics != nil {
+		h.metrics.RecordWebSocketConnect()
+		defer h.metrics.RecordWebSocketDisconnect()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	frames := h.Observe(ctx, "")
+	dir := newLiveDirectory(h)
+
+	send := func(update liveDirectoryUpdate) bool {
+		data, err := json.Marshal(update)
+		if err != nil {
+			return false
+		}
+		select {
+		case conn.send <- data:
+			return true
+		case <-conn.closed:
+			return false
+		}
+	}
+
+	go conn.writePump(h.logger)
+	go func() {
+		defer conn.close()
+
+		ticker := time.NewTicker(liveDirectoryInterval)
+		defer ticker.Stop()
+
+		if !send(dir.snapshot()) {
+			return
+		}
+		for {
+			select {
+			case <-conn.closed:
+				return
+			case <-ticker.C:
+				for _, update := range dir.refresh() {
+					if !send(update) {
+						return
+					}
+				}
+			case live, ok := <-frames:
+				if !ok {
+					return
+				}
+				if update, ok := dir.observe(live, time.Now()); ok && !send(update) {
+					return
+				}
+			}
+		}
+	}()
+
+	// The directory takes no requests; reading detects the client leaving
+	conn.readPump(h, func([]byte) {})
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+func TestStreamHub_LiveDirectory(t *testing.T) {
+	hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+	router := mux.NewRouter()
+	hub.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	frame := func(index uint32, blue int32) *telemetry.LobbySessionStateFrame {
+		return &telemetry.LobbySessionStateFrame{
+			FrameIndex: index,
+			Session: &apigame.SessionResponse{
+				MapName:          "mpl_arena_a",
+				MatchType:        "Echo_Arena",
+				GameStatus:       "playing",
+				GameClockDisplay: "04:59.00",
+				BluePoints:       blue,
+				Teams: []*apigame.Team{{
+					TeamName: "BLUE TEAM",
+					Players:  []*apigame.TeamMember{{AccountNumber: 42, DisplayName: "Player42", JerseyNumber: 7}},
+				}},
+			},
+		}
+	}
+	hub.BroadcastFrame("match-a", "node-1", frame(1, 0))
+	hub.subscribe("match-a", newStreamConn(nil, true).newSubscriber("match-a", 0))
+
+	// Listing
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest("GET", "/api/v3/live", nil))
+	var listing liveDirectoryUpdate
+	if err := json.Unmarshal(rec.Body.Bytes(), &listing); err != nil {
+		t.Fatalf("listing = %s, %v", rec.Body, err)
+	}
+	if len(listing.Matches) != 1 {
+		t.Fatalf("listing = %s, want one match", rec.Body)
+	}
+	m := listing.Matches[0]
+	if m.Node != "node-1" || m.MapName != "mpl_arena_a" || m.GameClockDisplay != "04:59.00" || m.Subscribers != 1 ||
+		len(m.Players) != 1 || m.Players[0] != (LivePlayer{AccountNumber: 42, DisplayName: "Player42", Team: "BLUE TEAM", JerseyNumber: 7}) {
+		t.Errorf("listed match = %+v", m)
+	}
+
+	// Updates over WebSocket
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/api/v3/live", nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer ws.Close()
+	read := func(t *testing.T) liveDirectoryUpdate {
+		t.Helper()
+		ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+		var update liveDirectoryUpdate
+		if err := ws.ReadJSON(&update); err != nil {
+			t.Fatalf("ReadJSON() error = %v", err)
+		}
+		return update
+	}
+
+	if update := read(t); update.Type != liveMatchesMessage || len(update.Matches) != 1 {
+		t.Fatalf("first update = %+v, want the live matches", update)
+	}
+
+	// A new match and a score change are sent as they happen; a frame that
+	// only moves the clock is not
+	hub.BroadcastFrame("match-b", "node-2", frame(1, 0))
+	if update := read(t); update.Type != liveMatchMessage || update.Match.MatchID != "match-b" || update.Match.Node != "node-2" {
+		t.Fatalf("update = %+v, want match-b", update)
+	}
+	hub.BroadcastFrame("match-a", "node-1", frame(2, 0))
+	hub.BroadcastFrame("match-a", "node-1", frame(3, 1))
+	if update := read(t); update.Type != liveMatchMessage || update.Match.MatchID != "match-a" || update.Match.BluePoints != 1 {
+		t.Fatalf("update = %+v, want the score of match-a", update)
+	}
+
+	hub.CloseMatch("match-b")
+	if update := read(t); update.Type != liveMatchRemovedMessage || update.MatchID != "match-b" {
+		t.Fatalf("update = %+v, want match-b removed", update)
+	}
+}
@@ -0,0 +1,474 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// streamSendBuffer is the number of messages queued for each connection
+	streamSendBuffer = 256
+
+	// maxStreamSubscriptions caps the matches one connection can subscribe to
+	maxStreamSubscriptions = 64
+
+	// maxFrameRange caps the frames returned by one get_frames request
+	maxFrameRange = 1000
+
+	streamPingPeriod   = 30 * time.Second
+	streamReadDeadline = 60 * time.Second
+)
+
+// MuxRequest is a client message on the multiplexed stream (/ws/stream)
+type MuxRequest struct {
+	Type             string            `json:"type"` // subscribe, unsubscribe, seek, get_frames, pause, play, playback, step, resync
+	MatchID          string            `json:"match_id"`
+	FPS              int               `json:"fps,omitempty"`               // subscribe: frame rate cap, 0 sends every frame
+	Filters          *StreamFilter     `json:"filters,omitempty"`           // subscribe
+	Projection       *StreamProjection `json:"projection,omitempty"`        // subscribe: the parts of each frame to send
+	KeyframeInterval int               `json:"keyframe_interval,omitempty"` // subscribe: frames between keyframes on the delta protocol
+	FrameIndex       uint32            `json:"frame_index,omitempty"`       // seek
+	Time             string            `json:"time,omitempty"`              // seek: game clock, "MM:SS"
+	Round            int               `json:"round,omitempty"`             // seek
+	Offset           string            `json:"offset,omitempty"`            // seek: wall-clock offset, e.g. "5m30s"
+	Start            uint32            `json:"start,omitempty"`             // get_frames: first frame index
+	End              uint32            `json:"end,omitempty"`               // get_frames: last frame index
+	Speed            float64           `json:"speed,omitempty"`             // playback: 0.25 to 8
+	Mode             string            `json:"mode,omitempty"`              // playback: realtime or lossless
+	Step             int               `json:"step,omitempty"`              // step: frames, negative to step back
+}
+
+// MuxMessage is a server message on the multiplexed stream. Every message
+// except connection errors carries the match it belongs to.
+type MuxMessage struct {
+	Type       string          `json:"type"` // frame, subscribed, unsubscribed, seeked, frames_dropped, match_ended, stream_ended, error
+	MatchID    string          `json:"match_id,omitempty"`
+	FrameIndex *uint32         `json:"frame_index,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	FrameCount *int            `json:"frame_count,omitempty"`
+	IsLive     *bool           `json:"is_live,omitempty"`
+	Dropped    *int            `json:"dropped,omitempty"` // frames_dropped: frames dropped since the last notice
+	Error      string          `json:"error,omitempty"`
+}
+
+// StreamFilter selects the frames a subscription receives
+type StreamFilter struct {
+	EventsOnly bool     `json:"events_only,omitempty"` // Only frames with events
+	GameStatus []string `json:"game_status,omitempty"` // Only frames in one of these game statuses, e.g. "playing"
+}
+
+// matches reports whether frame passes the filter
+func (f StreamFilter) matches(frame *telemetry.LobbySessionStateFrame) bool {
+	if f.EventsOnly && len(frame.GetEvents()) == 0 {
+		return false
+	}
+	if len(f.GameStatus) > 0 {
+		status := frame.GetSession().GetGameStatus()
+		for _, s := range f.GameStatus {
+			if s == status {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// streamConn is a stream WebSocket connection. Connections to the per-match
+// endpoint carry one subscription; multiplexed connections carry any number,
+// and tag their messages with the match ID. Connections that negotiated
+// DeltaSubprotocol receive binary messages.
+type streamConn struct {
+	ws        *websocket.Conn
+	send      chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+	tagged    bool
+	binary    bool
+
+	mu   sync.Mutex
+	subs map[string]*streamSubscriber
+
+	// Latest frames of subscriptions that fell behind under the coalesce
+	// policy, written once the send buffer drains; flush is signalled when
+	// one is held
+	held  map[*streamSubscriber][]byte
+	flush chan struct{}
+}
+
+func newStreamConn(ws *websocket.Conn, tagged bool) *streamConn {
+	return &streamConn{
+		ws:     ws,
+		send:   make(chan []byte, streamSendBuffer),
+		closed: make(chan struct{}),
+		tagged: tagged,
+		binary: ws != nil && ws.Subprotocol() == DeltaSubprotocol,
+		subs:   make(map[string]*streamSubscriber),
+		flush:  make(chan struct{}, 1),
+	}
+}
+
+// close stops the write pump; it is called when either pump ends
+func (c *streamConn) close() {
+	c.closeOnce.Do(func() { close(c.closed) })
+}
+
+// newSubscriber creates a subscription of the connection to matchID
+func (c *streamConn) newSubscriber(matchID string, frameRate int) *streamSubscriber {
+	sub := &streamSubscriber{
+		conn:      c,
+		matchID:   matchID,
+		frameRate: frameRate,
+		send:      c.send,
+		done:      make(chan struct{}),
+		steps:     make(chan int, 8),
+		wake:      make(chan struct{}, 1),
+	}
+	if c.binary {
+		sub.delta = &deltaState{interval: defaultKeyframeInterval}
+	}
+	return sub
+}
+
+// frameMessage wraps an encoded frame in the message format of the connection
+func (c *streamConn) frameMessage(matchID string, frame *telemetry.LobbySessionStateFrame, frameBytes []byte) ([]byte, error) {
+	if !c.tagged {
+		return json.Marshal(StreamMessage{Type: "frame", Payload: frameBytes})
+	}
+	frameIndex := frame.GetFrameIndex()
+	return json.Marshal(MuxMessage{
+		Type:       "frame",
+		MatchID:    matchID,
+		FrameIndex: &frameIndex,
+		Data:       frameBytes,
+	})
+}
+
+// statusMessage encodes a message without payload, such as match_ended
+func (c *streamConn) statusMessage(msgType, matchID string) []byte {
+	var msg any = StreamMessage{Type: msgType}
+	if c.tagged {
+		msg = MuxMessage{Type: msgType, MatchID: matchID}
+	}
+	data, _ := json.Marshal(msg)
+	return data
+}
+
+// reply queues a message, waiting for room rather than dropping it
+func (c *streamConn) reply(msg MuxMessage) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	select {
+	case c.send <- data:
+	case <-c.closed:
+	}
+}
+
+// writePump sends messages to the WebSocket
+func (c *streamConn) writePump(logger Logger) {
+	ticker := time.NewTicker(streamPingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.ws.Close()
+		c.close()
+	}()
+
+	write := func(message []byte) error {
+		msgType := websocket.TextMessage
+		if c.binary {
+			// Frames are already binary; other messages are JSON
+			msgType = websocket.BinaryMessage
+			if len(message) > 0 && message[0] == '{' {
+				message = append([]byte{deltaKindJSON}, message...)
+			}
+		}
+		c.ws.SetWriteDeadline(time.Now().Add(10 * time.Second))
+		return c.ws.WriteMessage(msgType, message)
+	}
+
+	for {
+		select {
+		case <-c.closed:
+			return
+		case message := <-c.send:
+			if err := write(message); err != nil {
+				logger.Debug("failed to write message", "error", err)
+				return
+			}
+			if len(c.send) > 0 {
+				continue
+			}
+		case <-c.flush:
+			if len(c.send) > 0 {
+				// Held frames follow those queued before them
+				continue
+			}
+		case <-ticker.C:
+			// Ping to keep connection alive
+			c.ws.SetWriteDeadline(time.Now().Add(10 * time.Second))
+			if err := c.ws.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+			continue
+		}
+
+		// The send buffer drained; catch up subscriptions that fell behind
+		for _, message := range c.takeHeld() {
+			if err := write(message); err != nil {
+				logger.Debug("failed to write message", "error", err)
+				return
+			}
+		}
+	}
+}
+
+// readPump reads messages from the WebSocket and passes them to handle until
+// the connection fails
+func (c *streamConn) readPump(hub *StreamHub, handle func([]byte)) {
+	defer c.ws.Close()
+
+	c.ws.SetReadLimit(64 * 1024) // 64KB
+	c.ws.SetReadDeadline(time.Now().Add(streamReadDeadline))
+	c.ws.SetPongHandler(func(string) error {
+		c.ws.SetReadDeadline(time.Now().Add(streamReadDeadline))
+		return nil
+	})
+
+	for {
+		_, message, err := c.ws.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				hub.logger.Debug("websocket read error", "error", err)
+			}
+			return
+		}
+		handle(message)
+	}
+}
+
+// accept reports whether frame is sent to the subscriber, and if so counts it
+// against the subscriber's frame rate and returns the projection to send
+func (s *streamSubscriber) accept(frame *telemetry.LobbySessionStateFrame, now time.Time) (*streamProjection, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.paused || s.replaying || !s.filter.matches(frame) {
+		return nil, false
+	}
+	if s.minInterval > 0 {
+		if now.Sub(s.lastSent) < s.minInterval {
+			return nil, false
+		}
+		s.lastSent = now
+	}
+	return s.projection, true
+}
+
+// handleMuxConnection handles multiplexed WebSocket connections, which
+// subscribe to and leave any number of matches with MuxRequest messages
+func (h *StreamHub) handleMuxConnection(w http.ResponseWriter, r *http.Request) {
+	ws, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Error("failed to upgrade websocket", "error", err)
+		return
+	}
+
+	conn := newStreamConn(ws, true)
+	defer conn.close()
+	defer func() {
+		conn.mu.Lock()
+		subs := conn.subs
+		conn.subs = nil
+		conn.mu.Unlock()
+		for matchID, sub := range subs {
+			h.unsubscribe(matchID, sub)
+		}
+	}()
+
+	if h.metrics != nil {
+		h.metrics.RecordWebSocketConnect()
+		defer h.metrics.RecordWebSocketDisconnect()
+	}
+
+	go conn.writePump(h.logger)
+	conn.readPump(h, func(message []byte) {
+		var req MuxRequest
+		if err := json.Unmarshal(message, &req); err != nil {
+			conn.reply(MuxMessage{Type: "error", Error: "invalid message: " + err.Error()})
+			return
+		}
+		if req.MatchID == "" {
+			conn.reply(MuxMessage{Type: "error", Error: "match_id is required"})
+			return
+		}
+		h.handleMuxRequest(conn, req)
+	})
+}
+
+// handleMuxRequest handles a message of a multiplexed connection
+func (h *StreamHub) handleMuxRequest(conn *streamConn, req MuxRequest) {
+	conn.mu.Lock()
+	sub, subscribed := conn.subs[req.MatchID]
+	conn.mu.Unlock()
+
+	if req.Type != "subscribe" && !subscribed {
+		conn.reply(MuxMessage{Type: "error", MatchID: req.MatchID, Error: "not subscribed to match"})
+		return
+	}
+
+	switch req.Type {
+	case "subscribe":
+		h.muxSubscribe(conn, req, sub)
+
+	case "unsubscribe":
+		conn.mu.Lock()
+		delete(conn.subs, req.MatchID)
+		conn.mu.Unlock()
+		h.unsubscribe(req.MatchID, sub)
+		conn.reply(MuxMessage{Type: "unsubscribed", MatchID: req.MatchID})
+
+	case "pause", "play":
+		sub.setPaused(req.Type == "pause")
+
+	case "playback":
+		if err := sub.setPlayback(req.Speed, req.Mode); err != nil {
+			conn.reply(MuxMessage{Type: "error", MatchID: req.MatchID, Error: err.Error()})
+		}
+
+	case "step":
+		if err := sub.step(req.Step); err != nil {
+			conn.reply(MuxMessage{Type: "error", MatchID: req.MatchID, Error: err.Error()})
+		}
+
+	case "seek":
+		sub.seek(h, SeekRequest{Frame: req.FrameIndex, Time: req.Time, Round: req.Round, Offset: req.Offset})
+
+	case "get_frames":
+		h.sendFrameRange(sub, req.Start, req.End)
+
+	case "resync":
+		sub.requestResync(resyncRequest)
+
+	default:
+		conn.reply(MuxMessage{Type: "error", MatchID: req.MatchID, Error: "unknown message type: " + req.Type})
+	}
+}
+
+// muxSubscribe subscribes conn to a match, or updates the frame rate and
+// filters of an existing subscription
+func (h *StreamHub) muxSubscribe(conn *streamConn, req MuxRequest, sub *streamSubscriber) {
+	fps := req.FPS
+	if fps < 0 {
+		fps = 0
+	}
+	if h.maxFrameRate > 0 && fps > h.maxFrameRate {
+		fps = h.maxFrameRate
+	}
+	var minInterval time.Duration
+	if fps > 0 {
+		minInterval = time.Second / time.Duration(fps)
+	}
+	var filter StreamFilter
+	if req.Filters != nil {
+		filter = *req.Filters
+	}
+	projection, err := newStreamProjection(req.Projection)
+	if err != nil {
+		conn.reply(MuxMessage{Type: "error", MatchID: req.MatchID, Error: err.Error()})
+		return
+	}
+
+	if sub != nil {
+		sub.mu.Lock()
+		sub.frameRate = fps
+		sub.minInterval = minInterval
+		sub.filter = filter
+		sub.projection = projection
+		sub.mu.Unlock()
+		sub.setKeyframeInterval(req.KeyframeInterval, h.keyframeInterval)
+	} else {
+		conn.mu.Lock()
+		if len(conn.subs) >= maxStreamSubscriptions {
+			conn.mu.Unlock()
+			conn.reply(MuxMessage{Type: "error", MatchID: req.MatchID, Error: "too many subscriptions"})
+			return
+		}
+		sub = conn.newSubscriber(req.MatchID, fps)
+		sub.minInterval = minInterval
+		sub.filter = filter
+		sub.projection = projection
+		conn.subs[req.MatchID] = sub
+		conn.mu.Unlock()
+		sub.setKeyframeInterval(req.KeyframeInterval, h.keyframeInterval)
+
+		h.subscribe(req.MatchID, sub)
+	}
+
+	frameCount, isLive := h.streamState(req.MatchID)
+	conn.reply(MuxMessage{Type: "subscribed", MatchID: req.MatchID, FrameCount: &frameCount, IsLive: &isLive})
+}
+
+// streamState returns the number of buffered frames of a match and whether
+// it is live
+func (h *StreamHub) streamState(matchID string) (frameCount int, live bool) {
+	h.mu.RLock()
+	stream, exists := h.matches[matchID]
+	h.mu.RUnlock()
+	if !exists {
+		return 0, false
+	}
+
+	stream.mu.RLock()
+	defer stream.mu.RUnlock()
+	live = !stream.ended && !stream.lastFrameAt.IsZero() && time.Since(stream.lastFrameAt) <= liveMatchTimeout
+	return len(stream.frames), live
+}
+
+// sendFrameRange sends the buffered frames of the subscriber's match with
+// indices from start to end, up to maxFrameRange of them
+func (h *StreamHub) sendFrameRange(sub *streamSubscriber, start, end uint32) {
+	h.mu.RLock()
+	stream, exists := h.matches[sub.matchID]
+	h.mu.RUnlock()
+	if !exists {
+		return
+	}
+
+	stream.mu.RLock()
+	frames := make([]*telemetry.LobbySessionStateFrame, 0)
+	for _, frame := range stream.frames {
+		if i := frame.GetFrameIndex(); i >= start && i <= end {
+			frames = append(frames, frame)
+			if len(frames) == maxFrameRange {
+				break
+			}
+		}
+	}
+	stream.mu.RUnlock()
+
+	sub.mu.Lock()
+	projection := sub.projection
+	sub.mu.Unlock()
+
+	for _, frame := range frames {
+		msgBytes, err := newFrameEncoder(sub.matchID, frame).snapshot(sub, projection)
+		if err != nil || msgBytes == nil {
+			continue
+		}
+		// Requested frames wait for room rather than being dropped
+		select {
+		case sub.send <- msgBytes:
+		case <-sub.done:
+			return
+		case <-sub.conn.closed:
+			return
+		}
+	}
+}
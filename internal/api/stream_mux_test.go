@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+func TestStreamHub_MultiplexedSubscriptions(t *testing.T) {
+	hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+	router := mux.NewRouter()
+	hub.RegisterRoutes(router)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/stream", nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer ws.Close()
+
+	read := func(t *testing.T) MuxMessage {
+		t.Helper()
+		var msg MuxMessage
+		ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+		if err := ws.ReadJSON(&msg); err != nil {
+			t.Fatalf("ReadJSON() error = %v", err)
+		}
+		return msg
+	}
+	frame := func(index uint32, status string) *telemetry.LobbySessionStateFrame {
+		return &telemetry.LobbySessionStateFrame{FrameIndex: index, Session: &apigame.SessionResponse{GameStatus: status}}
+	}
+
+	ws.WriteJSON(MuxRequest{Type: "subscribe", MatchID: "match-a"})
+	if msg := read(t); msg.Type != "subscribed" || msg.MatchID != "match-a" {
+		t.Fatalf("subscribe match-a = %+v, want subscribed", msg)
+	}
+	ws.WriteJSON(MuxRequest{Type: "subscribe", MatchID: "match-b", Filters: &StreamFilter{GameStatus: []string{"playing"}}})
+	if msg := read(t); msg.Type != "subscribed" || msg.MatchID != "match-b" {
+		t.Fatalf("subscribe match-b = %+v, want subscribed", msg)
+	}
+
+	// Frames are tagged by match, and match-b only gets frames in play
+	hub.BroadcastFrame("match-b", "", frame(1, "pre_match"))
+	hub.BroadcastFrame("match-a", "", frame(7, "playing"))
+	hub.BroadcastFrame("match-b", "", frame(2, "playing"))
+	for _, want := range []struct {
+		matchID string
+		index   uint32
+	}{{"match-a", 7}, {"match-b", 2}} {
+		msg := read(t)
+		if msg.Type != "frame" || msg.MatchID != want.matchID || msg.FrameIndex == nil || *msg.FrameIndex != want.index {
+			t.Fatalf("message = %+v, want frame %d of %s", msg, want.index, want.matchID)
+		}
+	}
+
+	ws.WriteJSON(MuxRequest{Type: "unsubscribe", MatchID: "match-a"})
+	if msg := read(t); msg.Type != "unsubscribed" || msg.MatchID != "match-a" {
+		t.Fatalf("unsubscribe = %+v, want unsubscribed", msg)
+	}
+	hub.BroadcastFrame("match-a", "", frame(8, "playing"))
+	hub.BroadcastFrame("match-b", "", frame(3, "playing"))
+	if msg := read(t); msg.MatchID != "match-b" || *msg.FrameIndex != 3 {
+		t.Fatalf("message after unsubscribe = %+v, want frame 3 of match-b", msg)
+	}
+
+	// The buffered history of a match can be requested by range
+	ws.WriteJSON(MuxRequest{Type: "get_frames", MatchID: "match-b", Start: 1, End: 2})
+	for _, want := range []uint32{1, 2} {
+		if msg := read(t); msg.Type != "frame" || *msg.FrameIndex != want {
+			t.Fatalf("get_frames message = %+v, want frame %d", msg, want)
+		}
+	}
+}
@@ -0,0 +1,430 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+// Playback modes, which decide what happens when a subscriber cannot keep up
+const (
+	// PlaybackRealtime keeps to the frames' timestamps, dropping frames the
+	// subscriber has no room for
+	PlaybackRealtime = "realtime"
+
+	// PlaybackLossless sends every frame, slowing playback down to the pace
+	// of the subscriber
+	PlaybackLossless = "lossless"
+)
+
+const (
+	minPlaybackSpeed = 0.25
+	maxPlaybackSpeed = 8
+
+	// maxPlaybackGap caps the wait between two frames, so that gaps in a
+	// recording do not stall its playback
+	maxPlaybackGap = 5 * time.Second
+
+	// maxPlaybackLag is how late a frame may be in realtime mode before it is
+	// dropped to catch up
+	maxPlaybackLag = 250 * time.Millisecond
+
+	// stepHistory is the number of played frames kept for stepping back
+	stepHistory = 300
+)
+
+// ReplayMatch replays a stored match to a subscriber from its first frame,
+// paced by the frames' timestamps at the subscriber's playback speed
+func (h *StreamHub) ReplayMatch(ctx context.Context, matchID string, sub *streamSubscriber) error {
+	cursor, err := h.captureCursor(matchID, nil)
+	if err != nil {
+		return err
+	}
+	return sub.playback(ctx, h, cursor, sub.beginPlayback())
+}
+
+// beginPlayback stops any playback in progress and holds back live frames
+// for a new one, which ends when stop is closed
+func (s *streamSubscriber) beginPlayback() (stop chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.playbackStop != nil {
+		close(s.playbackStop)
+	}
+	s.playbackStop = make(chan struct{})
+	s.replaying = true
+	if s.delta != nil && s.delta.ref != nil {
+		s.delta.resync = resyncSeek
+	}
+	return s.playbackStop
+}
+
+// endPlayback returns the subscriber to the live stream, unless a newer
+// playback has replaced the one that stop belongs to
+func (s *streamSubscriber) endPlayback(stop chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.playbackStop == stop {
+		close(s.playbackStop)
+		s.playbackStop = nil
+		s.replaying = false
+	}
+}
+
+// notify sends a message on multiplexed connections; per-match connections
+// have no message for it
+func (s *streamSubscriber) notify(msg MuxMessage) {
+	if s.conn.tagged {
+		s.conn.reply(msg)
+	}
+}
+
+// wakePlayback tells a playback in progress that its settings changed
+func (s *streamSubscriber) wakePlayback() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// setPaused pauses or resumes the subscription
+func (s *streamSubscriber) setPaused(paused bool) {
+	s.mu.Lock()
+	s.paused = paused
+	s.mu.Unlock()
+	s.wakePlayback()
+}
+
+// setPlayback sets the speed and mode of playback. A zero speed or empty
+// mode leaves that setting unchanged.
+func (s *streamSubscriber) setPlayback(speed float64, mode string) error {
+	if speed != 0 && (speed < minPlaybackSpeed || speed > maxPlaybackSpeed) {
+		return fmt.Errorf("speed must be between %gx and %gx", float64(minPlaybackSpeed), float64(maxPlaybackSpeed))
+	}
+	if mode != "" && mode != PlaybackRealtime && mode != PlaybackLossless {
+		return fmt.Errorf("unknown playback mode %q", mode)
+	}
+
+	s.mu.Lock()
+	if speed != 0 {
+		s.speed = speed
+	}
+	if mode != "" {
+		s.lossless = mode == PlaybackLossless
+	}
+	s.mu.Unlock()
+	s.wakePlayback()
+	return nil
+}
+
+// step pauses playback and moves it by n frames, back if n is negative
+func (s *streamSubscriber) step(n int) error {
+	s.mu.Lock()
+	replaying := s.replaying
+	if replaying {
+		s.paused = true
+	}
+	s.mu.Unlock()
+
+	if !replaying {
+		return errors.New("step requires a playback; seek first")
+	}
+	if n == 0 {
+		return nil
+	}
+	select {
+	case s.steps <- n:
+		return nil
+	default:
+		return errors.New("too many pending steps")
+	}
+}
+
+// playbackInterval returns the time between frames that have no timestamps
+func (s *streamSubscriber) playbackInterval() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.frameRate > 0 {
+		return time.Second / time.Duration(s.frameRate)
+	}
+	return time.Second / defaultPlaybackFPS
+}
+
+// pacer schedules the frames of a playback by their timestamps, each
+// relative to the frame before it
+type pacer struct {
+	due time.Time // When the last frame was due; zero to send the next now
+	ts  time.Time // Timestamp of the last frame, zero without one
+}
+
+// next returns when frame is due at speed. Frames without timestamps follow
+// the last one by interval.
+func (p *pacer) next(frame *telemetry.LobbySessionStateFrame, speed float64, interval time.Duration) (due, ts time.Time) {
+	if frame.GetTimestamp() != nil {
+		ts = frame.GetTimestamp().AsTime()
+	}
+	if p.due.IsZero() {
+		return time.Now(), ts
+	}
+
+	gap := interval
+	if !ts.IsZero() && !p.ts.IsZero() && !ts.Before(p.ts) {
+		gap = time.Duration(float64(ts.Sub(p.ts)) / speed)
+	}
+	if gap > maxPlaybackGap {
+		gap = maxPlaybackGap
+	}
+	return p.due.Add(gap), ts
+}
+
+// player holds the position of a playback. Played frames are kept so that
+// a paused playback can step back through them.
+type player struct {
+	hub     *StreamHub
+	sub     *streamSubscriber
+	cursor  frameCursor
+	history []*telemetry.LobbySessionStateFrame
+	pos     int // Position in history of the last frame played, or -1
+	seeked  bool
+	lastDue time.Time // When the last frame sent was due
+	dropped int       // Frames dropped since the subscriber was last told
+}
+
+// peek returns the next frame to play, reading it from the cursor if needed
+func (p *player) peek() (*telemetry.LobbySessionStateFrame, error) {
+	if p.pos+1 < len(p.history) {
+		return p.history[p.pos+1], nil
+	}
+	frame, err := p.cursor.next()
+	if err != nil {
+		return nil, err
+	}
+	p.history = append(p.history, frame)
+	if len(p.history) > stepHistory {
+		p.history = append(p.history[:0], p.history[1:]...)
+		p.pos--
+	}
+	return frame, nil
+}
+
+// play sends the frame a playback reaches at its time. Faster playback
+// speeds are still held to the subscriber's frame rate.
+func (p *player) play(ctx context.Context, frame *telemetry.LobbySessionStateFrame, due time.Time, lossless bool, stop chan struct{}) {
+	p.sub.mu.Lock()
+	minInterval := p.sub.minInterval
+	p.sub.mu.Unlock()
+
+	if minInterval > 0 && due.Sub(p.lastDue) < minInterval {
+		p.announce(frame)
+		return
+	}
+	p.lastDue = due
+	p.send(ctx, frame, lossless, stop)
+}
+
+// announce tells the subscriber where playback starts, before its first frame
+func (p *player) announce(frame *telemetry.LobbySessionStateFrame) {
+	if !p.seeked {
+		frameIndex := frame.GetFrameIndex()
+		p.sub.notify(MuxMessage{Type: "seeked", MatchID: p.sub.matchID, FrameIndex: &frameIndex})
+		p.seeked = true
+	}
+}
+
+// send sends frame to the subscriber if it passes the subscriber's filter.
+// When wait is set, it waits for room in the send buffer; otherwise the
+// frame is dropped if there is none.
+func (p *player) send(ctx context.Context, frame *telemetry.LobbySessionStateFrame, wait bool, stop chan struct{}) {
+	s := p.sub
+	p.announce(frame)
+
+	s.mu.Lock()
+	filter, projection := s.filter, s.projection
+	s.mu.Unlock()
+	if !filter.matches(frame) {
+		return
+	}
+
+	msgBytes, err := newFrameEncoder(s.matchID, frame).encode(s, projection)
+	if err != nil || msgBytes == nil {
+		return
+	}
+
+	if wait {
+		select {
+		case s.send <- msgBytes:
+		case <-ctx.Done():
+		case <-s.done:
+		case <-stop:
+		}
+		return
+	}
+
+	p.reportDropped(false)
+	select {
+	case s.send <- msgBytes:
+	default:
+		p.dropped++
+		s.markGap()
+		p.hub.recordDrops(s, dropReasonPlayback, 1)
+	}
+}
+
+// reportDropped tells the subscriber how many frames were dropped since it
+// was last told. Unless wait is set, it gives up if the send buffer is full.
+func (p *player) reportDropped(wait bool) {
+	if p.dropped == 0 {
+		return
+	}
+	msg := p.sub.conn.droppedMessage(p.sub.matchID, p.dropped)
+	if wait {
+		p.sub.sendStatus(msg)
+		p.dropped = 0
+		return
+	}
+	select {
+	case p.sub.send <- msg:
+		p.dropped = 0
+	default:
+	}
+}
+
+// sendStatus queues a message that must not be dropped, such as the end of
+// a stream, waiting for room in the send buffer
+func (s *streamSubscriber) sendStatus(msg []byte) {
+	select {
+	case s.send <- msg:
+	case <-s.done:
+	case <-s.conn.closed:
+	}
+}
+
+// step moves the playback by n frames and sends the frame it lands on
+func (p *player) step(ctx context.Context, n int, stop chan struct{}) error {
+	moved := 0
+	for ; moved < n; moved++ {
+		if _, err := p.peek(); err != nil {
+			break
+		}
+		p.pos++
+	}
+	for ; moved > n && p.pos > 0; moved-- {
+		p.pos--
+	}
+
+	switch {
+	case moved == 0 && n > 0:
+		return errors.New("no later frame to step to")
+	case moved == 0:
+		return errors.New("no earlier frame to step to")
+	}
+	p.send(ctx, p.history[p.pos], true, stop)
+	return nil
+}
+
+// playback sends the frames of cursor to the subscriber, paced by their
+// timestamps, until the cursor reaches the end of the match or its live
+// edge, or stop is closed
+func (s *streamSubscriber) playback(ctx context.Context, hub *StreamHub, cursor frameCursor, stop chan struct{}) error {
+	defer cursor.close()
+
+	p := &player{
+		hub:    hub,
+		sub:    s,
+		cursor: cursor,
+		pos:    -1,
+	}
+	var pace pacer
+
+	for {
+		s.mu.Lock()
+		paused, speed, lossless := s.paused, s.speed, s.lossless
+		s.mu.Unlock()
+		if speed == 0 {
+			speed = 1
+		}
+
+		var (
+			wait  <-chan time.Time
+			timer *time.Timer
+			due   time.Time
+			ts    time.Time
+		)
+		if paused {
+			// Playback resumes with the next frame right away
+			pace.due = time.Time{}
+		} else {
+			frame, err := p.peek()
+			if err != nil {
+				p.reportDropped(true)
+			}
+			if errors.Is(err, errLiveEdge) {
+				s.endPlayback(stop)
+				return nil
+			}
+			if err != nil {
+				s.endPlayback(stop)
+				if err == io.EOF {
+					s.sendStatus(s.conn.statusMessage("stream_ended", s.matchID))
+					return nil
+				}
+				return fmt.Errorf("failed to read frame: %w", err)
+			}
+			due, ts = pace.next(frame, speed, s.playbackInterval())
+			timer = time.NewTimer(time.Until(due))
+			wait = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			s.endPlayback(stop)
+			return ctx.Err()
+		case <-s.done:
+			return nil
+		case <-stop:
+			return nil
+		case <-s.wake:
+			// Paused, resumed or changed speed; the next frame is rescheduled
+		case n := <-s.steps:
+			if err := p.step(ctx, n, stop); err != nil {
+				s.notify(MuxMessage{Type: "error", MatchID: s.matchID, Error: err.Error()})
+			}
+		case <-wait:
+			p.pos++
+			pace.due, pace.ts = due, ts
+
+			frame := p.history[p.pos]
+			if !lossless && time.Since(due) > maxPlaybackLag {
+				// Too far behind the frames' timestamps to send them all
+				p.dropped++
+				hub.recordDrops(s, dropReasonPlayback, 1)
+				continue
+			}
+			p.play(ctx, frame, due, lossless, stop)
+			if lossless && time.Since(due) > maxPlaybackLag {
+				// The subscriber held playback back; carry on from now
+				pace.due = time.Now()
+			}
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// droppedMessage encodes a notice of frames dropped for a slow subscriber
+func (c *streamConn) droppedMessage(matchID string, dropped int) []byte {
+	var msg any = StreamMessage{Type: "frames_dropped", Payload: json.RawMessage(fmt.Sprintf(`{"dropped":%d}`, dropped))}
+	if c.tagged {
+		msg = MuxMessage{Type: "frames_dropped", MatchID: matchID, Dropped: &dropped}
+	}
+	data, _ := json.Marshal(msg)
+	return data
+}
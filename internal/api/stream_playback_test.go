@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sliceCursor plays a fixed list of frames, then ends the match
+type sliceCursor struct {
+	frames []*telemetry.LobbySessionStateFrame
+}
+
+func (c *sliceCursor) next() (*telemetry.LobbySessionStateFrame, error) {
+	if len(c.frames) == 0 {
+		return nil, io.EOF
+	}
+	frame := c.frames[0]
+	c.frames = c.frames[1:]
+	return frame, nil
+}
+
+func (c *sliceCursor) close() {}
+
+// timedFrames returns n frames recorded interval apart
+func timedFrames(n int, interval time.Duration) *sliceCursor {
+	start := time.Now()
+	c := &sliceCursor{}
+	for i := 0; i < n; i++ {
+		c.frames = append(c.frames, &telemetry.LobbySessionStateFrame{
+			FrameIndex: uint32(i + 1),
+			Timestamp:  timestamppb.New(start.Add(time.Duration(i) * interval)),
+		})
+	}
+	return c
+}
+
+func TestStreamSubscriber_Playback(t *testing.T) {
+	hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+	newSub := func() *streamSubscriber {
+		return newStreamConn(nil, true).newSubscriber("match-a", 0)
+	}
+	read := func(t *testing.T, sub *streamSubscriber) MuxMessage {
+		t.Helper()
+		select {
+		case data := <-sub.send:
+			var msg MuxMessage
+			json.Unmarshal(data, &msg)
+			return msg
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for a message")
+			return MuxMessage{}
+		}
+	}
+	play := func(sub *streamSubscriber, cursor frameCursor) {
+		stop := sub.beginPlayback()
+		go sub.playback(context.Background(), hub, cursor, stop)
+	}
+
+	t.Run("paced by timestamps", func(t *testing.T) {
+		sub := newSub()
+		if err := sub.setPlayback(16, ""); err == nil {
+			t.Error("setPlayback(16) accepted a speed above 8x")
+		}
+		sub.setPlayback(4, "")
+
+		// Five frames 100ms apart take 100ms at 4x
+		start := time.Now()
+		play(sub, timedFrames(5, 100*time.Millisecond))
+		for msg := read(t, sub); msg.Type != "stream_ended"; msg = read(t, sub) {
+		}
+		if elapsed := time.Since(start); elapsed < 90*time.Millisecond || elapsed > 350*time.Millisecond {
+			t.Errorf("playback took %v, want about 100ms", elapsed)
+		}
+	})
+
+	t.Run("steps while paused", func(t *testing.T) {
+		sub := newSub()
+		sub.setPaused(true)
+		play(sub, timedFrames(5, time.Hour))
+
+		for _, tt := range []struct {
+			step int
+			want uint32
+		}{{2, 2}, {-1, 1}, {1, 2}} {
+			if err := sub.step(tt.step); err != nil {
+				t.Fatalf("step(%d) error = %v", tt.step, err)
+			}
+			msg := read(t, sub)
+			if msg.Type == "seeked" {
+				msg = read(t, sub)
+			}
+			if msg.Type != "frame" || *msg.FrameIndex != tt.want {
+				t.Fatalf("step(%d) = %+v, want frame %d", tt.step, msg, tt.want)
+			}
+		}
+
+		// Playback resumes after the frame stepped to
+		sub.setPaused(false)
+		if msg := read(t, sub); *msg.FrameIndex != 3 {
+			t.Errorf("frame after resuming = %+v, want frame 3", msg)
+		}
+	})
+
+	for _, mode := range []string{PlaybackRealtime, PlaybackLossless} {
+		t.Run(mode, func(t *testing.T) {
+			sub := newSub()
+			sub.setPlayback(0, mode)
+
+			// The subscriber reads nothing while more frames are played
+			// than fit in its send buffer
+			frames := 2 * streamSendBuffer
+			play(sub, timedFrames(frames, time.Millisecond))
+			time.Sleep(time.Duration(frames) * time.Millisecond)
+
+			var received, dropped int
+			for msg := read(t, sub); msg.Type != "stream_ended"; msg = read(t, sub) {
+				switch msg.Type {
+				case "frame":
+					received++
+				case "frames_dropped":
+					dropped += *msg.Dropped
+				}
+			}
+			if received+dropped != frames {
+				t.Errorf("received %d and dropped %d frames, want %d in all", received, dropped, frames)
+			}
+			if (dropped > 0) != (mode == PlaybackRealtime) {
+				t.Errorf("%s playback dropped %d frames", mode, dropped)
+			}
+		})
+	}
+}
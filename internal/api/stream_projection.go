@@ -0,0 +1,343 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Projection views, which select the parts of each frame a subscription receives
+const (
+	ViewFull       = "full"       // The whole frame
+	ViewEvents     = "events"     // Only the events; frames without events are skipped
+	ViewScoreboard = "scoreboard" // Clock, scores, team and player stats, without positions or bones
+	ViewPositions  = "positions"  // Disc and player positions, without bones or stats
+)
+
+// StreamProjection selects the parts of each frame a subscription receives
+type StreamProjection struct {
+	View    string   `json:"view,omitempty"`    // full (default), events, scoreboard or positions
+	Players []string `json:"players,omitempty"` // Only these players, by account number
+}
+
+// streamProjection is a validated StreamProjection. Subscriptions with the
+// same key receive the same encoded frames.
+type streamProjection struct {
+	view    string
+	players map[uint64]struct{}
+	key     string
+}
+
+// fullProjection is the projection of subscriptions that did not ask for one
+var fullProjection = &streamProjection{view: ViewFull, key: ViewFull}
+
+// newStreamProjection validates a projection requested by a subscriber
+func newStreamProjection(p *StreamProjection) (*streamProjection, error) {
+	if p == nil || (p.View == "" || p.View == ViewFull) && len(p.Players) == 0 {
+		return fullProjection, nil
+	}
+
+	proj := &streamProjection{view: p.View}
+	switch p.View {
+	case "":
+		proj.view = ViewFull
+	case ViewFull, ViewEvents, ViewScoreboard, ViewPositions:
+	default:
+		return nil, fmt.Errorf("unknown view %q", p.View)
+	}
+
+	ids := make([]uint64, 0, len(p.Players))
+	if len(p.Players) > 0 {
+		proj.players = make(map[uint64]struct{}, len(p.Players))
+	}
+	for _, s := range p.Players {
+		id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid player ID %q", s)
+		}
+		if _, dup := proj.players[id]; !dup {
+			proj.players[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	var key strings.Builder
+	key.WriteString(proj.view)
+	for _, id := range ids {
+		key.WriteByte(',')
+		key.WriteString(strconv.FormatUint(id, 10))
+	}
+	proj.key = key.String()
+	return proj, nil
+}
+
+// parseStreamProjection reads a projection from the view and players
+// query parameters of a stream URL
+func parseStreamProjection(view, players string) (*streamProjection, error) {
+	p := &StreamProjection{View: view}
+	if players != "" {
+		p.Players = strings.Split(players, ",")
+	}
+	return newStreamProjection(p)
+}
+
+// project returns the projection of frame, or nil if none of it is selected.
+// The result shares data with frame, which must not change.
+func (p *streamProjection) project(frame *telemetry.LobbySessionStateFrame) *telemetry.LobbySessionStateFrame {
+	if p.view == ViewFull && p.players == nil {
+		return frame
+	}
+
+	// Bones and events refer to players by slot
+	var slots map[int32]struct{}
+	if p.players != nil {
+		slots = make(map[int32]struct{})
+		for _, team := range frame.GetSession().GetTeams() {
+			for _, member := range team.GetPlayers() {
+				if _, ok := p.players[member.GetAccountNumber()]; ok {
+					slots[member.GetSlotNumber()] = struct{}{}
+				}
+			}
+		}
+	}
+
+	out := &telemetry.LobbySessionStateFrame{
+		FrameIndex: frame.GetFrameIndex(),
+		Timestamp:  frame.GetTimestamp(),
+	}
+	switch p.view {
+	case ViewEvents:
+		out.Events = p.events(frame.GetEvents(), slots)
+		if len(out.Events) == 0 {
+			return nil
+		}
+	case ViewScoreboard:
+		out.Session = p.scoreboard(frame.GetSession())
+	case ViewPositions:
+		out.Session = p.positions(frame.GetSession())
+	default:
+		out.Events = p.events(frame.GetEvents(), slots)
+		out.Session = p.session(frame.GetSession())
+		out.PlayerBones = p.bones(frame.GetPlayerBones(), slots)
+	}
+	return out
+}
+
+// events returns the events about the selected players, and those about no
+// player in particular
+func (p *streamProjection) events(events []*telemetry.LobbySessionEvent, slots map[int32]struct{}) []*telemetry.LobbySessionEvent {
+	if slots == nil {
+		return events
+	}
+	var out []*telemetry.LobbySessionEvent
+	for _, event := range events {
+		slot, ok := eventPlayerSlot(event)
+		if _, selected := slots[slot]; !ok || selected {
+			out = append(out, event)
+		}
+	}
+	return out
+}
+
+// eventPlayerSlot returns the slot of the player an event is about, if any
+func eventPlayerSlot(event *telemetry.LobbySessionEvent) (int32, bool) {
+	m := event.ProtoReflect()
+	oneof := m.Descriptor().Oneofs().ByName("event")
+	if oneof == nil {
+		return 0, false
+	}
+	field := m.WhichOneof(oneof)
+	if field == nil || field.Kind() != protoreflect.MessageKind {
+		return 0, false
+	}
+	inner := m.Get(field).Message()
+	slotField := inner.Descriptor().Fields().ByName("player_slot")
+	if slotField == nil {
+		return 0, false
+	}
+	return int32(inner.Get(slotField).Int()), true
+}
+
+func (p *streamProjection) bones(bones *apigame.PlayerBonesResponse, slots map[int32]struct{}) *apigame.PlayerBonesResponse {
+	if bones == nil || slots == nil {
+		return bones
+	}
+	out := &apigame.PlayerBonesResponse{ErrCode: bones.GetErrCode()}
+	for _, user := range bones.GetUserBones() {
+		if _, ok := slots[user.GetPlayerIndex()]; ok {
+			out.UserBones = append(out.UserBones, user)
+		}
+	}
+	return out
+}
+
+// teams sets the teams of out to those of session, with their players
+// limited to the selected ones and passed through member
+func (p *streamProjection) teams(out, session *apigame.SessionResponse, member func(*apigame.TeamMember) *apigame.TeamMember) *apigame.SessionResponse {
+	out.Teams = make([]*apigame.Team, 0, len(session.GetTeams()))
+	for _, team := range session.GetTeams() {
+		t := &apigame.Team{
+			TeamName:      team.GetTeamName(),
+			HasPossession: team.GetHasPossession(),
+			Stats:         team.GetStats(),
+		}
+		for _, m := range team.GetPlayers() {
+			if p.players != nil {
+				if _, ok := p.players[m.GetAccountNumber()]; !ok {
+					continue
+				}
+			}
+			t.Players = append(t.Players, member(m))
+		}
+		out.Teams = append(out.Teams, t)
+	}
+	return out
+}
+
+// session returns session with its teams limited to the selected players
+func (p *streamProjection) session(session *apigame.SessionResponse) *apigame.SessionResponse {
+	if session == nil || p.players == nil {
+		return session
+	}
+	out := &apigame.SessionResponse{}
+	dst := out.ProtoReflect()
+	session.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Name() != "teams" {
+			dst.Set(fd, v)
+		}
+		return true
+	})
+	return p.teams(out, session, func(m *apigame.TeamMember) *apigame.TeamMember { return m })
+}
+
+func (p *streamProjection) scoreboard(session *apigame.SessionResponse) *apigame.SessionResponse {
+	if session == nil {
+		return nil
+	}
+	out := &apigame.SessionResponse{
+		SessionId:        session.GetSessionId(),
+		GameStatus:       session.GetGameStatus(),
+		GameClock:        session.GetGameClock(),
+		GameClockDisplay: session.GetGameClockDisplay(),
+		MatchType:        session.GetMatchType(),
+		MapName:          session.GetMapName(),
+		BluePoints:       session.GetBluePoints(),
+		OrangePoints:     session.GetOrangePoints(),
+		BlueRoundScore:   session.GetBlueRoundScore(),
+		OrangeRoundScore: session.GetOrangeRoundScore(),
+		TotalRoundCount:  session.GetTotalRoundCount(),
+		LastScore:        session.GetLastScore(),
+		Pause:            session.GetPause(),
+	}
+	return p.teams(out, session, func(m *apigame.TeamMember) *apigame.TeamMember {
+		return &apigame.TeamMember{
+			AccountNumber: m.GetAccountNumber(),
+			DisplayName:   m.GetDisplayName(),
+			SlotNumber:    m.GetSlotNumber(),
+			JerseyNumber:  m.GetJerseyNumber(),
+			Level:         m.GetLevel(),
+			Ping:          m.GetPing(),
+			HasPossession: m.GetHasPossession(),
+			Stats:         m.GetStats(),
+		}
+	})
+}
+
+func (p *streamProjection) positions(session *apigame.SessionResponse) *apigame.SessionResponse {
+	if session == nil {
+		return nil
+	}
+	out := &apigame.SessionResponse{
+		SessionId:        session.GetSessionId(),
+		GameStatus:       session.GetGameStatus(),
+		GameClock:        session.GetGameClock(),
+		GameClockDisplay: session.GetGameClockDisplay(),
+		Disc:             session.GetDisc(),
+		Possession:       session.GetPossession(),
+	}
+	return p.teams(out, session, func(m *apigame.TeamMember) *apigame.TeamMember {
+		return &apigame.TeamMember{
+			AccountNumber:    m.GetAccountNumber(),
+			DisplayName:      m.GetDisplayName(),
+			SlotNumber:       m.GetSlotNumber(),
+			Head:             m.GetHead(),
+			Body:             m.GetBody(),
+			LeftHand:         m.GetLeftHand(),
+			RightHand:        m.GetRightHand(),
+			Velocity:         m.GetVelocity(),
+			HasPossession:    m.GetHasPossession(),
+			IsStunned:        m.GetIsStunned(),
+			IsBlocking:       m.GetIsBlocking(),
+			LeftHoldingOnto:  m.GetLeftHoldingOnto(),
+			RightHoldingOnto: m.GetRightHoldingOnto(),
+		}
+	})
+}
+
+// frameEncoder encodes a frame for the subscribers of a match, once for each
+// distinct projection and message protocol
+type frameEncoder struct {
+	matchID string
+	frame   *telemetry.LobbySessionStateFrame
+	encoded map[string]*encodedFrame
+
+	// Quantized projections and messages for the delta protocol, made on
+	// first use
+	quantizedFrames map[string]*telemetry.LobbySessionStateFrame
+	deltaMessages   map[deltaCacheKey][]byte
+}
+
+// encodedFrame is a frame encoded for one projection
+type encodedFrame struct {
+	data     []byte    // Nil if the projection selects nothing of the frame
+	messages [2][]byte // Wrapped for untagged and tagged connections
+}
+
+func newFrameEncoder(matchID string, frame *telemetry.LobbySessionStateFrame) *frameEncoder {
+	return &frameEncoder{matchID: matchID, frame: frame, encoded: make(map[string]*encodedFrame, 1)}
+}
+
+// message returns the frame message for a connection with a projection, or
+// nil if the projection selects nothing of the frame
+func (e *frameEncoder) message(conn *streamConn, proj *streamProjection) ([]byte, error) {
+	if proj == nil {
+		proj = fullProjection
+	}
+	enc, ok := e.encoded[proj.key]
+	if !ok {
+		enc = &encodedFrame{}
+		if projected := proj.project(e.frame); projected != nil {
+			data, err := protojson.MarshalOptions{EmitUnpopulated: false}.Marshal(projected)
+			if err != nil {
+				return nil, err
+			}
+			enc.data = data
+		}
+		// Cached only once encoded, so a failure is not taken for a
+		// projection that selects nothing
+		e.encoded[proj.key] = enc
+	}
+	if enc.data == nil {
+		return nil, nil
+	}
+
+	tagged := 0
+	if conn.tagged {
+		tagged = 1
+	}
+	if enc.messages[tagged] == nil {
+		msg, err := conn.frameMessage(e.matchID, e.frame, enc.data)
+		if err != nil {
+			return nil, err
+		}
+		enc.messages[tagged] = msg
+	}
+	return enc.messages[tagged], nil
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+func TestStreamProjection(t *testing.T) {
+	frame := &telemetry.LobbySessionStateFrame{
+		FrameIndex: 7,
+		Events: []*telemetry.LobbySessionEvent{
+			{Event: &telemetry.LobbySessionEvent_PlayerGoal{PlayerGoal: &telemetry.PlayerGoal{PlayerSlot: 1}}},
+			{Event: &telemetry.LobbySessionEvent_PlayerGoal{PlayerGoal: &telemetry.PlayerGoal{PlayerSlot: 2}}},
+		},
+		Session: &apigame.SessionResponse{
+			BluePoints: 3,
+			Disc:       &apigame.Disc{Position: []float64{1, 2, 3}},
+			Teams: []*apigame.Team{{Players: []*apigame.TeamMember{
+				{AccountNumber: 100, SlotNumber: 1, Head: &apigame.BodyPart{Position: []float64{0, 1, 0}}, Stats: &apigame.PlayerStats{Goals: 1}},
+				{AccountNumber: 200, SlotNumber: 2},
+			}}},
+		},
+		PlayerBones: &apigame.PlayerBonesResponse{UserBones: []*apigame.UserBones{{PlayerIndex: 1}, {PlayerIndex: 2}}},
+	}
+
+	project := func(t *testing.T, p *StreamProjection) *telemetry.LobbySessionStateFrame {
+		t.Helper()
+		proj, err := newStreamProjection(p)
+		if err != nil {
+			t.Fatalf("newStreamProjection(%+v) error = %v", p, err)
+		}
+		return proj.project(frame)
+	}
+
+	if got := project(t, nil); got != frame {
+		t.Error("full projection copied the frame")
+	}
+	if got := project(t, &StreamProjection{View: ViewEvents}); len(got.GetEvents()) != 2 || got.GetSession() != nil {
+		t.Errorf("events view = %v, want only the two events", got)
+	}
+	if got := project(t, &StreamProjection{View: ViewScoreboard}); got.GetSession().GetBluePoints() != 3 ||
+		got.GetSession().GetDisc() != nil || got.GetSession().GetTeams()[0].GetPlayers()[0].GetHead() != nil ||
+		got.GetSession().GetTeams()[0].GetPlayers()[0].GetStats().GetGoals() != 1 {
+		t.Errorf("scoreboard view = %v, want scores and stats without positions", got)
+	}
+	if got := project(t, &StreamProjection{View: ViewPositions}); got.GetSession().GetDisc() == nil ||
+		got.GetSession().GetTeams()[0].GetPlayers()[0].GetHead() == nil || got.GetPlayerBones() != nil {
+		t.Errorf("positions view = %v, want disc and player positions without bones", got)
+	}
+
+	// Selecting a player keeps their team entry, events and bones
+	got := project(t, &StreamProjection{Players: []string{"200"}})
+	if players := got.GetSession().GetTeams()[0].GetPlayers(); len(players) != 1 || players[0].GetAccountNumber() != 200 {
+		t.Errorf("players = %v, want only player 200", players)
+	}
+	if len(got.GetEvents()) != 1 || len(got.GetPlayerBones().GetUserBones()) != 1 || got.GetSession().GetBluePoints() != 3 {
+		t.Errorf("frame for player 200 = %v, want one event and one set of bones", got)
+	}
+	if got := project(t, &StreamProjection{View: ViewEvents, Players: []string{"300"}}); got != nil {
+		t.Errorf("events view for an absent player = %v, want nil", got)
+	}
+
+	if _, err := newStreamProjection(&StreamProjection{View: "bones"}); err == nil {
+		t.Error("newStreamProjection() accepted an unknown view")
+	}
+
+	// Subscriptions with the same projection share one encoding
+	a, _ := newStreamProjection(&StreamProjection{View: ViewScoreboard, Players: []string{"200", "100"}})
+	b, _ := newStreamProjection(&StreamProjection{View: ViewScoreboard, Players: []string{"100", "200"}})
+	conn := newStreamConn(nil, true)
+	encoder := newFrameEncoder("match-a", frame)
+	msgA, _ := encoder.message(conn, a)
+	msgB, _ := encoder.message(conn, b)
+	if &msgA[0] != &msgB[0] || len(encoder.encoded) != 1 {
+		t.Errorf("equal projections were encoded %d times", len(encoder.encoded))
+	}
+	var msg MuxMessage
+	if err := json.Unmarshal(msgA, &msg); err != nil || *msg.FrameIndex != 7 {
+		t.Errorf("message = %s, %v", msgA, err)
+	}
+}
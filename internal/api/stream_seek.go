@@ -0,0 +1,363 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/nevrcap"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+// defaultPlaybackFPS paces playback for subscribers without a frame rate
+const defaultPlaybackFPS = 30
+
+var (
+	// errLiveEdge is returned by a frame cursor that has caught up with the
+	// live frames of a match
+	errLiveEdge = errors.New("reached the live edge of the match")
+
+	errSeekNotFound = errors.New("seek position not found")
+)
+
+// seekTarget matches the frame a SeekRequest points to: the first frame of a
+// match at or past the requested position
+type seekTarget struct {
+	frame  uint32
+	clock  float64 // Seconds on the game clock, or -1
+	round  int
+	offset time.Duration // Or -1
+	start  time.Time     // Timestamp of the first frame, for offset
+}
+
+func newSeekTarget(req SeekRequest) (*seekTarget, error) {
+	t := &seekTarget{frame: req.Frame, clock: -1, round: req.Round, offset: -1}
+	if req.Round < 0 {
+		return nil, fmt.Errorf("invalid round %d", req.Round)
+	}
+	if req.Time != "" {
+		clock, err := parseGameClock(req.Time)
+		if err != nil {
+			return nil, err
+		}
+		t.clock = clock
+	}
+	if req.Offset != "" {
+		offset, err := time.ParseDuration(req.Offset)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid offset %q", req.Offset)
+		}
+		t.offset = offset
+	}
+	if t.frame == 0 && t.clock < 0 && t.round == 0 && t.offset < 0 {
+		return nil, errors.New("seek requires a frame, time, round or offset")
+	}
+	return t, nil
+}
+
+// parseGameClock parses a game clock of the form "MM:SS" or "HH:MM:SS", with
+// optional fractional seconds, into seconds
+func parseGameClock(s string) (float64, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid time %q: expected MM:SS or HH:MM:SS", s)
+	}
+
+	var seconds float64
+	for i, part := range parts {
+		v, err := strconv.ParseFloat(part, 64)
+		if err != nil || v < 0 || (i < len(parts)-1 && v != float64(int(v))) {
+			return 0, fmt.Errorf("invalid time %q: expected MM:SS or HH:MM:SS", s)
+		}
+		seconds = seconds*60 + v
+	}
+	return seconds, nil
+}
+
+// matches reports whether frame is at or past the target. Frames are tested
+// in match order, so the first match is the seek position.
+func (t *seekTarget) matches(frame *telemetry.LobbySessionStateFrame) bool {
+	switch {
+	case t.frame > 0:
+		return frame.GetFrameIndex() >= t.frame
+
+	case t.clock >= 0:
+		round := nevrcap.FrameRound(frame)
+		if round < t.round {
+			return false
+		}
+		// The clock counts down, so later positions have less time left. A
+		// round that ends before reaching the clock matches its next round.
+		return round > t.round && t.round > 0 || frame.GetSession().GetGameClock() <= t.clock
+
+	case t.round > 0:
+		return nevrcap.FrameRound(frame) >= t.round
+
+	default:
+		if frame.GetTimestamp() == nil {
+			return false
+		}
+		if t.start.IsZero() {
+			t.start = frame.GetTimestamp().AsTime()
+		}
+		return !frame.GetTimestamp().AsTime().Before(t.start.Add(t.offset))
+	}
+}
+
+// block returns the block of a capture to search for the target from: the
+// last block that starts before it
+func (t *seekTarget) block(index *nevrcap.Index) (nevrcap.Block, bool) {
+	switch {
+	case t.frame > 0:
+		return index.BlockForFrame(t.frame)
+
+	case t.round > 0:
+		// The clock is searched for within the round
+		return index.BlockForRound(t.round)
+
+	case t.clock >= 0:
+		return nevrcap.Block{}, false
+
+	default:
+		if len(index.Blocks) == 0 {
+			return nevrcap.Block{}, false
+		}
+		if t.start.IsZero() {
+			t.start = index.Blocks[0].Timestamp
+		}
+		return index.BlockForTime(t.start.Add(t.offset))
+	}
+}
+
+// frameCursor reads the frames of a match in order
+type frameCursor interface {
+	// next returns the next frame, io.EOF after the last frame of a match
+	// that has ended, or errLiveEdge once it catches up with a live match
+	next() (*telemetry.LobbySessionStateFrame, error)
+	close()
+}
+
+// ringCursor reads the frame buffer of a match stream by sequence number.
+// A cursor that falls behind the buffer skips ahead to its oldest frame.
+type ringCursor struct {
+	stream *matchStream
+	seq    int
+}
+
+func (c *ringCursor) next() (*telemetry.LobbySessionStateFrame, error) {
+	c.stream.mu.RLock()
+	defer c.stream.mu.RUnlock()
+
+	pos := c.seq - c.stream.dropped
+	if pos < 0 {
+		pos, c.seq = 0, c.stream.dropped
+	}
+	if pos >= len(c.stream.frames) {
+		if c.stream.ended {
+			return nil, io.EOF
+		}
+		return nil, errLiveEdge
+	}
+	c.seq++
+	return c.stream.frames[pos], nil
+}
+
+func (c *ringCursor) close() {}
+
+// captureCursor reads a match from its capture file, then continues from the
+// frame buffer where the file ends, so that playback of a match in progress
+// reaches its live frames
+type captureCursor struct {
+	hub     *StreamHub
+	matchID string
+	reader  *nevrcap.Reader
+	pending *telemetry.LobbySessionStateFrame // Read while seeking, returned first
+	last    uint32                            // Index of the last frame read
+	ring    *ringCursor
+}
+
+func (c *captureCursor) next() (*telemetry.LobbySessionStateFrame, error) {
+	if c.pending != nil {
+		frame := c.pending
+		c.pending = nil
+		c.last = frame.GetFrameIndex()
+		return frame, nil
+	}
+	if c.ring != nil {
+		return c.ring.next()
+	}
+
+	frame, err := c.reader.ReadFrame()
+	if err == nil {
+		c.last = frame.GetFrameIndex()
+		return frame, nil
+	}
+
+	// The last block of a file being written may be incomplete; the frame
+	// buffer holds the frames from there on
+	if c.ring = c.hub.ringCursorAfter(c.matchID, c.last); c.ring != nil {
+		return c.ring.next()
+	}
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	return nil, err
+}
+
+func (c *captureCursor) close() {
+	c.reader.Close()
+}
+
+// ringCursorAfter returns a cursor at the first buffered frame of a match
+// with an index after frameIndex, or nil if the match has no buffered frames
+func (h *StreamHub) ringCursorAfter(matchID string, frameIndex uint32) *ringCursor {
+	h.mu.RLock()
+	stream, exists := h.matches[matchID]
+	h.mu.RUnlock()
+	if !exists {
+		return nil
+	}
+
+	stream.mu.RLock()
+	defer stream.mu.RUnlock()
+	if len(stream.frames) == 0 {
+		return nil
+	}
+
+	pos := len(stream.frames)
+	for i, frame := range stream.frames {
+		if frame.GetFrameIndex() > frameIndex {
+			pos = i
+			break
+		}
+	}
+	return &ringCursor{stream: stream, seq: stream.dropped + pos}
+}
+
+// seekCursor returns a cursor at the first frame of a match that target
+// matches. The frame buffer is searched first; positions before the oldest
+// buffered frame are read from the match's capture file.
+func (h *StreamHub) seekCursor(matchID string, target *seekTarget) (frameCursor, error) {
+	h.mu.RLock()
+	stream, exists := h.matches[matchID]
+	h.mu.RUnlock()
+
+	var buffered *ringCursor
+	if exists {
+		stream.mu.RLock()
+		target.start = stream.firstFrameAt
+		pos, exact := -1, false
+		if seq, ok := stream.frameIndex[target.frame]; ok && target.frame > 0 {
+			pos, exact = seq-stream.dropped, true
+		} else {
+			for i, frame := range stream.frames {
+				if target.matches(frame) {
+					pos = i
+					break
+				}
+			}
+		}
+		dropped := stream.dropped
+		stream.mu.RUnlock()
+
+		if pos > 0 || pos == 0 && (exact || dropped == 0) {
+			return &ringCursor{stream: stream, seq: dropped + pos}, nil
+		}
+		if pos == 0 {
+			// The position may lie before the buffer
+			buffered = &ringCursor{stream: stream, seq: dropped}
+		} else if dropped == 0 {
+			return nil, errSeekNotFound
+		}
+	}
+
+	cursor, err := h.captureCursor(matchID, target)
+	if err != nil && buffered != nil {
+		// Without a capture file, the oldest buffered frame is the closest
+		return buffered, nil
+	}
+	return cursor, err
+}
+
+// captureCursor opens the capture file of a match at the first frame target
+// matches, or at its first frame if target is nil. With a seek index, the
+// search starts at the block holding the target rather than the file start.
+func (h *StreamHub) captureCursor(matchID string, target *seekTarget) (*captureCursor, error) {
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not available")
+	}
+
+	filePath, index, err := h.storage.CaptureIndex(matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reader *nevrcap.Reader
+	if target != nil && index != nil {
+		if block, ok := target.block(index); ok {
+			reader, err = nevrcap.OpenBlock(filePath, block)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open file: %w", err)
+			}
+		}
+	}
+	if reader == nil {
+		reader, err = nevrcap.Open(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open file: %w", err)
+		}
+
+		// Skip header
+		if _, err := reader.ReadHeader(); err != nil {
+			reader.Close()
+			return nil, fmt.Errorf("failed to read header: %w", err)
+		}
+	}
+
+	for {
+		frame, err := reader.ReadFrame()
+		if err != nil {
+			reader.Close()
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, errSeekNotFound
+			}
+			return nil, fmt.Errorf("failed to read frame: %w", err)
+		}
+		if target == nil || target.matches(frame) {
+			return &captureCursor{hub: h, matchID: matchID, reader: reader, pending: frame}, nil
+		}
+	}
+}
+
+// seek moves the subscriber to the position of a seek request and plays the
+// match from there, rejoining the live stream once the playback catches up
+func (s *streamSubscriber) seek(hub *StreamHub, req SeekRequest) {
+	target, err := newSeekTarget(req)
+	if err != nil {
+		s.notify(MuxMessage{Type: "error", MatchID: s.matchID, Error: err.Error()})
+		return
+	}
+
+	stop := s.beginPlayback()
+	go func() {
+		// Reading a capture file up to the position can take a while
+		cursor, err := hub.seekCursor(s.matchID, target)
+		if err != nil {
+			select {
+			case <-stop:
+				// A newer seek replaced this one
+			default:
+				s.endPlayback(stop)
+				s.notify(MuxMessage{Type: "error", MatchID: s.matchID, Error: "seek failed: " + err.Error()})
+			}
+			return
+		}
+		if err := s.playback(context.Background(), hub, cursor, stop); err != nil {
+			hub.logger.Debug("seek playback failed", "match_id", s.matchID, "error", err)
+		}
+	}()
+}
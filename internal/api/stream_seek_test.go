@@ -0,0 +1,110 @@
+package api
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+)
+
+func TestParseGameClock(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{"02:30", 150, false},
+		{"1:02:03", 3723, false},
+		{"00:04.5", 4.5, false},
+		{"150", 0, true},
+		{"1.5:00", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseGameClock(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseGameClock(%q) = %v, %v, want %v (error %v)", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestStreamHub_SeekFallsBackToCapture(t *testing.T) {
+	storage, err := NewStorageManager(t.TempDir(), time.Hour, 1<<30, &DefaultLogger{})
+	if err != nil {
+		t.Fatalf("NewStorageManager() error = %v", err)
+	}
+	hub := NewStreamHub(storage, &DefaultLogger{}, nil, 60, nil)
+	router := mux.NewRouter()
+	hub.RegisterRoutes(router)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	// Frames 1-10 are round 1 and 11-20 round 2, whose clock runs from 9 to 0.
+	// Only the last five stay buffered.
+	matchID := "match-a"
+	frame := func(i uint32) *telemetry.LobbySessionStateFrame {
+		session := &apigame.SessionResponse{GameClock: 300}
+		if i > 10 {
+			session.BlueRoundScore = 1
+			session.GameClock = float64(20 - i)
+		}
+		return &telemetry.LobbySessionStateFrame{FrameIndex: i, Session: session}
+	}
+	hub.BroadcastFrame(matchID, "", frame(1))
+	hub.matches[matchID].maxFrames = 5
+	storage.WriteFrame(matchID, frame(1))
+	for i := uint32(2); i <= 20; i++ {
+		storage.WriteFrame(matchID, frame(i))
+		hub.BroadcastFrame(matchID, "", frame(i))
+	}
+	storage.CloseMatch(matchID)
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/ws/stream", nil)
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer ws.Close()
+	read := func(t *testing.T) MuxMessage {
+		t.Helper()
+		var msg MuxMessage
+		ws.SetReadDeadline(time.Now().Add(2 * time.Second))
+		if err := ws.ReadJSON(&msg); err != nil {
+			t.Fatalf("ReadJSON() error = %v", err)
+		}
+		return msg
+	}
+	ws.WriteJSON(MuxRequest{Type: "subscribe", MatchID: matchID, FPS: 60})
+	read(t)
+
+	tests := []struct {
+		req   MuxRequest
+		first uint32
+	}{
+		{MuxRequest{Type: "seek", MatchID: matchID, Time: "00:05", Round: 2}, 15},
+		{MuxRequest{Type: "seek", MatchID: matchID, Round: 2}, 11},
+		{MuxRequest{Type: "seek", MatchID: matchID, FrameIndex: 18}, 18},
+	}
+	for _, tt := range tests {
+		ws.WriteJSON(tt.req)
+		if msg := read(t); msg.Type != "seeked" || *msg.FrameIndex != tt.first {
+			t.Fatalf("seek %+v = %+v, want seeked to %d", tt.req, msg, tt.first)
+		}
+		// Playback continues from the capture file into the buffer
+		for want := tt.first; want <= 20; want++ {
+			if msg := read(t); msg.Type != "frame" || *msg.FrameIndex != want {
+				t.Fatalf("seek %+v: message = %+v, want frame %d", tt.req, msg, want)
+			}
+		}
+	}
+
+	// Having caught up, the subscriber is live again
+	time.Sleep(50 * time.Millisecond)
+	hub.BroadcastFrame(matchID, "", frame(21))
+	if msg := read(t); msg.Type != "frame" || *msg.FrameIndex != 21 {
+		t.Fatalf("message = %+v, want live frame 21", msg)
+	}
+}
@@ -0,0 +1,495 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/graph"
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// sseContentType is the media type of Server-Sent Events
+	sseContentType = "text/event-stream"
+
+	// sseHeartbeat is how often an idle event stream is sent a comment, so
+	// that proxies keep it open
+	sseHeartbeat = 15 * time.Second
+
+	// sseWriteTimeout bounds each write to an event stream, so that a client
+	// that stops reading does not hold its stream open
+	sseWriteTimeout = 10 * time.Second
+
+	// Event names other than the event types
+	sseScoreboard = "scoreboard"
+	sseMatchEnded = "match_ended"
+)
+
+// scoreboardProjection selects the scoreboard of a frame for score changes
+var scoreboardProjection = &streamProjection{view: ViewScoreboard, key: ViewScoreboard}
+
+// sseEventMarshaler encodes event payloads with the field names of their
+// event types
+var sseEventMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// sseMessage is the data of an event on the SSE endpoints
+type sseMessage struct {
+	MatchID    string          `json:"match_id"`
+	FrameIndex uint32          `json:"frame_index"`
+	Timestamp  *time.Time      `json:"timestamp,omitempty"`
+	Event      json.RawMessage `json:"event,omitempty"`      // The fields of a typed event
+	Scoreboard json.RawMessage `json:"scoreboard,omitempty"` // The clock, scores, status and players after a score change
+}
+
+// sseStream is a Server-Sent Events response fed with the frames of one
+// match, or of every match when matchID is empty.
+//
+// Each frame yields its typed events, then a scoreboard event if the score
+// changed. Event IDs are "<frame>.<n>", where n is the position of the
+// event among those of its frame and frame is the frame index, or the
+// frame's timestamp in Unix nanoseconds on the global stream. A reconnecting
+// client's Last-Event-ID resumes after that event from the frame buffer.
+type sseStream struct {
+	w        http.ResponseWriter
+	rc       *http.ResponseController
+	matchID  string
+	events   bool
+	scores   bool
+	last     map[string]uint32 // Index of the last frame handled, by match
+	scoreKey map[string]string // Scores of the last frame handled, by match
+
+	// end is the position after the last frame handled, the ID of a
+	// match's match_ended event
+	end sseResume
+
+	// directory follows the live matches on the global stream
+	directory *liveDirectory
+}
+
+// sseResume is a position in the event streams, parsed from Last-Event-ID
+type sseResume struct {
+	frame uint64
+	n     int
+}
+
+// registerSSERoutes registers the Server-Sent Events routes
+func (h *StreamHub) registerSSERoutes(r *mux.Router) {
+	r.HandleFunc("/api/v3/matches/{matchId}/events", h.handleMatchEvents).Methods("GET")
+	r.HandleFunc("/api/v3/matches/{matchId}/scoreboard", h.handleMatchScoreboard).Methods("GET")
+	r.HandleFunc("/api/v3/live", h.handleLive).Methods("GET")
+}
+
+// handleMatchEvents streams the typed events of a match
+func (h *StreamHub) handleMatchEvents(w http.ResponseWriter, r *http.Request) {
+	h.serveSSE(w, r, mux.Vars(r)["matchId"], true, false)
+}
+
+// handleMatchScoreboard streams the score changes of a match, starting with
+// its current scoreboard
+func (h *StreamHub) handleMatchScoreboard(w http.ResponseWriter, r *http.Request) {
+	h.serveSSE(w, r, mux.Vars(r)["matchId"], false, true)
+}
+
+// serveSSE streams the events and score changes of a match, or of every
+// match and the live directory if matchID is empty, until the client goes
+// away or the match ends
+func (h *StreamHub) serveSSE(w http.ResponseWriter, r *http.Request, matchID string, events, scores bool) {
+	if matchID != "" && !store.ValidSessionID(matchID) {
+		http.Error(w, store.ErrInvalidSessionID.Error(), http.StatusBadRequest)
+		return
+	}
+	resume, err := parseLastEventID(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	frames := h.Observe(ctx, matchID)
+
+	replay, ended := h.replayFrames(matchID, resume)
+	if matchID != "" && ended && resume != nil && !resume.pending(matchID, replay) {
+		// The client saw the end of the match; 204 stops it reconnecting
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s := &sseStream{
+		w:        w,
+		rc:       http.NewResponseController(w),
+		matchID:  matchID,
+		events:   events,
+		scores:   scores,
+		last:     make(map[string]uint32),
+		scoreKey: make(map[string]string),
+	}
+	w.Header().Set("Content-Type", sseContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := s.rc.Flush(); errors.Is(err, http.ErrNotSupported) {
+		h.logger.Error("response does not support streaming", "path", r.URL.Path)
+		return
+	}
+	// Event streams outlive the server's write timeout; each write sets a
+	// deadline of its own instead
+	s.rc.SetWriteDeadline(time.Time{})
+
+	if h.metrics != nil {
+		h.metrics.SSEConnections.Inc()
+		defer h.metrics.SSEConnections.Dec()
+	}
+
+	var refresh <-chan time.Time
+	if matchID == "" {
+		s.directory = newLiveDirectory(h)
+		if err := s.send("", liveMatchesMessage, s.directory.snapshot()); err != nil {
+			return
+		}
+		ticker := time.NewTicker(liveDirectoryInterval)
+		defer ticker.Stop()
+		refresh = ticker.C
+	} else {
+		h.addViewer(matchID, 1)
+		defer h.addViewer(matchID, -1)
+	}
+
+	if err := s.replay(replay, resume); err != nil {
+		return
+	}
+	if matchID != "" && ended {
+		s.send(s.end.String(), sseMatchEnded, sseMessage{MatchID: matchID})
+		return
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if err := s.comment("heartbeat"); err != nil {
+				return
+			}
+		case <-refresh:
+			for _, update := range s.directory.refresh() {
+				if err := s.send("", update.Type, update); err != nil {
+					return
+				}
+			}
+		case live, ok := <-frames:
+			if !ok {
+				return
+			}
+			if s.directory != nil {
+				if update, ok := s.directory.observe(live, time.Now()); ok {
+					if err := s.send("", update.Type, update); err != nil {
+						return
+					}
+				}
+			}
+			if live.Frame == nil {
+				id := ""
+				if matchID != "" {
+					id = s.end.String()
+				}
+				if err := s.send(id, sseMatchEnded, sseMessage{MatchID: live.MatchID}); err != nil || matchID != "" {
+					return
+				}
+				delete(s.last, live.MatchID)
+				delete(s.scoreKey, live.MatchID)
+				continue
+			}
+			if last, seen := s.last[live.MatchID]; seen && live.Frame.GetFrameIndex() <= last {
+				// Already replayed from the frame buffer
+				continue
+			}
+			if err := s.frame(live.MatchID, live.Frame, 0); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// parseLastEventID reads the position a reconnecting client resumes from,
+// nil if it is not resuming
+func parseLastEventID(r *http.Request) (*sseResume, error) {
+	id := r.Header.Get("Last-Event-ID")
+	if id == "" {
+		id = r.URL.Query().Get("last_event_id")
+	}
+	if id == "" {
+		return nil, nil
+	}
+
+	frame, n, _ := strings.Cut(id, ".")
+	resume := &sseResume{}
+	var err error
+	if resume.frame, err = strconv.ParseUint(frame, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid Last-Event-ID %q", id)
+	}
+	if n != "" {
+		if resume.n, err = strconv.Atoi(n); err != nil || resume.n < 0 {
+			return nil, fmt.Errorf("invalid Last-Event-ID %q", id)
+		}
+	}
+	return resume, nil
+}
+
+// String formats the position as an event ID
+func (p sseResume) String() string {
+	return strconv.FormatUint(p.frame, 10) + "." + strconv.Itoa(p.n)
+}
+
+// pending reports whether any of the frames to replay has events after the
+// resume point
+func (p *sseResume) pending(matchID string, frames []graph.LiveFrame) bool {
+	for _, live := range frames {
+		key, _ := sseFrameKey(matchID, live.Frame)
+		if key > p.frame || key == p.frame && p.n < len(live.Frame.GetEvents()) {
+			return true
+		}
+	}
+	return false
+}
+
+// replayFrames returns the buffered frames to replay to a new event stream:
+// for a resuming client, those from the frame it saw last, and otherwise
+// the latest frame of each match, for its scoreboard. For a single match it
+// also reports whether the match has ended. Frames are in the order of
+// their keys.
+func (h *StreamHub) replayFrames(matchID string, resume *sseResume) ([]graph.LiveFrame, bool) {
+	h.mu.RLock()
+	streams := make([]*matchStream, 0, len(h.matches))
+	for id, stream := range h.matches {
+		if matchID == "" || id == matchID {
+			streams = append(streams, stream)
+		}
+	}
+	h.mu.RUnlock()
+
+	var frames []graph.LiveFrame
+	ended := false
+	for _, stream := range streams {
+		stream.mu.RLock()
+		ended = stream.ended
+		if len(stream.frames) == 0 || matchID == "" && stream.ended && resume == nil {
+			stream.mu.RUnlock()
+			continue
+		}
+		if resume == nil {
+			frames = append(frames, graph.LiveFrame{MatchID: stream.matchID, Frame: stream.frames[len(stream.frames)-1]})
+			stream.mu.RUnlock()
+			continue
+		}
+
+		// The frame before the resume point gives the scores the client
+		// last saw
+		start := sort.Search(len(stream.frames), func(i int) bool {
+			key, _ := sseFrameKey(matchID, stream.frames[i])
+			return key >= resume.frame
+		})
+		if start > 0 {
+			start--
+		}
+		for _, frame := range stream.frames[start:] {
+			frames = append(frames, graph.LiveFrame{MatchID: stream.matchID, Frame: frame})
+		}
+		stream.mu.RUnlock()
+	}
+
+	sort.SliceStable(frames, func(i, j int) bool {
+		a, _ := sseFrameKey(matchID, frames[i].Frame)
+		b, _ := sseFrameKey(matchID, frames[j].Frame)
+		return a < b
+	})
+	return frames, ended
+}
+
+// replay sends the buffered frames of a new event stream. Frames before the
+// resume point only set the scores the client last saw.
+func (s *sseStream) replay(frames []graph.LiveFrame, resume *sseResume) error {
+	for _, live := range frames {
+		// Without a resume point only the scoreboard is sent
+		skip := len(live.Frame.GetEvents())
+		if resume != nil {
+			skip = 0
+			key, _ := sseFrameKey(s.matchID, live.Frame)
+			switch {
+			case key < resume.frame:
+				s.note(live.MatchID, live.Frame)
+				continue
+			case key == resume.frame:
+				skip = resume.n + 1
+			}
+		}
+		if err := s.frame(live.MatchID, live.Frame, skip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sseFrameKey returns the key of a frame in event IDs: its index on a
+// match's stream, or its timestamp on the global stream. Frames without a
+// timestamp have no key on the global stream.
+func sseFrameKey(matchID string, frame *telemetry.LobbySessionStateFrame) (uint64, bool) {
+	if matchID != "" {
+		return uint64(frame.GetFrameIndex()), true
+	}
+	if ts := frame.GetTimestamp(); ts != nil {
+		return uint64(ts.AsTime().UnixNano()), true
+	}
+	return 0, false
+}
+
+// frame sends the events of a frame from position skip on, then its
+// scoreboard if the score changed
+func (s *sseStream) frame(matchID string, frame *telemetry.LobbySessionStateFrame, skip int) error {
+	key, hasKey := sseFrameKey(s.matchID, frame)
+	id := func(n int) string {
+		if !hasKey {
+			return ""
+		}
+		return sseResume{frame: key, n: n}.String()
+	}
+	msg := sseMessage{MatchID: matchID, FrameIndex: frame.GetFrameIndex()}
+	if ts := frame.GetTimestamp(); ts != nil {
+		t := ts.AsTime()
+		msg.Timestamp = &t
+	}
+
+	events := frame.GetEvents()
+	if s.events {
+		for n := skip; n < len(events); n++ {
+			name := store.EventType(events[n])
+			if name == "" {
+				continue
+			}
+			m := events[n].ProtoReflect()
+			payload := m.Get(m.WhichOneof(m.Descriptor().Oneofs().ByName("event"))).Message().Interface()
+			data, err := sseEventMarshaler.Marshal(payload)
+			if err != nil {
+				return err
+			}
+			msg.Event = data
+			if err := s.send(id(n), name, msg); err != nil {
+				return err
+			}
+		}
+		msg.Event = nil
+	}
+
+	changed := s.note(matchID, frame)
+	if s.scores && changed && skip <= len(events) {
+		data, err := sseEventMarshaler.Marshal(scoreboardProjection.scoreboard(frame.GetSession()))
+		if err != nil {
+			return err
+		}
+		msg.Scoreboard = data
+		return s.send(id(len(events)), sseScoreboard, msg)
+	}
+	return nil
+}
+
+// note records a frame as handled, and reports whether its scores differ
+// from those of the match's previous frame
+func (s *sseStream) note(matchID string, frame *telemetry.LobbySessionStateFrame) bool {
+	s.last[matchID] = frame.GetFrameIndex()
+	frameKey, _ := sseFrameKey(s.matchID, frame)
+	s.end = sseResume{frame: frameKey, n: len(frame.GetEvents()) + 1}
+	session := frame.GetSession()
+	if session == nil {
+		return false
+	}
+	key := scoreKey(session)
+	prev, seen := s.scoreKey[matchID]
+	s.scoreKey[matchID] = key
+	return !seen || key != prev
+}
+
+// scoreKey sums up what a score change is detected on: the status, scores
+// and roster, and the players' counting stats, but not the clock, pings or
+// possession times
+func scoreKey(session *apigame.SessionResponse) string {
+	b := make([]byte, 0, 256)
+	b = append(b, session.GetGameStatus()...)
+	b = append(b, '|')
+	b = append(b, session.GetPause().GetPausedState()...)
+	for _, v := range []int32{
+		session.GetBluePoints(), session.GetOrangePoints(),
+		session.GetBlueRoundScore(), session.GetOrangeRoundScore(), session.GetTotalRoundCount(),
+	} {
+		b = append(b, '|')
+		b = strconv.AppendInt(b, int64(v), 10)
+	}
+	for _, team := range session.GetTeams() {
+		b = append(b, '/')
+		for _, m := range team.GetPlayers() {
+			st := m.GetStats()
+			b = append(b, ';')
+			b = strconv.AppendUint(b, m.GetAccountNumber(), 10)
+			for _, v := range []int32{
+				st.GetPoints(), st.GetGoals(), st.GetAssists(), st.GetSaves(), st.GetStuns(), st.GetPasses(),
+				st.GetCatches(), st.GetSteals(), st.GetBlocks(), st.GetInterceptions(), st.GetShotsTaken(),
+			} {
+				b = append(b, ',')
+				b = strconv.AppendInt(b, int64(v), 10)
+			}
+		}
+	}
+	return string(b)
+}
+
+// send writes an event and flushes it to the client
+func (s *sseStream) send(id, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if id != "" {
+		b.WriteString("id: ")
+		b.WriteString(id)
+		b.WriteByte('\n')
+	}
+	b.WriteString("event: ")
+	b.WriteString(event)
+	b.WriteString("\ndata: ")
+	b.Write(payload)
+	b.WriteString("\n\n")
+	return s.write(b.String())
+}
+
+// comment writes a comment line, which clients ignore
+func (s *sseStream) comment(text string) error {
+	return s.write(": " + text + "\n\n")
+}
+
+// write writes data within sseWriteTimeout and flushes it to the client
+func (s *sseStream) write(data string) error {
+	s.rc.SetWriteDeadline(time.Now().Add(sseWriteTimeout))
+	if _, err := io.WriteString(s.w, data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// wantsEventStream reports whether a request asks for Server-Sent Events
+func wantsEventStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), sseContentType)
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	telemetry "github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/gorilla/mux"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type sseTestEvent struct {
+	id, event string
+	data      sseMessage
+}
+
+func TestStreamHub_SSE(t *testing.T) {
+	hub := NewStreamHub(nil, &DefaultLogger{}, nil, 60, nil)
+	router := mux.NewRouter()
+	hub.RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	start := time.Now()
+	frame := func(index uint32, blue int32, events ...*telemetry.LobbySessionEvent) *telemetry.LobbySessionStateFrame {
+		return &telemetry.LobbySessionStateFrame{
+			FrameIndex: index,
+			Timestamp:  timestamppb.New(start.Add(time.Duration(index) * time.Second)),
+			Events:     events,
+			Session: &apigame.SessionResponse{
+				GameStatus: "playing",
+				GameClock:  float64(300 - index), // Clock changes are not score changes
+				BluePoints: blue,
+			},
+		}
+	}
+	stun := func(slot int32) *telemetry.LobbySessionEvent {
+		return &telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_PlayerStun{PlayerStun: &telemetry.PlayerStun{PlayerSlot: slot}}}
+	}
+	goal := &telemetry.LobbySessionEvent{Event: &telemetry.LobbySessionEvent_GoalScored{GoalScored: &telemetry.GoalScored{}}}
+
+	hub.BroadcastFrame("match-a", "", frame(1, 0, stun(1)))
+
+	open := func(t *testing.T, path, lastEventID string) (*bufio.Reader, func()) {
+		t.Helper()
+		req, _ := http.NewRequest("GET", server.URL+path, nil)
+		req.Header.Set("Accept", sseContentType)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("GET %s error = %v", path, err)
+		}
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != sseContentType {
+			t.Fatalf("GET %s = %d %s", path, resp.StatusCode, resp.Header.Get("Content-Type"))
+		}
+		return bufio.NewReader(resp.Body), func() { resp.Body.Close() }
+	}
+	read := func(t *testing.T, r *bufio.Reader) sseTestEvent {
+		t.Helper()
+		var e sseTestEvent
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				t.Fatalf("reading event stream: %v", err)
+			}
+			line = strings.TrimSuffix(line, "\n")
+			switch {
+			case line == "" && e.event != "":
+				return e
+			case strings.HasPrefix(line, "id: "):
+				e.id = line[4:]
+			case strings.HasPrefix(line, "event: "):
+				e.event = line[7:]
+			case strings.HasPrefix(line, "data: "):
+				json.Unmarshal([]byte(line[6:]), &e.data)
+			}
+		}
+	}
+	expect := func(t *testing.T, r *bufio.Reader, want ...string) []sseTestEvent {
+		t.Helper()
+		var got []sseTestEvent
+		for _, w := range want {
+			e := read(t, r)
+			for strings.HasPrefix(e.event, liveMatchMessage) && !strings.Contains(w, liveMatchMessage) {
+				// Directory updates of the global stream
+				e = read(t, r)
+			}
+			if e.id+" "+e.event != w {
+				t.Fatalf("event %q, want %q", e.id+" "+e.event, w)
+			}
+			got = append(got, e)
+		}
+		return got
+	}
+
+	scores, closeScores := open(t, "/api/v3/matches/match-a/scoreboard", "")
+	defer closeScores()
+	events, closeEvents := open(t, "/api/v3/matches/match-a/events", "")
+	live, closeLive := open(t, "/api/v3/live", "")
+	defer closeLive()
+	expect(t, live, " "+liveMatchesMessage)
+
+	// The scoreboard stream starts with the current scores
+	expect(t, scores, "1.1 scoreboard")
+
+	hub.BroadcastFrame("match-a", "", frame(2, 0, stun(2)))
+	hub.BroadcastFrame("match-a", "", frame(3, 1, goal, stun(3)))
+
+	expect(t, events, "2.0 player_stun")
+	got := expect(t, events, "3.0 goal_scored", "3.1 player_stun")
+	if got[1].data.MatchID != "match-a" || got[1].data.FrameIndex != 3 || !strings.Contains(string(got[1].data.Event), `"player_slot":3`) {
+		t.Errorf("event data = %+v", got[1].data)
+	}
+	closeEvents()
+
+	got = expect(t, scores, "3.2 scoreboard")
+	if !strings.Contains(string(got[0].data.Scoreboard), `"blue_points":1`) {
+		t.Errorf("scoreboard = %s", got[0].data.Scoreboard)
+	}
+
+	// The global stream carries both, with IDs by frame timestamp
+	liveID := func(index uint32, n int) string {
+		return sseResume{frame: uint64(start.Add(time.Duration(index) * time.Second).UnixNano()), n: n}.String()
+	}
+	expect(t, live, liveID(1, 1)+" scoreboard", liveID(2, 0)+" player_stun",
+		liveID(3, 0)+" goal_scored", liveID(3, 1)+" player_stun", liveID(3, 2)+" scoreboard")
+
+	// A reconnecting client resumes after the last event it saw
+	hub.BroadcastFrame("match-a", "", frame(4, 1, stun(4)))
+	resumed, closeResumed := open(t, "/api/v3/matches/match-a/events", "3.0")
+	defer closeResumed()
+	expect(t, resumed, "3.1 player_stun", "4.0 player_stun")
+
+	hub.CloseMatch("match-a")
+	expect(t, resumed, "4.2 match_ended")
+
+	// Once the client saw the end of the match, it is told not to reconnect
+	req, _ := http.NewRequest("GET", server.URL+"/api/v3/matches/match-a/events", nil)
+	req.Header.Set("Last-Event-ID", "4.2")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("reconnecting after the end = %d, want 204", resp.StatusCode)
+	}
+}
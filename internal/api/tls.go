@@ -0,0 +1,233 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Client certificate modes
+const (
+	ClientAuthRequest = "request" // Verify a client certificate if one is presented
+	ClientAuthRequire = "require" // Reject connections without a valid client certificate
+)
+
+// tlsReloadInterval is how often certificate files are checked for changes
+const tlsReloadInterval = 10 * time.Second
+
+// TLSConfig configures TLS on the API server
+type TLSConfig struct {
+	CertFile string `json:"cert_file" yaml:"cert_file"`
+	KeyFile  string `json:"key_file" yaml:"key_file"`
+
+	// Client certificate authentication. Certificates signed by the CA
+	// authenticate their node without a token.
+	ClientCAFile string            `json:"client_ca_file" yaml:"client_ca_file"`
+	ClientAuth   string            `json:"client_auth" yaml:"client_auth"`   // "request" (default) or "require"
+	ClientNodes  map[string]string `json:"client_nodes" yaml:"client_nodes"` // Subject DN or CN to node ID; unmapped certificates use their CN
+	ClientScope  string            `json:"client_scope" yaml:"client_scope"` // Scopes of certificates used without a token (default "ingest")
+}
+
+// Enabled reports whether the server should serve TLS
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != ""
+}
+
+// Validate checks the TLS configuration
+func (c TLSConfig) Validate() error {
+	if !c.Enabled() {
+		if c.ClientCAFile != "" {
+			return fmt.Errorf("tls client_ca_file requires cert_file and key_file")
+		}
+		return nil
+	}
+	if c.CertFile == "" || c.KeyFile == "" {
+		return fmt.Errorf("tls requires both cert_file and key_file")
+	}
+	switch c.ClientAuth {
+	case "", ClientAuthRequest, ClientAuthRequire:
+	default:
+		return fmt.Errorf("invalid tls client_auth %q (must be %q or %q)", c.ClientAuth, ClientAuthRequest, ClientAuthRequire)
+	}
+	if c.ClientAuth == ClientAuthRequire && c.ClientCAFile == "" {
+		return fmt.Errorf("tls client_auth %q requires client_ca_file", ClientAuthRequire)
+	}
+	return nil
+}
+
+// certNode returns the node a verified client certificate identifies
+func (c TLSConfig) certNode(cert *x509.Certificate) string {
+	if node, ok := c.ClientNodes[cert.Subject.String()]; ok {
+		return node
+	}
+	if node, ok := c.ClientNodes[cert.Subject.CommonName]; ok {
+		return node
+	}
+	return cert.Subject.CommonName
+}
+
+// certReloader serves a certificate and CA pool from files, picking up
+// replaced files without a restart. If reloading fails the previous
+// certificate is kept.
+type certReloader struct {
+	certFile, keyFile, caFile string
+	logger                    Logger
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+	modTimes  [3]time.Time
+	checkedAt time.Time
+}
+
+func newCertReloader(certFile, keyFile, caFile string, logger Logger) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile, caFile: caFile, logger: logger}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload reads the files if any changed since they were last read. The
+// caller must hold r.mu or have exclusive access.
+func (r *certReloader) reload() error {
+	r.checkedAt = time.Now()
+
+	var modTimes [3]time.Time
+	for i, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	if modTimes == r.modTimes {
+		return nil
+	}
+
+	var cert *tls.Certificate
+	if r.certFile != "" {
+		loaded, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to load certificate: %w", err)
+		}
+		cert = &loaded
+	}
+
+	var pool *x509.CertPool
+	if r.caFile != "" {
+		var err error
+		if pool, err = loadCertPool(r.caFile); err != nil {
+			return err
+		}
+	}
+
+	if r.cert != nil && r.logger != nil {
+		r.logger.Info("Reloaded TLS certificates", "cert_file", r.certFile, "ca_file", r.caFile)
+	}
+	r.cert, r.pool, r.modTimes = cert, pool, modTimes
+	return nil
+}
+
+// current returns the certificate and CA pool, reloading them if due
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) >= tlsReloadInterval {
+		if err := r.reload(); err != nil && r.logger != nil {
+			r.logger.Error("Failed to reload TLS certificates, keeping the previous ones", "error", err)
+		}
+	}
+	return r.cert, r.pool
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// NewServerTLSConfig creates the server's TLS configuration. The certificate
+// and client CA are reloaded when their files change.
+func NewServerTLSConfig(c TLSConfig, logger Logger) (*tls.Config, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if !c.Enabled() {
+		return nil, errors.New("tls is not configured")
+	}
+
+	reloader, err := newCertReloader(c.CertFile, c.KeyFile, c.ClientCAFile, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth := tls.NoClientCert
+	if c.ClientCAFile != "" {
+		clientAuth = tls.VerifyClientCertIfGiven
+		if c.ClientAuth == ClientAuthRequire {
+			clientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _ := reloader.current()
+			return cert, nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := reloader.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+				ClientAuth:   clientAuth,
+				NextProtos:   []string{"h2", "http/1.1"},
+			}, nil
+		},
+	}, nil
+}
+
+// NewClientTLSConfig creates the TLS configuration agents connect with. The
+// CA verifies the server instead of the system roots; the client certificate
+// is reloaded when its files change. All arguments are optional.
+func NewClientTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("a client certificate requires both --tls-cert and --tls-key")
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" {
+		reloader, err := newCertReloader(certFile, keyFile, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := reloader.current()
+			return cert, nil
+		}
+	}
+	return config, nil
+}
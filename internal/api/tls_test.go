@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-agent/v4/internal/api/store"
+)
+
+// writeTestCert writes a certificate and key signed by parent (self-signed if
+// nil) to dir and returns them
+func writeTestCert(t *testing.T, dir, name string, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestServer_ClientCertificateAuth(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := writeTestCert(t, dir, "ca", &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	writeTestCert(t, dir, "server", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "server"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca, caKey)
+	writeTestCert(t, dir, "agent", &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "agent-a"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	repo, err := store.NewEmbeddedRepository(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewEmbeddedRepository() error = %v", err)
+	}
+	server := NewServer(repo, &DefaultLogger{}, "test-secret")
+
+	config := TLSConfig{
+		CertFile:     filepath.Join(dir, "server.pem"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+		ClientNodes:  map[string]string{"agent-a": "node-a"},
+	}
+	tlsConfig, err := NewServerTLSConfig(config, &DefaultLogger{})
+	if err != nil {
+		t.Fatalf("NewServerTLSConfig() error = %v", err)
+	}
+	server.SetTLSConfig(tlsConfig, config)
+
+	ts := httptest.NewUnstartedServer(server)
+	ts.TLS = tlsConfig
+	ts.StartTLS()
+	defer ts.Close()
+
+	sessionID := "5d6f1a2b-3c4d-4e5f-8a9b-0c1d2e3f4a5b"
+	post := func(t *testing.T, caFile, certFile, keyFile string) int {
+		t.Helper()
+		clientTLS, err := NewClientTLSConfig(caFile, certFile, keyFile)
+		if err != nil {
+			t.Fatalf("NewClientTLSConfig() error = %v", err)
+		}
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}}
+		body := strings.NewReader(`{"session": {"sessionid": "` + sessionID + `"}}`)
+		resp, err := client.Post(ts.URL+"/v3/lobby-session-events", "application/json", body)
+		if err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	caFile := filepath.Join(dir, "ca.pem")
+	if code := post(t, caFile, "", ""); code != http.StatusUnauthorized {
+		t.Errorf("ingest without certificate or token = %d, want %d", code, http.StatusUnauthorized)
+	}
+	if code := post(t, caFile, filepath.Join(dir, "agent.pem"), filepath.Join(dir, "agent.key")); code != http.StatusOK {
+		t.Fatalf("ingest with client certificate = %d, want %d", code, http.StatusOK)
+	}
+
+	frames, err := repo.Frames(context.Background(), sessionID)
+	if err != nil || len(frames) != 1 {
+		t.Fatalf("Frames() = %d frames, %v, want 1", len(frames), err)
+	}
+	if frames[0].NodeID != "node-a" {
+		t.Errorf("frame node = %q, want node-a", frames[0].NodeID)
+	}
+}
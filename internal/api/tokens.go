@@ -0,0 +1,295 @@
+package api
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported token signing algorithms
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmEdDSA = "EdDSA"
+	AlgorithmRS256 = "RS256"
+)
+
+// revocationReloadInterval is how often the revocation file is checked for changes
+const revocationReloadInterval = 10 * time.Second
+
+var errRevoked = errors.New("token has been revoked")
+
+// JWTKey configures a token signing key. Tokens name the key that signed them
+// in their "kid" header, so keys can be rotated by adding the new key,
+// issuing tokens with it and removing the old key once its tokens expire.
+type JWTKey struct {
+	ID        string `json:"kid" yaml:"kid"`
+	Algorithm string `json:"algorithm" yaml:"algorithm"` // HS256, EdDSA or RS256
+
+	// HS256 shared secret
+	Secret string `json:"secret,omitempty" yaml:"secret,omitempty"`
+
+	// PEM key files for EdDSA (Ed25519) and RS256. The server only needs the
+	// public key; the private key is needed to issue tokens.
+	PublicKeyFile  string `json:"public_key_file,omitempty" yaml:"public_key_file,omitempty"`
+	PrivateKeyFile string `json:"private_key_file,omitempty" yaml:"private_key_file,omitempty"`
+}
+
+// SigningKey is a loaded token signing key
+type SigningKey struct {
+	ID     string
+	Method jwt.SigningMethod
+
+	verifyKey any
+	signKey   any // Nil if the key can only verify
+}
+
+// NewHMACKey creates an HS256 signing key
+func NewHMACKey(id string, secret []byte) *SigningKey {
+	return &SigningKey{
+		ID:        id,
+		Method:    jwt.SigningMethodHS256,
+		verifyKey: secret,
+		signKey:   secret,
+	}
+}
+
+// CanSign reports whether tokens can be issued with the key
+func (k *SigningKey) CanSign() bool {
+	return k.signKey != nil
+}
+
+// Load reads the key material of a configured key
+func (k JWTKey) Load() (*SigningKey, error) {
+	if k.ID == "" {
+		return nil, fmt.Errorf("jwt key has no kid")
+	}
+
+	switch k.Algorithm {
+	case AlgorithmHS256:
+		if k.Secret == "" {
+			return nil, fmt.Errorf("jwt key %q: secret is required for HS256", k.ID)
+		}
+		return NewHMACKey(k.ID, []byte(k.Secret)), nil
+	case AlgorithmEdDSA, AlgorithmRS256:
+	default:
+		return nil, fmt.Errorf("jwt key %q: unsupported algorithm %q (must be HS256, EdDSA or RS256)", k.ID, k.Algorithm)
+	}
+	if k.PublicKeyFile == "" && k.PrivateKeyFile == "" {
+		return nil, fmt.Errorf("jwt key %q: public_key_file or private_key_file is required for %s", k.ID, k.Algorithm)
+	}
+
+	key := &SigningKey{ID: k.ID}
+	if k.PrivateKeyFile != "" {
+		data, err := os.ReadFile(k.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", k.ID, err)
+		}
+		var signer crypto.Signer
+		if k.Algorithm == AlgorithmEdDSA {
+			private, err := jwt.ParseEdPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %q: %w", k.ID, err)
+			}
+			signer = private.(ed25519.PrivateKey)
+		} else {
+			private, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %q: %w", k.ID, err)
+			}
+			signer = private
+		}
+		key.signKey = signer
+		key.verifyKey = signer.Public()
+	}
+	if k.PublicKeyFile != "" {
+		data, err := os.ReadFile(k.PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", k.ID, err)
+		}
+		if k.Algorithm == AlgorithmEdDSA {
+			key.verifyKey, err = jwt.ParseEdPublicKeyFromPEM(data)
+		} else {
+			key.verifyKey, err = jwt.ParseRSAPublicKeyFromPEM(data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", k.ID, err)
+		}
+	}
+
+	switch key.verifyKey.(type) {
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	case *rsa.PublicKey:
+		key.Method = jwt.SigningMethodRS256
+	}
+	return key, nil
+}
+
+// LoadSigningKeys loads the configured keys, rejecting duplicate kids
+func LoadSigningKeys(configs []JWTKey) ([]*SigningKey, error) {
+	keys := make([]*SigningKey, 0, len(configs))
+	seen := make(map[string]bool, len(configs))
+	for _, cfg := range configs {
+		if seen[cfg.ID] {
+			return nil, fmt.Errorf("duplicate jwt key %q", cfg.ID)
+		}
+		seen[cfg.ID] = true
+
+		key, err := cfg.Load()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// IssueToken signs a token with the given claims. A random token ID is set
+// so the token can be revoked, and the key's kid is set in the header.
+func IssueToken(key *SigningKey, claims Claims) (string, error) {
+	if !key.CanSign() {
+		return "", fmt.Errorf("jwt key %q has no private key to sign with", key.ID)
+	}
+	if claims.ID == "" {
+		claims.ID = uuid.Must(uuid.NewV4()).String()
+	}
+	if claims.IssuedAt == nil {
+		claims.IssuedAt = jwt.NewNumericDate(time.Now())
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+	return token.SignedString(key.signKey)
+}
+
+// RevokedToken is an entry of the revocation list
+type RevokedToken struct {
+	ID        string    `json:"jti"`
+	RevokedAt time.Time `json:"revoked_at"`
+	ExpiresAt time.Time `json:"expires_at,omitempty"` // Entry can be dropped after this; zero keeps it
+}
+
+// RevocationList is a file of revoked token IDs shared by the token commands
+// and the server, which picks up changes without a restart
+type RevocationList struct {
+	path string
+
+	mu        sync.Mutex
+	revoked   map[string]RevokedToken
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+// NewRevocationList opens the revocation list stored at path. The file does
+// not need to exist yet.
+func NewRevocationList(path string) (*RevocationList, error) {
+	l := &RevocationList{path: path}
+	if err := l.reload(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// reload reads the file if it changed since it was last read. The caller must
+// hold l.mu or have exclusive access.
+func (l *RevocationList) reload() error {
+	l.checkedAt = time.Now()
+
+	info, err := os.Stat(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		l.revoked = make(map[string]RevokedToken)
+		l.modTime = time.Time{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat revocation list: %w", err)
+	}
+	if l.revoked != nil && info.ModTime().Equal(l.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		return fmt.Errorf("failed to read revocation list: %w", err)
+	}
+	var entries []RevokedToken
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &entries); err != nil {
+			return fmt.Errorf("invalid revocation list %s: %w", l.path, err)
+		}
+	}
+
+	l.revoked = make(map[string]RevokedToken, len(entries))
+	for _, entry := range entries {
+		l.revoked[entry.ID] = entry
+	}
+	l.modTime = info.ModTime()
+	return nil
+}
+
+// IsRevoked reports whether the token ID has been revoked. The file is
+// re-read at most every few seconds; if it cannot be read the last loaded
+// list is used.
+func (l *RevocationList) IsRevoked(id string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if time.Since(l.checkedAt) >= revocationReloadInterval {
+		_ = l.reload()
+	}
+	_, ok := l.revoked[id]
+	return ok
+}
+
+// Revoke adds a token ID to the list. Expired entries are dropped while the
+// file is rewritten.
+func (l *RevocationList) Revoke(id string, expiresAt time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := l.reload(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	l.revoked[id] = RevokedToken{ID: id, RevokedAt: now, ExpiresAt: expiresAt}
+
+	entries := make([]RevokedToken, 0, len(l.revoked))
+	for _, entry := range l.revoked {
+		if !entry.ExpiresAt.IsZero() && entry.ExpiresAt.Before(now) {
+			delete(l.revoked, entry.ID)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write atomically so the server never reads a partial list
+	tmp := l.path + ".tmp"
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("failed to create revocation list directory: %w", err)
+	}
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write revocation list: %w", err)
+	}
+	if err := os.Rename(tmp, l.path); err != nil {
+		return fmt.Errorf("failed to replace revocation list: %w", err)
+	}
+	return nil
+}
@@ -2,13 +2,12 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
 
-	"github.com/echotools/nevr-agent/v4/internal/amqp"
 	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
-	"github.com/gofrs/uuid/v5"
 	"github.com/gorilla/websocket"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -46,15 +45,15 @@ func (s *Server) WebSocketStreamHandler(w http.ResponseWriter, r *http.Request)
 	}
 	defer conn.Close()
 
-	// Extract optional node and user ID from headers
-	node := r.Header.Get("X-Node-ID")
-	if node == "" {
-		node = "default-node"
-	}
-	userID := r.Header.Get("X-User-ID")
+	node, userID := s.auth.Identity(r.Context())
 
 	s.logger.Info("WebSocket connection established", "remote_addr", r.RemoteAddr, "node", node, "user_id", userID)
 
+	if s.metrics != nil {
+		s.metrics.RecordWebSocketConnect()
+		defer s.metrics.RecordWebSocketDisconnect()
+	}
+
 	// Configure connection
 	conn.SetReadLimit(maxMessageSize)
 	conn.SetReadDeadline(time.Now().Add(pongWait))
@@ -83,7 +82,8 @@ func (s *Server) WebSocketStreamHandler(w http.ResponseWriter, r *http.Request)
 	for {
 		select {
 		case message := <-messageChan:
-			if err := s.processWebSocketMessage(ctx, message, node, userID); err != nil {
+			duplicate, err := s.processWebSocketMessage(ctx, message, node, userID)
+			if err != nil {
 				s.logger.Error("Failed to process message", "error", err)
 				// Send error back to client
 				if err := s.sendWebSocketError(conn, err); err != nil {
@@ -92,7 +92,7 @@ func (s *Server) WebSocketStreamHandler(w http.ResponseWriter, r *http.Request)
 				}
 			} else {
 				// Send success acknowledgment
-				if err := s.sendWebSocketAck(conn); err != nil {
+				if err := s.sendWebSocketAck(conn, duplicate); err != nil {
 					s.logger.Error("Failed to send acknowledgment", "error", err)
 					return
 				}
@@ -145,67 +145,56 @@ func (s *Server) writeWebSocketPings(conn *websocket.Conn, ticker *time.Ticker,
 	}
 }
 
-// processWebSocketMessage processes a single message from the websocket
-func (s *Server) processWebSocketMessage(ctx context.Context, message []byte, node, userID string) error {
+// processWebSocketMessage processes a single message from the websocket and
+// reports whether it carried a frame that was already stored
+func (s *Server) processWebSocketMessage(ctx context.Context, message []byte, node, userID string) (duplicate bool, err error) {
+	if s.metrics != nil {
+		s.metrics.RecordWebSocketMessage()
+	}
+
 	// Parse the payload as Envelope
 	msg := &telemetry.Envelope{}
 	if err := protojson.Unmarshal(message, msg); err != nil {
-		return fmt.Errorf("invalid protobuf payload: %w", err)
+		return false, fmt.Errorf("invalid protobuf payload: %w", err)
 	}
 
 	// Ignore messages that are not LobbySessionStateFrame
 	if msg.GetFrame() == nil || msg.GetFrame().GetSession() == nil {
-		return nil
-	}
-
-	frame := msg.GetFrame()
-	lobbySessionID := frame.GetSession().GetSessionId()
-
-	matchID := MatchID{
-		UUID: uuid.FromStringOrNil(lobbySessionID),
-		Node: node,
+		return false, nil
 	}
 
-	if !matchID.IsValid() {
-		return fmt.Errorf("invalid match ID: %s", lobbySessionID)
+	duplicate, err = s.storeFrame(ctx, node, userID, msg.GetFrame(), len(message))
+	var limitErr *RateLimitError
+	if err != nil && !errors.Is(err, errInvalidMatchID) && !errors.As(err, &limitErr) {
+		return false, fmt.Errorf("failed to store session frame: %w", err)
 	}
-
-	// Store the frame to MongoDB
-	if err := StoreSessionFrame(ctx, s.mongoClient, lobbySessionID, userID, frame); err != nil {
-		return fmt.Errorf("failed to store session frame: %w", err)
-	}
-
-	// Publish to AMQP if publisher is available
-	if s.amqpPublisher != nil && s.amqpPublisher.IsConnected() {
-		amqpEvent := &amqp.MatchEvent{
-			Type:           "session.frame",
-			LobbySessionID: lobbySessionID,
-			UserID:         userID,
-			Timestamp:      frame.Timestamp.AsTime(),
-		}
-		if err := s.amqpPublisher.Publish(ctx, amqpEvent); err != nil {
-			// Log error but don't fail - AMQP is best-effort
-			s.logger.Warn("Failed to publish AMQP event", "error", err)
-		}
-	}
-
-	return nil
+	return duplicate, err
 }
 
-// sendWebSocketError sends an error message to the client
+// sendWebSocketError sends an error message to the client. Frames rejected by
+// an ingest limit carry the "rate_limited" code and the seconds to wait.
 func (s *Server) sendWebSocketError(conn *websocket.Conn, err error) error {
 	response := map[string]interface{}{
 		"success": false,
 		"error":   err.Error(),
 	}
+	var limitErr *RateLimitError
+	if errors.As(err, &limitErr) {
+		response["code"] = "rate_limited"
+		response["retry_after"] = limitErr.RetryAfterSeconds()
+	}
 	return s.sendWebSocketJSON(conn, response)
 }
 
-// sendWebSocketAck sends a success acknowledgment to the client
-func (s *Server) sendWebSocketAck(conn *websocket.Conn) error {
+// sendWebSocketAck sends a success acknowledgment to the client, marked as a
+// duplicate when the frame was already stored
+func (s *Server) sendWebSocketAck(conn *websocket.Conn, duplicate bool) error {
 	response := map[string]interface{}{
 		"success": true,
 	}
+	if duplicate {
+		response["duplicate"] = true
+	}
 	return s.sendWebSocketJSON(conn, response)
 }
 
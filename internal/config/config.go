@@ -44,24 +44,106 @@ type AgentConfig struct {
 	// Events API configuration
 	EventsEnabled bool   `yaml:"events_enabled" mapstructure:"events_enabled"`
 	EventsURL     string `yaml:"events_url" mapstructure:"events_url"`
+
+	// Batching of frames posted to the events API; a batch size of 1 posts
+	// each frame as JSON on its own
+	EventsBatchSize   int    `yaml:"events_batch_size" mapstructure:"events_batch_size"`
+	EventsFormat      string `yaml:"events_format" mapstructure:"events_format"`           // protobuf or ndjson
+	EventsCompression string `yaml:"events_compression" mapstructure:"events_compression"` // none, gzip or zstd
+
+	// gRPC ingest stream, e.g. http://localhost:9091; empty disables it
+	GRPCURL string `yaml:"grpc_url" mapstructure:"grpc_url"`
+
+	// TLS for the events API, WebSocket and gRPC streams (https/wss URLs)
+	TLSCA   string `yaml:"tls_ca" mapstructure:"tls_ca"`     // CA that verifies the server, instead of the system roots
+	TLSCert string `yaml:"tls_cert" mapstructure:"tls_cert"` // Client certificate for mutual TLS
+	TLSKey  string `yaml:"tls_key" mapstructure:"tls_key"`
 }
 
 // APIServerConfig holds configuration for the API server subcommand
 type APIServerConfig struct {
 	ServerAddress string `yaml:"server_address" mapstructure:"server_address"`
 	MongoURI      string `yaml:"mongo_uri" mapstructure:"mongo_uri"`
-	JWTSecret     string `yaml:"jwt_secret" mapstructure:"jwt_secret"`
+	JWTSecret     string `yaml:"jwt_secret" mapstructure:"jwt_secret"` // Verifies tokens without a kid header
+
+	// Token signing keys selected by the token's kid header, for rotation and
+	// asymmetric signing
+	JWTKeys           []JWTKeyConfig `yaml:"jwt_keys" mapstructure:"jwt_keys"`
+	JWTRevocationFile string         `yaml:"jwt_revocation_file" mapstructure:"jwt_revocation_file"` // Revoked token IDs
+
+	// Optional HTTPS and client certificate authentication
+	TLS TLSServerConfig `yaml:"tls" mapstructure:"tls"`
+
+	// Storage backend: "mongo" or "embedded" (file-backed, no MongoDB required)
+	StorageBackend string `yaml:"storage_backend" mapstructure:"storage_backend"`
+	DataDir        string `yaml:"data_dir" mapstructure:"data_dir"` // Directory for the embedded backend
 
 	// Capture storage configuration
 	CaptureDir       string `yaml:"capture_dir" mapstructure:"capture_dir"`
 	CaptureRetention string `yaml:"capture_retention" mapstructure:"capture_retention"` // Duration string (e.g., "24h", "7d")
 	CaptureMaxSize   int64  `yaml:"capture_max_size" mapstructure:"capture_max_size"`   // Max storage in bytes
 
-	// Rate limiting
-	MaxStreamHz int `yaml:"max_stream_hz" mapstructure:"max_stream_hz"` // Max frames per second from clients
+	// Session retention: expired sessions are archived to the archive directory
+	// and removed from session storage. Empty keeps sessions forever. Archives
+	// are not subject to the capture retention and size limits.
+	ArchiveDir            string                 `yaml:"archive_dir" mapstructure:"archive_dir"` // Default: "archive" in the capture directory
+	SessionRetention      string                 `yaml:"session_retention" mapstructure:"session_retention"`
+	SessionRetentionRules []SessionRetentionRule `yaml:"session_retention_rules" mapstructure:"session_retention_rules"`
+
+	// Ingest rate limits and quotas; zero disables a limit
+	MaxStreamHz      int   `yaml:"max_stream_hz" mapstructure:"max_stream_hz"`             // Max frames per second per node
+	TokenMaxStreamHz int   `yaml:"token_max_stream_hz" mapstructure:"token_max_stream_hz"` // Max frames per second per token
+	IngestBurst      int   `yaml:"ingest_burst" mapstructure:"ingest_burst"`               // Frames accepted at once above the rate
+	DailyFrameQuota  int64 `yaml:"daily_frame_quota" mapstructure:"daily_frame_quota"`     // Frames per node per UTC day
+	DailyByteQuota   int64 `yaml:"daily_byte_quota" mapstructure:"daily_byte_quota"`       // Bytes per node per UTC day
+
+	// Stream subscribers
+	StreamKeyframeInterval    int    `yaml:"stream_keyframe_interval" mapstructure:"stream_keyframe_interval"`         // Frames between keyframes on the binary delta protocol (0 = 60)
+	StreamSlowConsumerPolicy  string `yaml:"stream_slow_consumer_policy" mapstructure:"stream_slow_consumer_policy"`   // drop, coalesce or disconnect
+	StreamSlowConsumerTimeout string `yaml:"stream_slow_consumer_timeout" mapstructure:"stream_slow_consumer_timeout"` // Time behind before disconnecting (e.g., "10s")
+
+	// Frame bus between API server replicas: empty for none, or "amqp"
+	StreamBus         string `yaml:"stream_bus" mapstructure:"stream_bus"`
+	StreamBusExchange string `yaml:"stream_bus_exchange" mapstructure:"stream_bus_exchange"` // Fanout exchange (default "match.frames")
+	AMQPURI           string `yaml:"amqp_uri" mapstructure:"amqp_uri"`                       // Broker of the amqp stream bus
+	InstanceID        string `yaml:"instance_id" mapstructure:"instance_id"`                 // ID of this replica on the bus (default: host name and random suffix)
 
 	// Metrics
 	MetricsAddr string `yaml:"metrics_addr" mapstructure:"metrics_addr"` // Prometheus metrics endpoint address
+
+	// gRPC
+	GRPCAddress string `yaml:"grpc_address" mapstructure:"grpc_address"` // gRPC listen address; empty disables gRPC
+}
+
+// SessionRetentionRule overrides the session retention for matching sessions.
+// Empty match fields match any session; the first matching rule wins.
+type SessionRetentionRule struct {
+	NodeID       string `yaml:"node_id" mapstructure:"node_id"`
+	MatchType    string `yaml:"match_type" mapstructure:"match_type"`
+	PrivateMatch *bool  `yaml:"private_match" mapstructure:"private_match"`
+	Retention    string `yaml:"retention" mapstructure:"retention"` // Duration string, "0" keeps matching sessions forever
+}
+
+// TLSServerConfig configures TLS on the API server. Certificate files are
+// reloaded when they change.
+type TLSServerConfig struct {
+	CertFile string `yaml:"cert_file" mapstructure:"cert_file"`
+	KeyFile  string `yaml:"key_file" mapstructure:"key_file"`
+
+	// Client certificates signed by this CA authenticate their node
+	ClientCAFile string            `yaml:"client_ca_file" mapstructure:"client_ca_file"`
+	ClientAuth   string            `yaml:"client_auth" mapstructure:"client_auth"`   // "request" (default) or "require"
+	ClientNodes  map[string]string `yaml:"client_nodes" mapstructure:"client_nodes"` // Subject DN or CN to node ID; default is the CN
+	ClientScope  string            `yaml:"client_scope" mapstructure:"client_scope"` // Scopes without a token (default "ingest")
+}
+
+// JWTKeyConfig configures a token signing key
+type JWTKeyConfig struct {
+	ID             string `yaml:"kid" mapstructure:"kid"`
+	Algorithm      string `yaml:"algorithm" mapstructure:"algorithm"` // HS256, EdDSA or RS256
+	Secret         string `yaml:"secret" mapstructure:"secret"`       // HS256 only
+	PublicKeyFile  string `yaml:"public_key_file" mapstructure:"public_key_file"`
+	PrivateKeyFile string `yaml:"private_key_file" mapstructure:"private_key_file"` // Needed to issue tokens
 }
 
 // ConverterConfig holds configuration for the converter subcommand
@@ -92,11 +174,15 @@ func DefaultConfig() *Config {
 			Format:          "nevrcap",
 			OutputDirectory: "output",
 			EventsURL:       "http://localhost:8081",
+			EventsBatchSize: 20,
+			EventsFormat:    "protobuf",
 		},
 		APIServer: APIServerConfig{
 			ServerAddress:    ":8081",
 			MongoURI:         "mongodb://localhost:27017",
 			JWTSecret:        "",
+			StorageBackend:   "mongo",
+			DataDir:          "./data",
 			CaptureDir:       "./captures",
 			CaptureRetention: "168h",                  // 7 days
 			CaptureMaxSize:   10 * 1024 * 1024 * 1024, // 10GB
@@ -219,6 +305,22 @@ func (c *Config) ValidateAgentConfig() error {
 			return fmt.Errorf("failed to create output directory: %w", err)
 		}
 	}
+	if (c.Agent.TLSCert == "") != (c.Agent.TLSKey == "") {
+		return fmt.Errorf("tls cert and tls key must be specified together")
+	}
+	if c.Agent.EventsBatchSize < 0 {
+		return fmt.Errorf("events batch size must not be negative")
+	}
+	switch c.Agent.EventsFormat {
+	case "", "protobuf", "ndjson":
+	default:
+		return fmt.Errorf("invalid events format %q: must be protobuf or ndjson", c.Agent.EventsFormat)
+	}
+	switch c.Agent.EventsCompression {
+	case "", "none", "gzip", "zstd":
+	default:
+		return fmt.Errorf("invalid events compression %q: must be none, gzip or zstd", c.Agent.EventsCompression)
+	}
 	return nil
 }
 
@@ -227,11 +329,29 @@ func (c *Config) ValidateAPIServerConfig() error {
 	if c.APIServer.ServerAddress == "" {
 		return fmt.Errorf("server address must be specified")
 	}
-	if c.APIServer.MongoURI == "" {
-		return fmt.Errorf("mongo URI must be specified")
+	switch c.APIServer.StorageBackend {
+	case "", "mongo":
+		if c.APIServer.MongoURI == "" {
+			return fmt.Errorf("mongo URI must be specified")
+		}
+	case "embedded":
+		if c.APIServer.DataDir == "" {
+			return fmt.Errorf("data directory must be specified for embedded storage")
+		}
+	default:
+		return fmt.Errorf("invalid storage backend: %s (must be 'mongo' or 'embedded')", c.APIServer.StorageBackend)
+	}
+	if c.APIServer.JWTSecret == "" && len(c.APIServer.JWTKeys) == 0 {
+		return fmt.Errorf("jwt secret or jwt keys must be specified")
+	}
+	if (c.APIServer.TLS.CertFile == "") != (c.APIServer.TLS.KeyFile == "") {
+		return fmt.Errorf("tls cert file and key file must be specified together")
+	}
+	if c.APIServer.TLS.ClientCAFile != "" && c.APIServer.TLS.CertFile == "" {
+		return fmt.Errorf("tls client CA requires a server certificate")
 	}
-	if c.APIServer.JWTSecret == "" {
-		return fmt.Errorf("jwt secret must be specified")
+	if c.APIServer.CaptureDir == "" && (c.APIServer.SessionRetention != "" || len(c.APIServer.SessionRetentionRules) > 0) {
+		return fmt.Errorf("capture directory must be specified to archive expired sessions")
 	}
 	return nil
 }
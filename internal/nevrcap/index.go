@@ -0,0 +1,195 @@
+package nevrcap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+// IndexSuffix is appended to the path of a capture to name its index
+const IndexSuffix = ".idx"
+
+// LockSuffix is appended to the path of a capture to name the lock file
+// present while it is written
+const LockSuffix = ".lock"
+
+const indexVersion = 1
+
+// ErrStaleIndex is returned for an index that does not match its capture
+var ErrStaleIndex = errors.New("capture index is out of date")
+
+// ErrCaptureInUse is returned by Reindex for a capture that is being written
+var ErrCaptureInUse = errors.New("capture is being written")
+
+// ActiveCaptureAge is how recently a capture without a lock file must have
+// been modified to be taken as still being written, by a writer that does
+// not create lock files
+var ActiveCaptureAge = 2 * time.Minute
+
+// Index maps the positions of a capture to its blocks. Each block is a zstd
+// frame that can be decoded on its own, from its offset in the file.
+type Index struct {
+	Version int     `json:"version"`
+	Size    int64   `json:"size"`   // Bytes of the capture covered by the index
+	Frames  int     `json:"frames"` // Frames in the capture
+	Blocks  []Block `json:"blocks"`
+	Rounds  []Round `json:"rounds,omitempty"`
+
+	lastRound int
+}
+
+// Block describes a block of a capture by its first frame
+type Block struct {
+	Offset     int64     `json:"offset"`      // Byte offset of the block in the file
+	Seq        int       `json:"seq"`         // Frames before the block
+	FrameIndex uint32    `json:"frame_index"` // Index of the first frame
+	Timestamp  time.Time `json:"timestamp"`   // Timestamp of the first frame
+	Round      int       `json:"round"`       // Round of the first frame
+}
+
+// Round marks the first frame of a round
+type Round struct {
+	Number int `json:"number"`
+	Seq    int `json:"seq"` // Frames before the round
+}
+
+// FrameRound returns the round a frame belongs to, counting from 1: one more
+// than the rounds already won by either team
+func FrameRound(frame *telemetry.LobbySessionStateFrame) int {
+	session := frame.GetSession()
+	return int(session.GetBlueRoundScore()+session.GetOrangeRoundScore()) + 1
+}
+
+// IndexPath returns the path of the index of the capture at path
+func IndexPath(path string) string {
+	return path + IndexSuffix
+}
+
+// LockPath returns the path of the lock file of the capture at path
+func LockPath(path string) string {
+	return path + LockSuffix
+}
+
+func (idx *Index) addBlock(offset int64, frame *telemetry.LobbySessionStateFrame) {
+	block := Block{
+		Offset:     offset,
+		Seq:        idx.Frames,
+		FrameIndex: frame.GetFrameIndex(),
+		Round:      FrameRound(frame),
+	}
+	if ts := frame.GetTimestamp(); ts != nil {
+		block.Timestamp = ts.AsTime()
+	}
+	idx.Blocks = append(idx.Blocks, block)
+}
+
+func (idx *Index) addFrame(frame *telemetry.LobbySessionStateFrame) {
+	if round := FrameRound(frame); round != idx.lastRound {
+		idx.Rounds = append(idx.Rounds, Round{Number: round, Seq: idx.Frames})
+		idx.lastRound = round
+	}
+	idx.Frames++
+}
+
+func (idx *Index) clone() *Index {
+	c := *idx
+	c.Blocks = append([]Block(nil), idx.Blocks...)
+	c.Rounds = append([]Round(nil), idx.Rounds...)
+	return &c
+}
+
+// BlockForSeq returns the block holding the frame with sequence number seq
+func (idx *Index) BlockForSeq(seq int) (Block, bool) {
+	i := sort.Search(len(idx.Blocks), func(i int) bool { return idx.Blocks[i].Seq > seq })
+	if i == 0 {
+		return Block{}, false
+	}
+	return idx.Blocks[i-1], true
+}
+
+// BlockForFrame returns the last block starting at or before frameIndex.
+// Frame indices increase through a capture.
+func (idx *Index) BlockForFrame(frameIndex uint32) (Block, bool) {
+	i := sort.Search(len(idx.Blocks), func(i int) bool { return idx.Blocks[i].FrameIndex > frameIndex })
+	if i == 0 {
+		return idx.firstBlock()
+	}
+	return idx.Blocks[i-1], true
+}
+
+// BlockForTime returns the last block starting at or before t
+func (idx *Index) BlockForTime(t time.Time) (Block, bool) {
+	i := sort.Search(len(idx.Blocks), func(i int) bool { return idx.Blocks[i].Timestamp.After(t) })
+	if i == 0 {
+		return idx.firstBlock()
+	}
+	return idx.Blocks[i-1], true
+}
+
+// BlockForRound returns the block holding the first frame of a round, or of
+// the first later round if the capture has no frames of it
+func (idx *Index) BlockForRound(round int) (Block, bool) {
+	for _, r := range idx.Rounds {
+		if r.Number >= round {
+			return idx.BlockForSeq(r.Seq)
+		}
+	}
+	return Block{}, false
+}
+
+func (idx *Index) firstBlock() (Block, bool) {
+	if len(idx.Blocks) == 0 {
+		return Block{}, false
+	}
+	return idx.Blocks[0], true
+}
+
+// Save writes the index to path
+func (idx *Index) Save(path string) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+
+	// Write under a temporary name so readers never see a partial index
+	tmpPath := path + ".partial"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// LoadIndex reads the index of the capture at path. It returns
+// ErrStaleIndex if the capture has changed size since it was indexed.
+func LoadIndex(path string) (*Index, error) {
+	data, err := os.ReadFile(IndexPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &Index{}
+	if err := json.Unmarshal(data, idx); err != nil {
+		return nil, fmt.Errorf("invalid capture index: %w", err)
+	}
+	if idx.Version != indexVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrStaleIndex, idx.Version)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() != idx.Size {
+		return nil, ErrStaleIndex
+	}
+	return idx, nil
+}
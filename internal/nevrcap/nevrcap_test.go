@@ -0,0 +1,202 @@
+package nevrcap
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/echotools/nevr-capture/v3/pkg/codecs"
+	"github.com/echotools/nevr-common/v4/gen/go/apigame"
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+)
+
+// testFrame returns frame i of a match whose second round starts at frame 6
+func testFrame(i uint32) *telemetry.LobbySessionStateFrame {
+	session := &apigame.SessionResponse{}
+	if i >= 6 {
+		session.OrangeRoundScore = 1
+	}
+	return &telemetry.LobbySessionStateFrame{FrameIndex: i, Session: session}
+}
+
+func readFrames(t *testing.T, r *Reader) []uint32 {
+	t.Helper()
+	var got []uint32
+	for {
+		frame, err := r.ReadFrame()
+		if err == io.EOF {
+			return got
+		}
+		if err != nil {
+			t.Fatalf("ReadFrame() error = %v", err)
+		}
+		got = append(got, frame.GetFrameIndex())
+	}
+}
+
+func TestWriter_IndexedBlocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "match.nevrcap")
+	w, err := CreateWithBlockSize(path, 3)
+	if err != nil {
+		t.Fatalf("CreateWithBlockSize() error = %v", err)
+	}
+	w.WriteHeader(&telemetry.TelemetryHeader{CaptureId: "match"})
+	for i := uint32(1); i <= 10; i++ {
+		if err := w.WriteFrame(testFrame(i)); err != nil {
+			t.Fatalf("WriteFrame() error = %v", err)
+		}
+	}
+	// Frame 10 starts a block that is not written yet
+	if live := w.Index(); len(live.Blocks) != 3 || live.Frames != 9 {
+		t.Errorf("live index has %d blocks and %d frames, want 3 and 9", len(live.Blocks), live.Frames)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	index, err := LoadIndex(path)
+	if err != nil {
+		t.Fatalf("LoadIndex() error = %v", err)
+	}
+	if len(index.Blocks) != 4 || index.Frames != 10 || len(index.Rounds) != 2 {
+		t.Fatalf("index = %+v, want 4 blocks, 10 frames and 2 rounds", index)
+	}
+
+	tests := []struct {
+		name  string
+		block func() (Block, bool)
+		first uint32
+	}{
+		{"frame", func() (Block, bool) { return index.BlockForFrame(8) }, 7},
+		{"round", func() (Block, bool) { return index.BlockForRound(2) }, 4},
+		{"seq", func() (Block, bool) { return index.BlockForSeq(9) }, 10},
+	}
+	for _, tt := range tests {
+		block, ok := tt.block()
+		if !ok {
+			t.Fatalf("%s: no block found", tt.name)
+		}
+		r, err := OpenBlock(path, block)
+		if err != nil {
+			t.Fatalf("%s: OpenBlock() error = %v", tt.name, err)
+		}
+		got := readFrames(t, r)
+		r.Close()
+		if len(got) == 0 || got[0] != tt.first || got[len(got)-1] != 10 {
+			t.Errorf("%s: frames from block = %v, want %d to 10", tt.name, got, tt.first)
+		}
+	}
+
+	// The blocks read as a single stream with the existing codec
+	reader, err := codecs.NewNevrCapReader(path)
+	if err != nil {
+		t.Fatalf("NewNevrCapReader() error = %v", err)
+	}
+	defer reader.Close()
+	if header, err := reader.ReadHeader(); err != nil || header.GetCaptureId() != "match" {
+		t.Fatalf("ReadHeader() = %v, %v", header, err)
+	}
+	for i := uint32(1); i <= 10; i++ {
+		if frame, err := reader.ReadFrame(); err != nil || frame.GetFrameIndex() != i {
+			t.Fatalf("ReadFrame() = %v, %v, want frame %d", frame, err, i)
+		}
+	}
+}
+
+func TestReindex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "match.nevrcap")
+	w, err := codecs.NewNevrCapWriter(path)
+	if err != nil {
+		t.Fatalf("NewNevrCapWriter() error = %v", err)
+	}
+	w.WriteHeader(&telemetry.TelemetryHeader{CaptureId: "match"})
+	for i := uint32(1); i <= 10; i++ {
+		w.WriteFrame(testFrame(i))
+	}
+	w.Close()
+
+	if _, err := LoadIndex(path); !os.IsNotExist(err) {
+		t.Fatalf("LoadIndex() error = %v, want not exist", err)
+	}
+
+	// A capture modified just now may still be written
+	if _, err := Reindex(path, 4); !errors.Is(err, ErrCaptureInUse) {
+		t.Fatalf("Reindex() of a recent capture error = %v, want ErrCaptureInUse", err)
+	}
+	modified := time.Now().Add(-time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+
+	index, err := Reindex(path, 4)
+	if err != nil {
+		t.Fatalf("Reindex() error = %v", err)
+	}
+	if len(index.Blocks) != 3 || index.Frames != 10 {
+		t.Fatalf("index = %+v, want 3 blocks and 10 frames", index)
+	}
+	if info, err := os.Stat(path); err != nil || !info.ModTime().Equal(modified) {
+		t.Errorf("modification time after Reindex = %v, want %v", info.ModTime(), modified)
+	}
+	if _, err := LoadIndex(path); err != nil {
+		t.Fatalf("LoadIndex() after Reindex error = %v", err)
+	}
+
+	r, err := Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer r.Close()
+	if _, err := r.ReadHeader(); err != nil {
+		t.Fatalf("ReadHeader() error = %v", err)
+	}
+	if got := readFrames(t, r); len(got) != 10 {
+		t.Fatalf("frames after Reindex = %v, want 10", got)
+	}
+
+	// An index no longer matching its capture is rejected
+	f, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	f.Write([]byte{0})
+	f.Close()
+	if _, err := LoadIndex(path); !errors.Is(err, ErrStaleIndex) {
+		t.Errorf("LoadIndex() of a changed capture error = %v, want ErrStaleIndex", err)
+	}
+}
+
+func TestCaptureInUse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "match.nevrcap")
+	w, err := Create(path)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	w.WriteHeader(&telemetry.TelemetryHeader{CaptureId: "match"})
+	w.WriteFrame(testFrame(1))
+
+	// The lock file marks the capture however long ago it was written to
+	modified := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+	if inUse, err := CaptureInUse(path); err != nil || !inUse {
+		t.Errorf("CaptureInUse() while written = %v, %v, want true", inUse, err)
+	}
+	if _, err := Reindex(path, 4); !errors.Is(err, ErrCaptureInUse) {
+		t.Errorf("Reindex() while written error = %v, want ErrCaptureInUse", err)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := os.Stat(LockPath(path)); !os.IsNotExist(err) {
+		t.Errorf("lock file after Close() error = %v, want not exist", err)
+	}
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+	if inUse, err := CaptureInUse(path); err != nil || inUse {
+		t.Errorf("CaptureInUse() after Close() = %v, %v, want false", inUse, err)
+	}
+}
@@ -0,0 +1,180 @@
+package nevrcap
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Reader reads frames from a capture, from its start or from a block
+type Reader struct {
+	file    *os.File
+	decoder *zstd.Decoder
+	reader  *bufio.Reader
+}
+
+// Open opens the capture at path from its start, where the header is read
+// with ReadHeader before the frames
+func Open(path string) (*Reader, error) {
+	return OpenBlock(path, Block{})
+}
+
+// OpenBlock opens the capture at path from the start of block, which is read
+// next by ReadFrame
+func OpenBlock(path string, block Block) (*Reader, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(block.Offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &Reader{
+		file:    file,
+		decoder: decoder,
+		reader:  bufio.NewReader(decoder),
+	}, nil
+}
+
+// ReadHeader reads the capture header
+func (r *Reader) ReadHeader() (*telemetry.TelemetryHeader, error) {
+	header := &telemetry.TelemetryHeader{}
+	if err := protodelim.UnmarshalFrom(r.reader, header); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// ReadFrame reads the next frame, returning io.EOF at the end of the capture
+func (r *Reader) ReadFrame() (*telemetry.LobbySessionStateFrame, error) {
+	frame := &telemetry.LobbySessionStateFrame{}
+	if err := protodelim.UnmarshalFrom(r.reader, frame); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
+
+// Close closes the capture
+func (r *Reader) Close() error {
+	r.decoder.Close()
+	return r.file.Close()
+}
+
+// Reindex rewrites the capture at path in blocks of blockFrames frames and
+// writes its index. Captures are rewritten because a capture compressed as a
+// single stream cannot be entered part way through. The rewritten capture
+// keeps the modification time of the original.
+//
+// Reindex returns ErrCaptureInUse, leaving the capture untouched, for a
+// capture that is being written or that changes while it is rewritten.
+func Reindex(path string, blockFrames int) (*Index, error) {
+	if inUse, err := CaptureInUse(path); err != nil {
+		return nil, err
+	} else if inUse {
+		return nil, ErrCaptureInUse
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	header, err := reader.ReadHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	tmpPath := path + ".reindex"
+	writer, err := CreateWithBlockSize(tmpPath, blockFrames)
+	if err != nil {
+		return nil, err
+	}
+	// The writer saves its index next to the temporary file
+	abort := func(err error) (*Index, error) {
+		writer.Close()
+		os.Remove(tmpPath)
+		os.Remove(IndexPath(tmpPath))
+		return nil, err
+	}
+
+	if err := writer.WriteHeader(header); err != nil {
+		return abort(err)
+	}
+	for {
+		frame, err := reader.ReadFrame()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return abort(err)
+		}
+		if err := writer.WriteFrame(frame); err != nil {
+			return abort(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		os.Remove(tmpPath)
+		os.Remove(IndexPath(tmpPath))
+		return nil, err
+	}
+	os.Remove(IndexPath(tmpPath))
+
+	// A writer that started since would lose what it appended
+	if current, err := os.Stat(path); err != nil || current.Size() != info.Size() || !current.ModTime().Equal(info.ModTime()) {
+		os.Remove(tmpPath)
+		if err != nil {
+			return nil, err
+		}
+		return nil, ErrCaptureInUse
+	}
+
+	index := writer.Index()
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+	// Retention and listings go by modification time
+	if err := os.Chtimes(path, time.Time{}, info.ModTime()); err != nil {
+		return nil, err
+	}
+	if err := index.Save(IndexPath(path)); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// CaptureInUse reports whether the capture at path is being written: it has
+// a lock file, or was modified within the last ActiveCaptureAge. A lock file
+// left by a writer that did not exit cleanly must be removed by hand.
+func CaptureInUse(path string) (bool, error) {
+	if _, err := os.Stat(LockPath(path)); err == nil {
+		return true, nil
+	} else if !os.IsNotExist(err) {
+		return false, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	return time.Since(info.ModTime()) < ActiveCaptureAge, nil
+}
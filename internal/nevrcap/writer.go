@@ -0,0 +1,176 @@
+// Package nevrcap writes .nevrcap captures as a series of independently
+// decodable zstd blocks, with a seek index sidecar that maps frame indices,
+// timestamps and rounds to the blocks, and opens captures at any block.
+//
+// Concatenated zstd frames decode as one stream, so captures written here
+// remain readable by any nevrcap reader.
+package nevrcap
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/echotools/nevr-common/v4/gen/go/telemetry/v1"
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// DefaultBlockFrames is the number of frames in each block, about ten
+// seconds of a capture at 30 Hz
+const DefaultBlockFrames = 300
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// Writer writes a capture in blocks and its index to the sidecar file when
+// closed. Completed blocks are flushed to the file, so that a capture can be
+// read while it is written. A lock file marks the capture as being written
+// until the writer is closed, see CaptureInUse.
+type Writer struct {
+	path        string
+	file        *os.File
+	out         *countingWriter
+	encoder     *zstd.Encoder
+	blockFrames int
+	inBlock     int
+
+	mu    sync.Mutex
+	index Index
+}
+
+// Create creates a capture file at path with blocks of DefaultBlockFrames
+func Create(path string) (*Writer, error) {
+	return CreateWithBlockSize(path, DefaultBlockFrames)
+}
+
+// CreateWithBlockSize creates a capture file at path with blocks of
+// blockFrames frames
+func CreateWithBlockSize(path string, blockFrames int) (*Writer, error) {
+	if blockFrames <= 0 {
+		blockFrames = DefaultBlockFrames
+	}
+
+	if err := os.WriteFile(LockPath(path), []byte(strconv.Itoa(os.Getpid())), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write lock file: %w", err)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		os.Remove(LockPath(path))
+		return nil, err
+	}
+
+	out := &countingWriter{w: file}
+	encoder, err := zstd.NewWriter(out, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	if err != nil {
+		file.Close()
+		os.Remove(LockPath(path))
+		return nil, err
+	}
+
+	return &Writer{
+		path:        path,
+		file:        file,
+		out:         out,
+		encoder:     encoder,
+		blockFrames: blockFrames,
+		index:       Index{Version: indexVersion},
+	}, nil
+}
+
+// WriteHeader writes the capture header in a block of its own
+func (w *Writer) WriteHeader(header *telemetry.TelemetryHeader) error {
+	if _, err := protodelim.MarshalTo(w.encoder, header); err != nil {
+		return err
+	}
+	return w.endBlock()
+}
+
+// WriteFrame writes a frame, starting a new block when the current one is full
+func (w *Writer) WriteFrame(frame *telemetry.LobbySessionStateFrame) error {
+	w.mu.Lock()
+	if w.inBlock == 0 {
+		w.index.addBlock(w.out.n, frame)
+	}
+	w.index.addFrame(frame)
+	w.mu.Unlock()
+
+	if _, err := protodelim.MarshalTo(w.encoder, frame); err != nil {
+		return err
+	}
+
+	w.inBlock++
+	if w.inBlock >= w.blockFrames {
+		return w.endBlock()
+	}
+	return nil
+}
+
+// endBlock finishes the current zstd frame and starts the next one
+func (w *Writer) endBlock() error {
+	if err := w.encoder.Close(); err != nil {
+		return err
+	}
+	w.encoder.Reset(w.out)
+	w.inBlock = 0
+
+	w.mu.Lock()
+	w.index.Size = w.out.n
+	w.mu.Unlock()
+	return nil
+}
+
+// Index returns a copy of the index of the blocks completed so far. It is
+// safe to call while frames are written.
+func (w *Writer) Index() *Index {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	index := w.index.clone()
+
+	// The block being written is not in the file yet
+	if n := len(index.Blocks); n > 0 && index.Blocks[n-1].Offset >= index.Size {
+		index.Frames = index.Blocks[n-1].Seq
+		index.Blocks = index.Blocks[:n-1]
+		for len(index.Rounds) > 0 && index.Rounds[len(index.Rounds)-1].Seq >= index.Frames {
+			index.Rounds = index.Rounds[:len(index.Rounds)-1]
+		}
+	}
+	return index
+}
+
+// Close finishes the last block, closes the file, writes its index and
+// removes the lock file
+func (w *Writer) Close() error {
+	defer os.Remove(LockPath(w.path))
+
+	err := w.encoder.Close()
+
+	w.mu.Lock()
+	w.index.Size = w.out.n
+	index := w.index.clone()
+	w.mu.Unlock()
+
+	if closeErr := w.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := index.Save(IndexPath(w.path)); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}